@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout bounds the API calls made while completing an argument,
+// so that a slow or unreachable endpoint never blocks the shell
+const completionTimeout = 3 * time.Second
+
+// completeResourceArgs completes <table-bucket> <namespace> <table> arguments.
+// The partially typed word is sent as a server-side prefix to keep the
+// candidate set small for large buckets.
+func completeResourceArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	// PersistentPreRunE does not run for shell completion, so the client may not exist yet
+	if getS3TablesClient() == nil {
+		if err := initAWSClient(cmd, args); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	lister := newLister(getS3TablesClient())
+	return completeResources(ctx, lister, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeResources returns the names at the level after args that start with prefix.
+// Lookup failures yield no candidates rather than an error.
+func completeResources(ctx context.Context, lister *s3tables.S3TablesLister, args []string, prefix string) []string {
+	var names []string
+	switch len(args) {
+	case 0:
+		buckets, err := lister.ListTableBucketsAll(ctx, prefix)
+		if err != nil {
+			return nil
+		}
+		for _, bucket := range buckets {
+			names = append(names, bucket.Name)
+		}
+	case 1:
+		bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
+		if err != nil {
+			return nil
+		}
+		namespaces, err := lister.ListNamespacesAll(ctx, bucketARN, prefix)
+		if err != nil {
+			return nil
+		}
+		for _, ns := range namespaces {
+			names = append(names, ns.Name)
+		}
+	case 2:
+		bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
+		if err != nil {
+			return nil
+		}
+		tables, err := lister.ListTablesAll(ctx, bucketARN, args[1], prefix)
+		if err != nil {
+			return nil
+		}
+		for _, tbl := range tables {
+			names = append(names, tbl.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	awss3tables "github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestCompleteResources_NamespacePrefix tests that the partial word is sent as the ListNamespaces prefix
+func TestCompleteResources_NamespacePrefix(t *testing.T) {
+	bucketName := "my-bucket"
+	bucketARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket"
+	all := []string{"sales", "sales_archive", "marketing"}
+
+	var gotPrefix string
+	mock := &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			return &awss3tables.ListTableBucketsOutput{
+				TableBuckets: []types.TableBucketSummary{{Name: &bucketName, Arn: &bucketARN}},
+			}, nil
+		},
+		listNamespacesFunc: func(ctx context.Context, params *awss3tables.ListNamespacesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListNamespacesOutput, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("ListNamespaces called without a deadline")
+			}
+			gotPrefix = *params.Prefix
+			var summaries []types.NamespaceSummary
+			for _, ns := range all {
+				if strings.HasPrefix(ns, gotPrefix) {
+					summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+				}
+			}
+			return &awss3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	got := completeResources(ctx, s3tables.NewS3TablesLister(mock), []string{bucketName}, "sal")
+
+	if gotPrefix != "sal" {
+		t.Errorf("ListNamespaces prefix = %q, want sal", gotPrefix)
+	}
+	if want := []string{"sales", "sales_archive"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completeResources() = %v, want %v", got, want)
+	}
+}
+
+// TestCompleteResources_LookupFailure tests that an unknown bucket yields no candidates
+func TestCompleteResources_LookupFailure(t *testing.T) {
+	got := completeResources(context.Background(), s3tables.NewS3TablesLister(&mockS3TablesAPI{}), []string{"missing"}, "")
+	if len(got) != 0 {
+		t.Errorf("completeResources() = %v, want no candidates", got)
+	}
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig holds the persistent defaults read from the config file.
+// Every field is optional; unset fields leave the flag default in place.
+type fileConfig struct {
+	Profile  string `yaml:"profile"`
+	Region   string `yaml:"region"`
+	Output   string `yaml:"output"`
+	Lang     string `yaml:"lang"`
+	AuditLog string `yaml:"audit_log"`
+	ASCII    *bool  `yaml:"ascii"`
+	Pager    *bool  `yaml:"pager"`
+	// Labels replaces the interactive prompt labels (e.g. for localization)
+	Labels promptLabels `yaml:"labels"`
+}
+
+// promptLabels holds the configured label of each interactive navigation level.
+// Empty labels keep the default.
+type promptLabels struct {
+	TableBucket string `yaml:"table_bucket"`
+	Namespace   string `yaml:"namespace"`
+	Table       string `yaml:"table"`
+}
+
+// levelLabels converts the configured labels for the navigation controller
+func (l promptLabels) levelLabels() s3tables.LevelLabels {
+	return s3tables.LevelLabels{TableBucket: l.TableBucket, Namespace: l.Namespace, Table: l.Table}
+}
+
+// configSetting maps a config file field to the flag it provides a default for
+type configSetting struct {
+	flag string
+	// env lists environment variables that take precedence over the config file
+	env []string
+	// envHandled is true when the AWS SDK reads env itself, so the flag must stay unset
+	envHandled bool
+	// command restricts the setting to one subcommand when the flag name is reused elsewhere
+	command string
+	value   func(cfg *fileConfig) string
+}
+
+// configSettings lists every flag that can be defaulted from the config file
+var configSettings = []configSetting{
+	{flag: "profile", env: []string{"AWS_PROFILE"}, envHandled: true, value: func(c *fileConfig) string { return c.Profile }},
+	{flag: "region", env: []string{"AWS_REGION", "AWS_DEFAULT_REGION"}, envHandled: true, value: func(c *fileConfig) string { return c.Region }},
+	{flag: "output", env: []string{"S3T_OUTPUT"}, command: "list", value: func(c *fileConfig) string { return c.Output }},
+	{flag: "lang", env: []string{"S3T_LANG"}, value: func(c *fileConfig) string { return c.Lang }},
+	{flag: "audit-log", env: []string{"S3T_AUDIT_LOG"}, value: func(c *fileConfig) string { return c.AuditLog }},
+	{flag: "ascii", env: []string{"S3T_ASCII"}, value: func(c *fileConfig) string { return formatOptionalBool(c.ASCII) }},
+	{flag: "pager", value: func(c *fileConfig) string { return formatOptionalBool(c.Pager) }},
+}
+
+// formatOptionalBool formats an optional boolean setting as a flag value ("" when unset)
+func formatOptionalBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+// defaultConfigPath returns $XDG_CONFIG_HOME/s3t/config.yaml, or ~/.config/s3t/config.yaml
+func defaultConfigPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "s3t", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "s3t", "config.yaml"), nil
+}
+
+// loadConfigFile reads the config file at path. A missing file yields a nil
+// config unless required is set (the path was given explicitly with --config).
+func loadConfigFile(path string, required bool) (*fileConfig, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	cfg, err := parseConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file '%s': %w", path, err)
+	}
+	return cfg, nil
+}
+
+// parseConfig decodes a YAML config, rejecting unknown keys so that typos are reported
+func parseConfig(r io.Reader) (*fileConfig, error) {
+	cfg := &fileConfig{}
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Sources recorded in configSources
+const (
+	sourceFlag       = "flag"
+	sourceConfigFile = "config file"
+	sourceDefault    = "default"
+)
+
+// configSources records where each config setting of the running command came
+// from (sourceFlag, sourceConfigFile or "env NAME"); unset settings are absent
+var configSources = map[string]string{}
+
+var (
+	// loadedConfig is the config file read by loadConfig (empty when there is none)
+	loadedConfig = &fileConfig{}
+	// loadedConfigPath is the path loadConfig read loadedConfig from
+	loadedConfigPath string
+)
+
+// settingValue returns the value of setting from the config file and environment,
+// with the environment taking precedence, and where it came from. Environment
+// variables read by the AWS SDK itself yield an empty value with an env source.
+func settingValue(setting configSetting, cfg *fileConfig, getenv func(string) string) (value, source string) {
+	value = setting.value(cfg)
+	if value != "" {
+		source = sourceConfigFile
+	}
+	for _, name := range setting.env {
+		if env := getenv(name); env != "" {
+			value, source = env, "env "+name
+			if setting.envHandled {
+				value = ""
+			}
+			break
+		}
+	}
+	return value, source
+}
+
+// applyConfig fills in flags of cmd that were not set on the command line,
+// with the precedence config file < environment < flags
+func applyConfig(cmd *cobra.Command, cfg *fileConfig, getenv func(string) string) error {
+	configSources = map[string]string{}
+	for _, setting := range configSettings {
+		if setting.command != "" && setting.command != cmd.Name() {
+			continue
+		}
+		flag := cmd.Flags().Lookup(setting.flag)
+		if flag == nil {
+			continue
+		}
+		if flag.Changed {
+			configSources[setting.flag] = sourceFlag
+			continue
+		}
+
+		value, source := settingValue(setting, cfg, getenv)
+		if source != "" {
+			configSources[setting.flag] = source
+		}
+		if value == "" {
+			continue
+		}
+		if err := cmd.Flags().Set(setting.flag, value); err != nil {
+			return fmt.Errorf("invalid value '%s' for %s: %w", value, setting.flag, err)
+		}
+	}
+	return nil
+}
+
+// loadConfig loads the config file selected by --config (or the default path)
+// and applies it to the flags of cmd
+func loadConfig(cmd *cobra.Command) error {
+	path, required := configPath, true
+	if path == "" {
+		var err error
+		if path, err = defaultConfigPath(); err != nil {
+			return nil // 設定ファイルの場所が決まらない場合はフラグのみで動作
+		}
+		required = false
+	}
+
+	cfg, err := loadConfigFile(path, required)
+	if err != nil {
+		return err
+	}
+	if cfg == nil {
+		// 既定の場所に設定ファイルがない場合は読み込んだパスを記録しない
+		cfg, path = &fileConfig{}, ""
+	}
+	loadedConfig, loadedConfigPath = cfg, path
+	return applyConfig(cmd, cfg, os.Getenv)
+}
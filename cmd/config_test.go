@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+// newConfigTestCommand returns a command carrying the flags the config file can default
+func newConfigTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "list"}
+	cmd.Flags().String("profile", "", "")
+	cmd.Flags().String("region", "", "")
+	cmd.Flags().String("output", outputFormatTable, "")
+	cmd.Flags().String("lang", "en", "")
+	cmd.Flags().String("audit-log", "", "")
+	cmd.Flags().Bool("ascii", false, "")
+	return cmd
+}
+
+// TestApplyConfigPrecedence tests the config file < environment < flags precedence
+func TestApplyConfigPrecedence(t *testing.T) {
+	ascii := true
+	cfg := &fileConfig{Profile: "file-profile", Region: "us-west-2", Output: "csv", Lang: "ja", ASCII: &ascii}
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		flag string
+		want string
+	}{
+		{name: "config file only", flag: "output", want: "csv"},
+		{name: "config file bool", flag: "ascii", want: "true"},
+		{name: "env overrides config", env: map[string]string{"S3T_OUTPUT": "table"}, flag: "output", want: "table"},
+		{name: "flag overrides env", env: map[string]string{"S3T_LANG": "en"}, args: []string{"--lang", "ja"}, flag: "lang", want: "ja"},
+		{name: "flag overrides config", args: []string{"--region", "eu-west-1"}, flag: "region", want: "eu-west-1"},
+		{name: "AWS env left to the SDK", env: map[string]string{"AWS_PROFILE": "env-profile"}, flag: "profile", want: ""},
+		{name: "AWS_DEFAULT_REGION left to the SDK", env: map[string]string{"AWS_DEFAULT_REGION": "eu-central-1"}, flag: "region", want: ""},
+		{name: "config file profile", flag: "profile", want: "file-profile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newConfigTestCommand()
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("failed to parse args: %v", err)
+			}
+			getenv := func(key string) string { return tt.env[key] }
+
+			if err := applyConfig(cmd, cfg, getenv); err != nil {
+				t.Fatalf("applyConfig() error = %v", err)
+			}
+			if got := cmd.Flags().Lookup(tt.flag).Value.String(); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.flag, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestApplyConfigScopedSetting tests that list-only settings leave other commands alone
+func TestApplyConfigScopedSetting(t *testing.T) {
+	cmd := &cobra.Command{Use: "profiles"}
+	cmd.Flags().String("output", "text", "")
+
+	if err := applyConfig(cmd, &fileConfig{Output: "csv"}, func(string) string { return "" }); err != nil {
+		t.Fatalf("applyConfig() error = %v", err)
+	}
+	if got := cmd.Flags().Lookup("output").Value.String(); got != "text" {
+		t.Errorf("output = %q, want %q", got, "text")
+	}
+}
+
+// TestApplyConfigInvalidValue tests that a value the flag rejects is reported
+func TestApplyConfigInvalidValue(t *testing.T) {
+	cmd := newConfigTestCommand()
+	err := applyConfig(cmd, &fileConfig{}, func(key string) string {
+		if key == "S3T_ASCII" {
+			return "maybe"
+		}
+		return ""
+	})
+	if err == nil || !strings.Contains(err.Error(), "ascii") {
+		t.Errorf("applyConfig() error = %v, want error mentioning ascii", err)
+	}
+}
+
+// TestLoadConfigFile tests reading, tolerating and rejecting config files
+func TestLoadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	t.Run("valid file", func(t *testing.T) {
+		path := write("valid.yaml", "profile: dev\nregion: ap-northeast-1\nascii: false\n")
+		cfg, err := loadConfigFile(path, true)
+		if err != nil {
+			t.Fatalf("loadConfigFile() error = %v", err)
+		}
+		if cfg.Profile != "dev" || cfg.Region != "ap-northeast-1" || cfg.ASCII == nil || *cfg.ASCII {
+			t.Errorf("loadConfigFile() = %+v", cfg)
+		}
+	})
+
+	t.Run("prompt labels", func(t *testing.T) {
+		path := write("labels.yaml", "labels:\n  table_bucket: テーブルバケットを選択\n  table: テーブルを選択\n")
+		cfg, err := loadConfigFile(path, true)
+		if err != nil {
+			t.Fatalf("loadConfigFile() error = %v", err)
+		}
+		labels := cfg.Labels.levelLabels()
+		if labels.For(s3tables.LevelTableBucket) != "テーブルバケットを選択" || labels.For(s3tables.LevelTable) != "テーブルを選択" {
+			t.Errorf("labels = %+v, want the configured table bucket and table labels", labels)
+		}
+		if got := labels.For(s3tables.LevelNamespace); got != "Select Namespace" {
+			t.Errorf("namespace label = %q, want the default", got)
+		}
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		cfg, err := loadConfigFile(write("empty.yaml", ""), true)
+		if err != nil {
+			t.Fatalf("loadConfigFile() error = %v", err)
+		}
+		if *cfg != (fileConfig{}) {
+			t.Errorf("loadConfigFile() = %+v, want empty config", cfg)
+		}
+	})
+
+	t.Run("missing default file is tolerated", func(t *testing.T) {
+		cfg, err := loadConfigFile(filepath.Join(dir, "missing.yaml"), false)
+		if err != nil || cfg != nil {
+			t.Errorf("loadConfigFile() = %v, %v; want no config", cfg, err)
+		}
+	})
+
+	t.Run("missing explicit file is an error", func(t *testing.T) {
+		if _, err := loadConfigFile(filepath.Join(dir, "missing.yaml"), true); err == nil {
+			t.Error("loadConfigFile() expected error for missing --config file")
+		}
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		path := write("malformed.yaml", "region: [unterminated\n")
+		_, err := loadConfigFile(path, false)
+		if err == nil {
+			t.Fatal("loadConfigFile() expected error for malformed YAML")
+		}
+		if !strings.Contains(err.Error(), "invalid config file") || !strings.Contains(err.Error(), path) {
+			t.Errorf("error = %q, want it to name the config file", err)
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := loadConfigFile(write("typo.yaml", "regoin: us-east-1\n"), false)
+		if err == nil || !strings.Contains(err.Error(), "regoin") {
+			t.Errorf("error = %v, want it to name the unknown key", err)
+		}
+	})
+}
+
+// TestLoadConfigMissingDefaultFile tests that no config file path is recorded when the default file does not exist
+func TestLoadConfigMissingDefaultFile(t *testing.T) {
+	savedConfig, savedPath, savedConfigPath := loadedConfig, loadedConfigPath, configPath
+	t.Cleanup(func() { loadedConfig, loadedConfigPath, configPath = savedConfig, savedPath, savedConfigPath })
+	t.Setenv("HOME", t.TempDir())
+	configPath, loadedConfigPath = "", "stale"
+
+	if err := loadConfig(newConfigTestCommand()); err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if loadedConfigPath != "" || loadedConfig == nil {
+		t.Errorf("loadedConfigPath = %q, loadedConfig = %v; want no path and an empty config", loadedConfigPath, loadedConfig)
+	}
+}
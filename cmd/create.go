@@ -1,16 +1,22 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"s3t/internal/s3tables"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/spf13/cobra"
 )
 
 var createCmd = &cobra.Command{
-	Use:   "create <table-bucket> <namespace> <table>",
+	Use:   "create <table-bucket> <namespace> [table]",
 	Short: "Create S3 Tables resources",
 	Long: `Create S3 Tables resources (Table Bucket, Namespace, Table) hierarchically.
 
@@ -19,23 +25,172 @@ This command creates the specified resources in order:
 2. Namespace (if not exists)
 3. Table (if not exists)
 
-Existing resources are detected and skipped with a notification.`,
-	Args: cobra.ExactArgs(3),
+Existing resources are detected and skipped with a notification.
+
+Multiple tables can be created under one namespace in a single run by
+repeating --table (optionally together with the positional table):
+  s3t create my-bucket my-namespace --table orders --table customers
+
+Pass "-" as the table to read table names from stdin, one per line:
+  cat names.txt | s3t create my-bucket my-namespace -
+Blank lines are skipped; invalid names are reported and skipped.
+
+The creation summary is printed to stderr and one line per resource to
+stdout: the kind (table-bucket, namespace or table), the name (namespace/table
+for tables) and the ARN ("-" for namespaces), separated by tabs. The ARNs can be
+captured while the summary stays visible:
+  arn=$(s3t create my-bucket my-namespace my-table | awk -F'\t' '$1 == "table" { print $3 }')
+
+Use --summary-line to print a final line for scripts:
+  SUMMARY created=<n> existed=<n> bucket_arn=<arn> table_arn=<arn>
+
+Use --only-new or --only-existing to list only the created or only the
+already existing resources in the summary.
+
+Use --wait-consistent to wait after each create until the new resource is
+visible through Get/List, so that commands run right afterwards see it.
+
+Use --wait to poll a newly created table bucket until GetTableBucket succeeds
+before its namespace is created.
+
+Use --no-create-bucket when table buckets are managed elsewhere (e.g. IaC):
+a missing table bucket is then reported as an error instead of being created,
+and no namespace or table is touched.
+
+Use --force-recreate to delete an existing table and create it fresh. Each
+delete is confirmed interactively unless --yes is given; a declined table is
+kept, reported as skipped, and the remaining tables are still processed:
+  s3t create my-bucket my-namespace my-table --force-recreate --yes
+
+Use --expect-type to verify the type of tables that already exist. A table
+whose type differs (e.g. an AWS managed table where a customer table is
+expected) is reported as an error instead of being skipped:
+  s3t create my-bucket my-namespace my-table --expect-type customer
+
+Use --existence-check get or --existence-check list when the IAM policy allows
+only one of GetNamespace/GetTable or ListNamespaces/ListTables. The default,
+auto, calls Get and falls back to List when Get is forbidden.
+
+Use --column to define the table schema and --partition-by to partition new
+tables by a column, optionally through a transform (identity, bucket[N],
+truncate[N], year, month, day or hour). Both flags are repeatable, and every
+partition column must be defined with --column:
+  s3t create my-bucket my-namespace events --column id:long --column ts:timestamp \
+    --partition-by ts:day --partition-by id:bucket[16]
+
+Use --preflight to verify S3 Tables permissions before any resource is touched.
+Use --explain to print the violated naming constraint in detail when validation fails.`,
+	Args: cobra.RangeArgs(2, 3),
 	RunE: runCreate,
 }
 
+var (
+	// createPreflight probes permissions before creating resources
+	createPreflight bool
+	// createExplain prints a detailed explanation of validation failures
+	createExplain bool
+	// createTables holds additional tables to create under the namespace
+	createTables []string
+
+	// createSummaryLine prints a machine-friendly summary line after the result
+	createSummaryLine bool
+
+	// createOnlyNew shows only messages for newly created resources
+	createOnlyNew bool
+	// createOnlyExisting shows only messages for resources that already existed
+	createOnlyExisting bool
+
+	// createWaitConsistent waits until each created resource is visible
+	createWaitConsistent bool
+
+	// createWait waits after a table bucket create until GetTableBucket succeeds
+	createWait bool
+
+	// createNoBucket fails on a missing table bucket instead of creating it
+	createNoBucket bool
+
+	// createForceRecreate deletes existing tables and creates them again
+	createForceRecreate bool
+	// createYes skips the confirmation before a table is recreated
+	createYes bool
+
+	// createExistenceCheck selects Get or List calls for existence checks (auto, get, list)
+	createExistenceCheck string
+	// createExpectType is the type existing tables must have (customer, aws; empty skips the check)
+	createExpectType string
+
+	// createColumns holds the name:type columns of the schema of new tables
+	createColumns []string
+	// createPartitionBy holds the col[:transform] partition fields of new tables
+	createPartitionBy []string
+
+	// createStdin is where table names are read from when the table argument is "-"
+	createStdin io.Reader = os.Stdin
+)
+
 func init() {
+	createCmd.Flags().BoolVar(&createPreflight, "preflight", false, "Verify S3 Tables permissions before creating resources")
+	createCmd.Flags().BoolVar(&createExplain, "explain", false, "Explain validation failures in detail")
+	createCmd.Flags().StringArrayVar(&createTables, "table", nil, "Table to create under the namespace (repeatable)")
+	createCmd.Flags().BoolVar(&createSummaryLine, "summary-line", false, "Print a final grep-able SUMMARY line")
+	createCmd.Flags().BoolVar(&createOnlyNew, "only-new", false, "Only list resources that were newly created")
+	createCmd.Flags().BoolVar(&createOnlyExisting, "only-existing", false, "Only list resources that already existed")
+	createCmd.Flags().BoolVar(&createWait, "wait", false, "Wait until a created table bucket is ready before creating the namespace")
+	createCmd.Flags().BoolVar(&createWaitConsistent, "wait-consistent", false, "Wait until each created resource is visible before continuing")
+	createCmd.Flags().BoolVar(&createNoBucket, "no-create-bucket", false, "Fail if the table bucket does not exist instead of creating it")
+	createCmd.Flags().BoolVar(&createForceRecreate, "force-recreate", false, "Delete existing tables and create them fresh (destructive)")
+	createCmd.Flags().StringVar(&createExistenceCheck, "existence-check", "auto", "How namespace and table existence is checked: auto (Get, falling back to List when forbidden), get or list")
+	createCmd.Flags().StringVar(&createExpectType, "expect-type", "", "Fail if an existing table is not of this type (customer or aws)")
+	createCmd.Flags().BoolVar(&createYes, "yes", false, "Recreate tables without asking for confirmation")
+	createCmd.Flags().StringArrayVar(&createColumns, "column", nil, "Schema column of new tables as name:type, e.g. ts:timestamp (repeatable)")
+	createCmd.Flags().StringArrayVar(&createPartitionBy, "partition-by", nil, "Partition new tables by col[:transform], e.g. id:bucket[16] (repeatable; requires --column)")
 	rootCmd.AddCommand(createCmd)
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
 	tableBucket := args[0]
 	namespace := args[1]
-	table := args[2]
+
+	if createOnlyNew && createOnlyExisting {
+		return fmt.Errorf("--only-new and --only-existing cannot be used together")
+	}
+	if createYes && !createForceRecreate {
+		return fmt.Errorf("--yes requires --force-recreate")
+	}
+	existenceCheck, err := s3tables.ParseExistenceCheck(createExistenceCheck)
+	if err != nil {
+		return err
+	}
+	expectType, err := s3tables.ParseTableType(createExpectType)
+	if err != nil {
+		return err
+	}
+	metadata, err := parseTableMetadata(createColumns, createPartitionBy)
+	if err != nil {
+		return err
+	}
+
+	var tables []string
+	var invalid []error
+	if len(args) == 3 && args[2] == "-" {
+		tables, invalid, err = readTableNames(createStdin)
+		if err != nil {
+			return fmt.Errorf("failed to read table names from stdin: %w", err)
+		}
+		for _, e := range invalid {
+			fmt.Fprintf(stderr(), "Skipping invalid table name: %v\n", e)
+		}
+	} else if len(args) == 3 {
+		tables = append(tables, args[2])
+	}
+	tables = append(tables, createTables...)
+	if len(tables) == 0 {
+		return fmt.Errorf("at least one table is required: pass <table> or --table")
+	}
 
 	// Validate input arguments
-	if err := s3tables.ValidateAll(tableBucket, namespace, table); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+	if err := validateCreateArgs(tableBucket, namespace, tables); err != nil {
+		return err
 	}
 
 	// Get the S3 Tables client from context (set by root command)
@@ -44,67 +199,221 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("S3 Tables client not initialized")
 	}
 
+	ctx := context.Background()
+
+	// Fail fast on missing permissions before touching any resource
+	if createPreflight {
+		if err := s3tables.NewS3TablesChecker(client).ProbePermissions(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Create the S3TablesCreator and execute
 	creator := s3tables.NewS3TablesCreator(client)
-	ctx := context.Background()
+	creator.SetWarningOutput(stderr())
+	audit, closeAudit := openAuditLogger()
+	defer closeAudit()
+	creator.SetAuditLogger(audit)
+	creator.SetExpectedRegion(clientRegion)
+	creator.SetCreateBucketIfMissing(!createNoBucket)
+	creator.SetExistenceCheck(existenceCheck)
+	creator.SetExpectTableType(expectType)
+	creator.SetTableMetadata(metadata)
+	if createWaitConsistent {
+		creator.SetWaitConsistent(s3tables.DefaultVisibilityTimeout)
+	}
+	if createWait {
+		creator.SetWaitBucketReady(s3tables.DefaultBucketReadyTimeout)
+	}
+	if createForceRecreate {
+		creator.SetForceRecreate(newRecreateDeleter(client, audit, createYes))
+	}
 
-	result, err := creator.Create(ctx, tableBucket, namespace, table)
+	result, err := creator.CreateTables(ctx, tableBucket, namespace, tables)
 	if err != nil {
+		// Report what was created before the failure, e.g. a bucket whose --wait timed out
+		if created, _ := result.Counts(); created > 0 {
+			printResult(stdout(), stderr(), result)
+		}
 		return err
 	}
 
 	// Output results
-	printResult(result)
+	printResult(stdout(), stderr(), filterResult(result, createOnlyNew, createOnlyExisting))
+	if createSummaryLine {
+		fmt.Fprintln(stdout(), result.SummaryLine())
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("skipped %d invalid table name(s)", len(invalid))
+	}
 	return nil
 }
 
-// printResult outputs the creation result in a user-friendly format
-func printResult(result *s3tables.CreateResult) {
-	fmt.Println()
-	fmt.Println("=== S3 Tables Resource Creation Summary ===")
-	fmt.Println()
+// parseTableMetadata builds the metadata of new tables from --column and --partition-by
+func parseTableMetadata(columnSpecs, partitionSpecs []string) (types.TableMetadata, error) {
+	if len(partitionSpecs) > 0 && len(columnSpecs) == 0 {
+		return nil, fmt.Errorf("--partition-by requires --column to define the partition columns")
+	}
+	columns, err := s3tables.ParseSchemaColumns(columnSpecs)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := s3tables.ParsePartitionSpec(partitionSpecs)
+	if err != nil {
+		return nil, err
+	}
+	return s3tables.BuildIcebergMetadata(columns, partition)
+}
 
-	// Print each message
-	for _, msg := range result.Messages {
-		fmt.Printf("  • %s\n", msg)
+// newRecreateDeleter returns the deleter used by --force-recreate.
+// With yes set, deletes are not confirmed.
+func newRecreateDeleter(client s3tables.S3TablesAPI, audit *s3tables.AuditLogger, yes bool) *s3tables.S3TablesDeleter {
+	deleter := s3tables.NewS3TablesDeleter(client)
+	deleter.SetOutput(stdout())
+	deleter.SetAuditLogger(audit)
+	deleter.SetConfirmFunc(confirmFunc())
+	if yes {
+		deleter.SetConfirmFunc(func(context.Context, string) (bool, error) { return true, nil })
 	}
+	return deleter
+}
 
-	fmt.Println()
+// readTableNames reads one table name per line from r.
+// Blank lines are skipped; names failing ValidateTable are returned separately
+// with their line number so that the valid ones can still be created.
+func readTableNames(r io.Reader) (valid []string, invalid []error, err error) {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" {
+			continue
+		}
+		if err := s3tables.ValidateTable(name); err != nil {
+			invalid = append(invalid, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+		valid = append(valid, name)
+	}
+	return valid, invalid, scanner.Err()
+}
 
-	// Print summary
-	created := 0
-	existed := 0
+// validateCreateArgs validates the bucket, namespace and every table name
+func validateCreateArgs(tableBucket, namespace string, tables []string) error {
+	for _, table := range tables {
+		if err := s3tables.ValidateAll(tableBucket, namespace, table); err != nil {
+			if createExplain {
+				return explainValidationError(err, tableBucket, namespace, table)
+			}
+			return fmt.Errorf("validation error: %w", err)
+		}
+	}
+	return nil
+}
+
+// explainValidationError appends a detailed constraint explanation for the failing field
+func explainValidationError(err error, tableBucket, namespace, table string) error {
+	var valErr *s3tables.ValidationError
+	if !errors.As(err, &valErr) {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	values := map[string]string{
+		"table-bucket": tableBucket,
+		"namespace":    namespace,
+		"table":        table,
+	}
+	return fmt.Errorf("validation error: %w\n\n%s", err, s3tables.ExplainValidation(valErr.Field, values[valErr.Field]))
+}
 
-	if result.TableBucketCreated {
-		created++
-	} else if result.TableBucketARN != "" {
-		existed++
+// filterResult returns a copy of result limited to the steps that created a
+// resource when onlyNew is set and to those that kept an existing one when
+// onlyExisting is set, so that the messages, the counts and the stdout lines
+// all show the same resources; with neither set, result is returned as is
+func filterResult(result *s3tables.CreateResult, onlyNew, onlyExisting bool) *s3tables.CreateResult {
+	if !onlyNew && !onlyExisting {
+		return result
 	}
+	filtered := *result
+	filtered.Messages, filtered.Steps, filtered.Tables = nil, nil, nil
+	// The table steps follow the order of result.Tables
+	tables := result.Tables
+	for _, step := range result.Steps {
+		var table s3tables.TableResult
+		if step.Resource == "table" && len(tables) > 0 {
+			table, tables = tables[0], tables[1:]
+		}
+		if step.New() && !onlyNew || !step.New() && !onlyExisting {
+			continue
+		}
+		filtered.Messages = append(filtered.Messages, step.Message)
+		filtered.Steps = append(filtered.Steps, step)
+		if step.Resource == "table" {
+			filtered.Tables = append(filtered.Tables, table)
+		}
+	}
+	return &filtered
+}
 
-	if result.NamespaceCreated {
-		created++
-	} else {
-		existed++
+// countSteps returns the number of steps that created a resource and that kept an existing one
+func countSteps(steps []s3tables.CreateStep) (created, existed int) {
+	for _, step := range steps {
+		if step.New() {
+			created++
+		} else {
+			existed++
+		}
 	}
+	return created, existed
+}
 
-	if result.TableCreated {
-		created++
-	} else if result.TableARN != "" {
-		existed++
+// printResult outputs the creation result. The human-readable summary goes to
+// errOut and one tab-separated line of kind, name and ARN per resource goes to
+// out, so that the ARNs can be piped to another command while the summary is
+// still shown on the terminal.
+func printResult(out, errOut io.Writer, result *s3tables.CreateResult) {
+	fmt.Fprintln(errOut)
+	fmt.Fprintln(errOut, "=== S3 Tables Resource Creation Summary ===")
+	fmt.Fprintln(errOut)
+
+	// Print each message
+	for _, msg := range result.Messages {
+		fmt.Fprintf(errOut, "  %s %s\n", bullet(), msg)
 	}
 
+	fmt.Fprintln(errOut)
+
+	// Print summary
+	created, existed := countSteps(result.Steps)
 	if created > 0 {
-		fmt.Printf("Created: %d resource(s)\n", created)
+		fmt.Fprintf(errOut, "Created: %d resource(s)\n", created)
 	}
 	if existed > 0 {
-		fmt.Printf("Already existed: %d resource(s)\n", existed)
+		fmt.Fprintf(errOut, "Already existed: %d resource(s)\n", existed)
 	}
-
-	// Print ARNs if available
 	if result.TableBucketARN != "" {
-		fmt.Printf("\nTable Bucket ARN: %s\n", result.TableBucketARN)
+		fmt.Fprintf(errOut, "\nTable Bucket ARN: %s\n", result.TableBucketARN)
 	}
-	if result.TableARN != "" {
-		fmt.Printf("Table ARN: %s\n", result.TableARN)
+
+	// One line per resource is the machine-readable result; the steps name the
+	// resources in order, and the table steps follow the order of result.Tables
+	namespace := result.Namespace
+	tables := result.Tables
+	for _, step := range result.Steps {
+		name, arn := step.Name, "-"
+		switch step.Resource {
+		case "table-bucket":
+			arn = result.TableBucketARN
+		case "namespace":
+			namespace = step.Name
+		case "table":
+			name = namespace + "/" + step.Name
+			if len(tables) > 0 {
+				arn, tables = tables[0].ARN, tables[1:]
+			}
+		}
+		fmt.Fprintf(out, "%s\t%s\t%s\n", step.Resource, name, arn)
 	}
 }
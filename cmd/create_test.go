@@ -2,7 +2,12 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	s3tablesinternal "s3t/internal/s3tables"
 
@@ -162,3 +167,270 @@ func TestCreateCommand_InvalidArgumentValues(t *testing.T) {
 func containsIgnoreCase(s, substr string) bool {
 	return bytes.Contains(bytes.ToLower([]byte(s)), bytes.ToLower([]byte(substr)))
 }
+
+// TestExplainValidationError tests that the failing field's value is explained
+func TestExplainValidationError(t *testing.T) {
+	err := s3tablesinternal.ValidateAll("valid-bucket", "bad-ns", "valid_table")
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+
+	explained := explainValidationError(err, "valid-bucket", "bad-ns", "valid_table")
+	msg := explained.Error()
+	if !containsIgnoreCase(msg, `namespace "bad-ns"`) {
+		t.Errorf("expected explanation for namespace, got %q", msg)
+	}
+	if !containsIgnoreCase(msg, "position 4: '-'") {
+		t.Errorf("expected offending position in explanation, got %q", msg)
+	}
+}
+
+// TestReadTableNames tests reading table names from a stdin-like reader
+func TestReadTableNames(t *testing.T) {
+	input := "orders\n\n  customers  \nBad-Name\nline_items\n\n"
+
+	valid, invalid, err := readTableNames(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("readTableNames() error = %v", err)
+	}
+
+	if want := []string{"orders", "customers", "line_items"}; !reflect.DeepEqual(valid, want) {
+		t.Errorf("valid = %v, want %v", valid, want)
+	}
+	if len(invalid) != 1 {
+		t.Fatalf("invalid = %v, want 1 entry", invalid)
+	}
+	if !strings.Contains(invalid[0].Error(), "line 4") {
+		t.Errorf("invalid[0] = %v, want line number 4", invalid[0])
+	}
+	var valErr *s3tablesinternal.ValidationError
+	if !errors.As(invalid[0], &valErr) || valErr.Field != "table" {
+		t.Errorf("invalid[0] = %v, want table ValidationError", invalid[0])
+	}
+}
+
+// TestFilterResult tests that --only-new and --only-existing filter the messages, counts and stdout lines alike
+func TestFilterResult(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/b"
+	steps := []s3tablesinternal.CreateStep{
+		{Resource: "table-bucket", Name: "b", Outcome: s3tablesinternal.StepExisted, Message: "Table Bucket 'b' already exists"},
+		{Resource: "namespace", Name: "ns", Outcome: s3tablesinternal.StepCreated, Message: "Namespace 'ns' created"},
+		{Resource: "table", Name: "orders", Outcome: s3tablesinternal.StepExisted, Message: "Table 'orders' already exists"},
+		{Resource: "table", Name: "customers", Outcome: s3tablesinternal.StepCreated, Message: "Table 'customers' created"},
+		{Resource: "table", Name: "events", Outcome: s3tablesinternal.StepRecreated, Message: "Table 'events' recreated"},
+		{Resource: "table", Name: "audit", Outcome: s3tablesinternal.StepSkipped, Message: "Table 'audit' skipped (recreate declined)"},
+	}
+	result := &s3tablesinternal.CreateResult{
+		TableBucketARN:   bucketARN,
+		Namespace:        "ns",
+		Steps:            steps,
+		NamespaceCreated: true,
+		Tables: []s3tablesinternal.TableResult{
+			{Name: "orders", ARN: bucketARN + "/table/t1"},
+			{Name: "customers", ARN: bucketARN + "/table/t2", Created: true},
+			{Name: "events", ARN: bucketARN + "/table/t3", Created: true},
+			{Name: "audit", ARN: bucketARN + "/table/t4", Skipped: true},
+		},
+	}
+	for _, step := range steps {
+		result.Messages = append(result.Messages, step.Message)
+	}
+
+	tests := []struct {
+		name         string
+		onlyNew      bool
+		onlyExisting bool
+		wantMessages []string
+		wantCounts   []string
+		wantStdout   string
+	}{
+		{
+			name:         "no filter",
+			wantMessages: result.Messages,
+			wantCounts:   []string{"Created: 3 resource(s)", "Already existed: 3 resource(s)"},
+			wantStdout: "table-bucket\tb\t" + bucketARN + "\n" +
+				"namespace\tns\t-\n" +
+				"table\tns/orders\t" + bucketARN + "/table/t1\n" +
+				"table\tns/customers\t" + bucketARN + "/table/t2\n" +
+				"table\tns/events\t" + bucketARN + "/table/t3\n" +
+				"table\tns/audit\t" + bucketARN + "/table/t4\n",
+		},
+		{
+			name:         "only new",
+			onlyNew:      true,
+			wantMessages: []string{"Namespace 'ns' created", "Table 'customers' created", "Table 'events' recreated"},
+			wantCounts:   []string{"Created: 3 resource(s)"},
+			wantStdout: "namespace\tns\t-\n" +
+				"table\tns/customers\t" + bucketARN + "/table/t2\n" +
+				"table\tns/events\t" + bucketARN + "/table/t3\n",
+		},
+		{
+			name:         "only existing",
+			onlyExisting: true,
+			wantMessages: []string{"Table Bucket 'b' already exists", "Table 'orders' already exists", "Table 'audit' skipped (recreate declined)"},
+			wantCounts:   []string{"Already existed: 3 resource(s)"},
+			wantStdout: "table-bucket\tb\t" + bucketARN + "\n" +
+				"table\tns/orders\t" + bucketARN + "/table/t1\n" +
+				"table\tns/audit\t" + bucketARN + "/table/t4\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shown := filterResult(result, tt.onlyNew, tt.onlyExisting)
+			if !reflect.DeepEqual(shown.Messages, tt.wantMessages) {
+				t.Errorf("filterResult() messages = %v, want %v", shown.Messages, tt.wantMessages)
+			}
+
+			var stdoutBuf, stderrBuf bytes.Buffer
+			printResult(&stdoutBuf, &stderrBuf, shown)
+			if got := stdoutBuf.String(); got != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", got, tt.wantStdout)
+			}
+			errOut := stderrBuf.String()
+			for _, want := range tt.wantCounts {
+				if !strings.Contains(errOut, want) {
+					t.Errorf("stderr = %q, want %q", errOut, want)
+				}
+			}
+			if tt.onlyNew && strings.Contains(errOut, "Already existed") || tt.onlyExisting && strings.Contains(errOut, "Created:") {
+				t.Errorf("stderr = %q, want only the counts of the shown resources", errOut)
+			}
+		})
+	}
+}
+
+// TestRunCreate_OnlyNewAndOnlyExisting tests that the two filters are mutually exclusive
+func TestRunCreate_OnlyNewAndOnlyExisting(t *testing.T) {
+	createOnlyNew, createOnlyExisting = true, true
+	defer func() { createOnlyNew, createOnlyExisting = false, false }()
+
+	err := runCreate(createCmd, []string{"my-bucket", "my_namespace", "my_table"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+		t.Errorf("runCreate() error = %v, want mutual exclusion error", err)
+	}
+}
+
+// TestPrintResult_Bullet tests that message bullets are valid UTF-8 and ASCII with --ascii
+func TestPrintResult_Bullet(t *testing.T) {
+	result := &s3tablesinternal.CreateResult{Messages: []string{"Table Bucket 'b' created"}}
+
+	tests := []struct {
+		name  string
+		ascii bool
+		want  string
+	}{
+		{name: "unicode", ascii: false, want: "  • Table Bucket 'b' created\n"},
+		{name: "ascii", ascii: true, want: "  - Table Bucket 'b' created\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := asciiOutput
+			asciiOutput = tt.ascii
+			defer func() { asciiOutput = orig }()
+
+			var buf bytes.Buffer
+			printResult(io.Discard, &buf, result)
+
+			out := buf.String()
+			if !utf8.ValidString(out) {
+				t.Errorf("printResult() output is not valid UTF-8: %q", out)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("printResult() output = %q, want line %q", out, tt.want)
+			}
+			if strings.Contains(out, "â€¢") {
+				t.Errorf("printResult() output contains mojibake bullet: %q", out)
+			}
+		})
+	}
+}
+
+// TestPrintResult_Streams tests that the summary goes to stderr and one named line per resource to stdout
+func TestPrintResult_Streams(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/b"
+	tests := []struct {
+		name       string
+		result     *s3tablesinternal.CreateResult
+		wantStdout string
+	}{
+		{
+			name: "table bucket and namespace only",
+			result: &s3tablesinternal.CreateResult{
+				TableBucketARN:     bucketARN,
+				Messages:           []string{"Table Bucket 'b' created", "Namespace 'ns' already exists"},
+				Steps:              []s3tablesinternal.CreateStep{{Resource: "table-bucket", Name: "b"}, {Resource: "namespace", Name: "ns", Outcome: s3tablesinternal.StepExisted}},
+				TableBucketCreated: true,
+			},
+			wantStdout: "table-bucket\tb\t" + bucketARN + "\n" +
+				"namespace\tns\t-\n",
+		},
+		{
+			name: "multiple tables",
+			result: &s3tablesinternal.CreateResult{
+				TableBucketARN: bucketARN,
+				Messages:       []string{"Table Bucket 'b' already exists", "Namespace 'ns' created", "Table 'orders' created", "Table 'customers' already exists"},
+				Steps: []s3tablesinternal.CreateStep{
+					{Resource: "table-bucket", Name: "b", Outcome: s3tablesinternal.StepExisted},
+					{Resource: "namespace", Name: "ns"},
+					{Resource: "table", Name: "orders"},
+					{Resource: "table", Name: "customers", Outcome: s3tablesinternal.StepExisted},
+				},
+				Tables: []s3tablesinternal.TableResult{
+					{Name: "orders", ARN: bucketARN + "/table/t1", Created: true},
+					{Name: "customers", ARN: bucketARN + "/table/t2"},
+				},
+			},
+			wantStdout: "table-bucket\tb\t" + bucketARN + "\n" +
+				"namespace\tns\t-\n" +
+				"table\tns/orders\t" + bucketARN + "/table/t1\n" +
+				"table\tns/customers\t" + bucketARN + "/table/t2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdoutBuf, stderrBuf bytes.Buffer
+			printResult(&stdoutBuf, &stderrBuf, tt.result)
+
+			if got := stdoutBuf.String(); got != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", got, tt.wantStdout)
+			}
+			errOut := stderrBuf.String()
+			if !strings.Contains(errOut, "=== S3 Tables Resource Creation Summary ===") {
+				t.Errorf("stderr = %q, want the summary header", errOut)
+			}
+			for _, msg := range tt.result.Messages {
+				if !strings.Contains(errOut, msg) {
+					t.Errorf("stderr = %q, want message %q", errOut, msg)
+				}
+			}
+			if !strings.Contains(errOut, "Table Bucket ARN: "+tt.result.TableBucketARN) {
+				t.Errorf("stderr = %q, want the table bucket ARN", errOut)
+			}
+			if strings.Contains(errOut, "/table/") {
+				t.Errorf("stderr = %q, table ARNs belong on stdout", errOut)
+			}
+		})
+	}
+}
+
+// TestParseTableMetadata tests building table metadata from --column and --partition-by
+func TestParseTableMetadata(t *testing.T) {
+	if metadata, err := parseTableMetadata(nil, nil); metadata != nil || err != nil {
+		t.Errorf("parseTableMetadata(nil, nil) = %v, %v, want no metadata", metadata, err)
+	}
+	if metadata, err := parseTableMetadata([]string{"id:long", "ts:timestamp"}, []string{"ts:day", "id:bucket[16]"}); metadata == nil || err != nil {
+		t.Errorf("parseTableMetadata() = %v, %v, want metadata", metadata, err)
+	}
+	for _, tt := range []struct{ columns, partition []string }{
+		{partition: []string{"ts:day"}},
+		{columns: []string{"ts:timestamp"}, partition: []string{"ts:week"}},
+		{columns: []string{"ts:datetime"}},
+	} {
+		if _, err := parseTableMetadata(tt.columns, tt.partition); err == nil {
+			t.Errorf("parseTableMetadata(%q, %q) error = nil, want error", tt.columns, tt.partition)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <table-bucket> <namespace> [table]",
+	Short: "Delete a namespace or a table",
+	Long: `Delete a namespace, or a single table when [table] is given, from a table bucket.
+
+Before deleting, the number of tables in the namespace is shown together with
+the first few table names, and confirmation is requested. A namespace that
+still contains tables is refused unless --force is given, in which case its
+tables are deleted first.
+
+With --dry-run, only existence checks are made: the resources that would be
+deleted and those that are already absent are listed, and nothing is deleted.
+
+Examples:
+  # Delete an empty namespace
+  s3t delete my-bucket my-namespace
+
+  # Delete a namespace together with its tables
+  s3t delete my-bucket my-namespace --force
+
+  # Delete a single table
+  s3t delete my-bucket my-namespace my-table
+
+  # Show what would be deleted without deleting anything
+  s3t delete my-bucket my-namespace --force --dry-run`,
+	Args:              cobra.RangeArgs(2, 3),
+	ValidArgsFunction: completeResourceArgs,
+	RunE:              runDelete,
+}
+
+var (
+	// deleteForce deletes the tables of a non-empty namespace before the namespace itself
+	deleteForce bool
+	// deleteDryRun lists what would be deleted without deleting anything
+	deleteDryRun bool
+)
+
+func init() {
+	deleteCmd.Flags().BoolVar(&deleteForce, "force", false, "Delete the namespace even if it contains tables")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "Show what would be deleted without deleting anything")
+	rootCmd.AddCommand(deleteCmd)
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	tableBucket := args[0]
+	namespace := args[1]
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	ctx := context.Background()
+	lister := newLister(client)
+
+	bucketARN, err := lister.GetTableBucketARN(ctx, tableBucket)
+	if err != nil {
+		return err
+	}
+
+	deleter := s3tables.NewS3TablesDeleter(client)
+	deleter.SetOutput(stdout())
+	audit, closeAudit := openAuditLogger()
+	defer closeAudit()
+	deleter.SetAuditLogger(audit)
+	deleter.SetConfirmFunc(confirmFunc())
+	deleter.SetDryRun(deleteDryRun)
+
+	deleted := fmt.Sprintf("namespace '%s'", namespace)
+	if len(args) == 3 {
+		deleted = fmt.Sprintf("table '%s/%s'", namespace, args[2])
+		err = deleter.DeleteTable(ctx, bucketARN, namespace, args[2])
+	} else {
+		err = deleter.DeleteNamespace(ctx, bucketARN, namespace, deleteForce)
+		var s3tErr *s3tables.S3TablesError
+		if !deleteForce && errors.As(err, &s3tErr) && s3tErr.Type == s3tables.ErrorTypeConflict {
+			s3tErr.Suggestions = append(s3tErr.Suggestions, "pass --force to delete the tables together with the namespace")
+		}
+	}
+	if errors.Is(err, s3tables.ErrDeleteCancelled) {
+		fmt.Fprintln(stdout(), "Cancelled")
+		return nil
+	}
+	if err != nil || deleteDryRun {
+		return err
+	}
+
+	fmt.Fprintf(stdout(), "Deleted %s\n", deleted)
+	return nil
+}
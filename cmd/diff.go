@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <table-bucket-a> <table-bucket-b>",
+	Short: "Compare the namespaces and tables of two table buckets",
+	Long: `Compare the namespace/table hierarchies of two table buckets and show what
+exists in only one of them, e.g. to verify a migration.
+
+Namespaces and tables present only in the second bucket are shown with "+",
+those present only in the first bucket with "-".
+
+A table bucket may be given by name or by ARN. A bucket ARN in another region
+than the configured one is read with a client for that region.
+
+Examples:
+  s3t diff old-bucket new-bucket
+  s3t diff old-bucket arn:aws:s3tables:us-west-2:123456789012:bucket/new-bucket
+  s3t diff old-bucket new-bucket -o json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+var (
+	// diffOutput is the output format: text or json
+	diffOutput string
+
+	// diffRegionalClient creates the client for a bucket ARN in another region
+	diffRegionalClient = newRegionalClient
+)
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", "text", "Output format: text or json")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	if diffOutput != "text" && diffOutput != "json" {
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", diffOutput)
+	}
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	specs, err := exportHierarchies(context.Background(), client, args)
+	if err != nil {
+		return err
+	}
+
+	out, closePager := stdoutPaged()
+	defer closePager()
+	return writeDiff(out, diffOutput, s3tables.DiffHierarchies(*specs[0], *specs[1]))
+}
+
+// exportHierarchies exports the hierarchies of the given buckets concurrently
+func exportHierarchies(ctx context.Context, client s3tables.S3TablesAPI, buckets []string) ([]*s3tables.HierarchySpec, error) {
+	specs := make([]*s3tables.HierarchySpec, len(buckets))
+	errs := make([]error, len(buckets))
+	var wg sync.WaitGroup
+	for i, bucket := range buckets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			specs[i], errs[i] = exportHierarchy(ctx, client, bucket)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("table bucket '%s': %w", buckets[i], err)
+		}
+	}
+	return specs, nil
+}
+
+// exportHierarchy exports the hierarchy of a bucket given by name or ARN.
+// A bucket ARN in another region than the configured client is read with a
+// client for its own region.
+func exportHierarchy(ctx context.Context, client s3tables.S3TablesAPI, bucket string) (*s3tables.HierarchySpec, error) {
+	if !strings.HasPrefix(bucket, "arn:") {
+		lister := newLister(client)
+		bucketARN, err := lister.GetTableBucketARN(ctx, bucket)
+		if err != nil {
+			return nil, err
+		}
+		return lister.ExportHierarchy(ctx, bucket, bucketARN)
+	}
+
+	resource, err := s3tables.ParseResourceARN(bucket)
+	if err != nil {
+		return nil, err
+	}
+	if resource.TableID != "" {
+		return nil, fmt.Errorf("'%s' is a table ARN, expected a table bucket ARN", bucket)
+	}
+	if resource.Region != clientRegion {
+		if client, err = diffRegionalClient(ctx, resource.Region); err != nil {
+			return nil, err
+		}
+	}
+	return newLister(client).ExportHierarchy(ctx, resource.BucketName, resource.BucketARN())
+}
+
+// writeDiff prints a hierarchy diff in the given format
+func writeDiff(w io.Writer, format string, diff s3tables.HierarchyDiff) error {
+	switch format {
+	case "text":
+		fmt.Fprintf(w, "--- %s\n+++ %s\n", diff.TableBucketA, diff.TableBucketB)
+		if diff.Equal() {
+			fmt.Fprintf(w, "No differences (%d namespace(s), %d table(s))\n", len(diff.Namespaces.Common), len(diff.Tables.Common))
+			return nil
+		}
+		writeDiffSet(w, "Namespaces", diff.Namespaces)
+		writeDiffSet(w, "Tables", diff.Tables)
+		return nil
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diff)
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", format)
+	}
+}
+
+// writeDiffSet prints the counts of a diff set followed by the added and removed names
+func writeDiffSet(w io.Writer, title string, set s3tables.DiffSet) {
+	fmt.Fprintf(w, "%s: %d added, %d removed, %d common\n", title, len(set.Added), len(set.Removed), len(set.Common))
+	for _, name := range set.Added {
+		fmt.Fprintf(w, "  + %s\n", name)
+	}
+	for _, name := range set.Removed {
+		fmt.Fprintf(w, "  - %s\n", name)
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3tables "github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// newDiffMock returns a mock holding one table bucket with the given tables per namespace
+func newDiffMock(bucket string, tables map[string][]string) *mockS3TablesAPI {
+	return &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String(bucket), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/" + bucket)},
+			}}, nil
+		},
+		listNamespacesFunc: func(ctx context.Context, params *awss3tables.ListNamespacesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListNamespacesOutput, error) {
+			var namespaces []types.NamespaceSummary
+			for ns := range tables {
+				namespaces = append(namespaces, types.NamespaceSummary{Namespace: []string{ns}})
+			}
+			return &awss3tables.ListNamespacesOutput{Namespaces: namespaces}, nil
+		},
+		listTablesFunc: func(ctx context.Context, params *awss3tables.ListTablesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTablesOutput, error) {
+			var summaries []types.TableSummary
+			for _, name := range tables[aws.ToString(params.Namespace)] {
+				summaries = append(summaries, types.TableSummary{Name: aws.String(name)})
+			}
+			return &awss3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+	}
+}
+
+// TestDiffCrossRegionBuckets tests diffing a bucket by name against a bucket ARN in another region
+func TestDiffCrossRegionBuckets(t *testing.T) {
+	local := newDiffMock("bucket-a", map[string][]string{"sales": {"orders", "refunds"}})
+	remote := newDiffMock("bucket-b", map[string][]string{"sales": {"orders"}, "marketing": {"leads"}})
+
+	savedRegion, savedFactory := clientRegion, diffRegionalClient
+	t.Cleanup(func() { clientRegion, diffRegionalClient = savedRegion, savedFactory })
+	clientRegion = "us-east-1"
+	var requestedRegion string
+	diffRegionalClient = func(ctx context.Context, region string) (s3tables.S3TablesAPI, error) {
+		requestedRegion = region
+		return remote, nil
+	}
+
+	specs, err := exportHierarchies(context.Background(), local,
+		[]string{"bucket-a", "arn:aws:s3tables:us-west-2:123456789012:bucket/bucket-b"})
+	if err != nil {
+		t.Fatalf("exportHierarchies() error = %v", err)
+	}
+	if requestedRegion != "us-west-2" {
+		t.Errorf("regional client created for %q, want us-west-2", requestedRegion)
+	}
+
+	var buf bytes.Buffer
+	if err := writeDiff(&buf, "text", s3tables.DiffHierarchies(*specs[0], *specs[1])); err != nil {
+		t.Fatalf("writeDiff() error = %v", err)
+	}
+	want := "--- bucket-a\n" +
+		"+++ bucket-b\n" +
+		"Namespaces: 1 added, 0 removed, 1 common\n" +
+		"  + marketing\n" +
+		"Tables: 1 added, 1 removed, 1 common\n" +
+		"  + marketing/leads\n" +
+		"  - sales/refunds\n"
+	if buf.String() != want {
+		t.Errorf("writeDiff() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+// TestWriteDiffNoDifferences tests the text output for identical hierarchies
+func TestWriteDiffNoDifferences(t *testing.T) {
+	spec := s3tables.HierarchySpec{TableBucket: "a", Namespaces: []s3tables.NamespaceSpec{{Name: "ns", Tables: []string{"t"}}}}
+	var buf bytes.Buffer
+	if err := writeDiff(&buf, "text", s3tables.DiffHierarchies(spec, spec)); err != nil {
+		t.Fatalf("writeDiff() error = %v", err)
+	}
+	if want := "--- a\n+++ a\nNo differences (1 namespace(s), 1 table(s))\n"; buf.String() != want {
+		t.Errorf("writeDiff() = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var existsCmd = &cobra.Command{
+	Use:   "exists --from-file <paths.jsonl>",
+	Short: "Check whether many table buckets, namespaces and tables exist",
+	Long: `Check the existence of every path listed in a JSON lines file and report
+one JSON line per input line.
+
+Each input line is an object such as
+  {"bucket": "my-bucket", "namespace": "sales", "table": "orders"}
+where namespace and table are optional. Blank lines are skipped. Pass "-" to
+read the paths from stdin.
+
+Each output line repeats the path with its input line number and an "exists"
+field, or an "error" field when the line is malformed or the check failed.
+The command exits non-zero when any path is missing, unless --allow-missing
+is given, and whenever a check failed.
+
+Examples:
+  s3t exists --from-file paths.jsonl
+  s3t exists --from-file paths.jsonl --parallel 8 --allow-missing
+  cat paths.jsonl | s3t exists --from-file -`,
+	Args: cobra.NoArgs,
+	RunE: runExists,
+}
+
+var (
+	// existsFromFile is the JSON lines file of paths to check ("-" for stdin)
+	existsFromFile string
+	// existsParallel is the number of paths checked concurrently
+	existsParallel int
+	// existsAllowMissing exits successfully even when some paths do not exist
+	existsAllowMissing bool
+	// existsExistenceCheck selects Get or List calls for existence checks (auto, get, list)
+	existsExistenceCheck string
+
+	// existsStdin is where paths are read from when --from-file is "-"
+	existsStdin io.Reader = os.Stdin
+)
+
+func init() {
+	existsCmd.Flags().StringVar(&existsFromFile, "from-file", "", "JSON lines file of {bucket, namespace, table} paths to check (\"-\" for stdin)")
+	existsCmd.Flags().IntVar(&existsParallel, "parallel", 4, "Number of paths checked concurrently")
+	existsCmd.Flags().BoolVar(&existsAllowMissing, "allow-missing", false, "Exit successfully even when some paths do not exist")
+	existsCmd.Flags().StringVar(&existsExistenceCheck, "existence-check", "auto", "How namespace and table existence is checked: auto (Get, falling back to List when forbidden), get or list")
+	rootCmd.AddCommand(existsCmd)
+}
+
+// pathRecord is one input line of exists --from-file
+type pathRecord struct {
+	Bucket    string `json:"bucket"`
+	Namespace string `json:"namespace"`
+	Table     string `json:"table"`
+}
+
+// existsRecord is one output line of exists: the checked path and its existence
+type existsRecord struct {
+	Line      int    `json:"line"`
+	Bucket    string `json:"bucket"`
+	Namespace string `json:"namespace,omitempty"`
+	Table     string `json:"table,omitempty"`
+	Exists    bool   `json:"exists"`
+	Error     string `json:"error,omitempty"`
+}
+
+func runExists(cmd *cobra.Command, args []string) error {
+	if existsFromFile == "" {
+		return fmt.Errorf("--from-file is required")
+	}
+	if existsParallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1")
+	}
+	existenceCheck, err := s3tables.ParseExistenceCheck(existsExistenceCheck)
+	if err != nil {
+		return err
+	}
+
+	in := existsStdin
+	if existsFromFile != "-" {
+		f, err := os.Open(existsFromFile)
+		if err != nil {
+			return fmt.Errorf("failed to open paths file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+	checker := s3tables.NewS3TablesChecker(client)
+	checker.SetExistenceCheck(existenceCheck)
+
+	out, closePager := stdoutPaged()
+	defer closePager()
+	return checkPathsFrom(context.Background(), checker, in, out, existsParallel, existsAllowMissing)
+}
+
+// checkPathsFrom checks every path read from r and writes one existsRecord per
+// input line to w, in input order. The returned error reports failed checks and,
+// unless allowMissing is set, missing paths.
+func checkPathsFrom(ctx context.Context, checker *s3tables.S3TablesChecker, r io.Reader, w io.Writer, parallel int, allowMissing bool) error {
+	var records []existsRecord
+	var paths []s3tables.ResourcePath
+	// checked maps an index of paths to the index of its record
+	var checked []int
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var path pathRecord
+		dec := json.NewDecoder(bytes.NewReader([]byte(text)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&path); err != nil {
+			records = append(records, existsRecord{Line: line, Error: fmt.Sprintf("invalid path record: %v", err)})
+			continue
+		}
+		records = append(records, existsRecord{Line: line, Bucket: path.Bucket, Namespace: path.Namespace, Table: path.Table})
+		paths = append(paths, s3tables.ResourcePath{Bucket: path.Bucket, Namespace: path.Namespace, Table: path.Table})
+		checked = append(checked, len(records)-1)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read paths: %w", err)
+	}
+
+	for i, result := range checker.CheckPaths(ctx, paths, parallel) {
+		record := &records[checked[i]]
+		record.Exists = result.Exists
+		if result.Err != nil {
+			record.Error = result.Err.Error()
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	failed, missing := 0, 0
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+		switch {
+		case record.Error != "":
+			failed++
+		case !record.Exists:
+			missing++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d paths could not be checked", failed, len(records))
+	}
+	if missing > 0 && !allowMissing {
+		return &s3tables.S3TablesError{
+			Operation:   "exists",
+			Message:     fmt.Sprintf("%d of %d paths do not exist", missing, len(records)),
+			Suggestions: []string{"pass --allow-missing to only report missing paths"},
+			Type:        s3tables.ErrorTypeNotFound,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3tables "github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// newExistsMock returns a client with bucket-a holding sales/orders
+func newExistsMock() *mockS3TablesAPI {
+	return &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			var buckets []types.TableBucketSummary
+			if strings.HasPrefix("bucket-a", aws.ToString(params.Prefix)) {
+				buckets = append(buckets, types.TableBucketSummary{Name: aws.String("bucket-a"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-a")})
+			}
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: buckets}, nil
+		},
+		getTableFunc: func(ctx context.Context, params *awss3tables.GetTableInput, optFns ...func(*awss3tables.Options)) (*awss3tables.GetTableOutput, error) {
+			if aws.ToString(params.Namespace) == "sales" && aws.ToString(params.Name) == "orders" {
+				return &awss3tables.GetTableOutput{Name: params.Name}, nil
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+	}
+}
+
+// TestCheckPathsFrom tests the per-line output and the exit code for mixed existence
+func TestCheckPathsFrom(t *testing.T) {
+	input := strings.Join([]string{
+		`{"bucket":"bucket-a","namespace":"sales","table":"orders"}`,
+		``,
+		`{"bucket":"bucket-a","namespace":"sales","table":"refunds"}`,
+		`{"bucket":"bucket-a"}`,
+		`{"bucket":"bucket-z","namespace":"sales"}`,
+	}, "\n")
+
+	tests := []struct {
+		name         string
+		allowMissing bool
+		wantExit     int
+	}{
+		{name: "missing paths fail", wantExit: ExitCodeNotFound},
+		{name: "allow missing", allowMissing: true, wantExit: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			checker := s3tables.NewS3TablesChecker(newExistsMock())
+			err := checkPathsFrom(context.Background(), checker, strings.NewReader(input), &out, 2, tt.allowMissing)
+			if got := ExitCode(err); got != tt.wantExit {
+				t.Errorf("ExitCode() = %d (err %v), want %d", got, err, tt.wantExit)
+			}
+			if err != nil && !strings.Contains(err.Error(), "2 of 4 paths do not exist") {
+				t.Errorf("error = %v, want 2 of 4 missing", err)
+			}
+
+			var got []existsRecord
+			dec := json.NewDecoder(&out)
+			for dec.More() {
+				var record existsRecord
+				if err := dec.Decode(&record); err != nil {
+					t.Fatalf("output line is not JSON: %v", err)
+				}
+				got = append(got, record)
+			}
+			want := []existsRecord{
+				{Line: 1, Bucket: "bucket-a", Namespace: "sales", Table: "orders", Exists: true},
+				{Line: 3, Bucket: "bucket-a", Namespace: "sales", Table: "refunds"},
+				{Line: 4, Bucket: "bucket-a", Exists: true},
+				{Line: 5, Bucket: "bucket-z", Namespace: "sales"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("records = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+// TestCheckPathsFromInvalidLine tests that malformed lines are reported and fail the command
+func TestCheckPathsFromInvalidLine(t *testing.T) {
+	input := `{"bucket":"bucket-a"}` + "\n" + `{"bucket":"bucket-a","tabel":"orders"}` + "\n" + `{"table":"orders"}` + "\n"
+
+	var out bytes.Buffer
+	checker := s3tables.NewS3TablesChecker(newExistsMock())
+	err := checkPathsFrom(context.Background(), checker, strings.NewReader(input), &out, 1, true)
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 paths could not be checked") {
+		t.Errorf("checkPathsFrom() error = %v, want 2 of 3 failed", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], `"line":2`) || !strings.Contains(lines[1], "tabel") || !strings.Contains(lines[2], "table bucket is required") {
+		t.Errorf("output = %q, want the bad lines reported with their errors", out.String())
+	}
+}
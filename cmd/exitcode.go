@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"s3t/internal/s3tables"
+)
+
+// Process exit codes by failure category
+const (
+	ExitCodeUnknown     = 1
+	ExitCodeCredentials = 2
+	ExitCodeForbidden   = 3
+	ExitCodeNotFound    = 4
+	ExitCodeValidation  = 5
+	ExitCodeConflict    = 6
+	ExitCodeInternal    = 7
+	ExitCodeRetryable   = 8
+)
+
+// ExitCode maps a command error to a process exit code so that scripts can
+// react to specific failures. A nil error maps to 0.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var valErr *s3tables.ValidationError
+	if errors.As(err, &valErr) {
+		return ExitCodeValidation
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ExitCodeRetryable
+	}
+
+	switch s3tables.GetErrorType(err) {
+	case s3tables.ErrorTypeCredentials:
+		return ExitCodeCredentials
+	case s3tables.ErrorTypeForbidden:
+		return ExitCodeForbidden
+	case s3tables.ErrorTypeNotFound:
+		return ExitCodeNotFound
+	case s3tables.ErrorTypeBadRequest:
+		return ExitCodeValidation
+	case s3tables.ErrorTypeConflict:
+		return ExitCodeConflict
+	case s3tables.ErrorTypeInternalServer:
+		return ExitCodeInternal
+	case s3tables.ErrorTypeThrottling, s3tables.ErrorTypeTimeout:
+		return ExitCodeRetryable
+	default:
+		return ExitCodeUnknown
+	}
+}
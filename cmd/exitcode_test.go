@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"s3t/internal/s3tables"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, 0},
+		{"plain error", errors.New("boom"), ExitCodeUnknown},
+		{"not found", &s3tables.S3TablesError{Type: s3tables.ErrorTypeNotFound}, ExitCodeNotFound},
+		{"forbidden", &s3tables.S3TablesError{Type: s3tables.ErrorTypeForbidden}, ExitCodeForbidden},
+		{"credentials", &s3tables.S3TablesError{Type: s3tables.ErrorTypeCredentials}, ExitCodeCredentials},
+		{"bad request", &s3tables.S3TablesError{Type: s3tables.ErrorTypeBadRequest}, ExitCodeValidation},
+		{"conflict", &s3tables.S3TablesError{Type: s3tables.ErrorTypeConflict}, ExitCodeConflict},
+		{"internal", &s3tables.S3TablesError{Type: s3tables.ErrorTypeInternalServer}, ExitCodeInternal},
+		{"throttling", &s3tables.S3TablesError{Type: s3tables.ErrorTypeThrottling}, ExitCodeRetryable},
+		{"timeout", &s3tables.S3TablesError{Type: s3tables.ErrorTypeTimeout}, ExitCodeRetryable},
+		{"deadline exceeded", fmt.Errorf("wait: %w", context.DeadlineExceeded), ExitCodeRetryable},
+		{"unknown type", &s3tables.S3TablesError{Type: s3tables.ErrorTypeUnknown}, ExitCodeUnknown},
+		{"validation", fmt.Errorf("validation error: %w", &s3tables.ValidationError{Field: "table", Message: "too long"}), ExitCodeValidation},
+		{"wrapped not found", fmt.Errorf("lookup: %w", &s3tables.S3TablesError{Type: s3tables.ErrorTypeNotFound}), ExitCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
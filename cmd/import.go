@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <spec-file>",
+	Short: "Create table buckets, namespaces and tables from a spec file",
+	Long: `Create every table bucket, namespace and table described in a spec file.
+Existing resources are detected and skipped, as with create.
+
+Supported formats (detected from the file extension unless --input-format is given):
+  yaml  A list of {table_bucket, namespaces: [{name, tables: [...]}]}
+  json  The same structure as a JSON array
+  csv   Rows with the header table_bucket,namespace,table
+        (leave table empty to create only the namespace)
+
+With --repair, only gaps below existing table buckets are filled: missing
+namespaces and tables are created, and a report lists what was present and
+what was missing before the repair. Table buckets are never created.
+
+With --parallel N, entries for up to N different table buckets are created
+concurrently. Entries that share a table bucket still run one after another,
+so the bucket is never created twice. Results are printed in file order.
+After an entry fails no further entry is started; with --continue-on-error
+the entries of other table buckets are still created.
+
+With --validate-only, the spec file is parsed and every name is validated as
+with create; namespaces and tables listed more than once are reported too.
+All problems are listed and no AWS API is called.
+
+Examples:
+  s3t import hierarchy.yaml
+  s3t import hierarchy.yaml --validate-only
+  s3t import hierarchy.yaml --parallel 4
+  s3t import hierarchy.yaml --repair
+  s3t import tables.txt --input-format csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+var (
+	// importInputFormat overrides the spec file format detected from the extension
+	importInputFormat string
+	// importRepair creates only the missing children of existing table buckets and reports the gaps
+	importRepair bool
+	// importValidateOnly checks the spec file without making any API calls
+	importValidateOnly bool
+	// importParallel is the number of table buckets processed concurrently
+	importParallel int
+	// importExistenceCheck selects Get or List calls for existence checks (auto, get, list)
+	importExistenceCheck string
+	// importContinueOnError keeps creating the entries of other table buckets after an entry fails
+	importContinueOnError bool
+)
+
+func init() {
+	importCmd.Flags().StringVar(&importInputFormat, "input-format", "", "Spec file format: yaml, json or csv (default: from file extension)")
+	importCmd.Flags().BoolVar(&importRepair, "repair", false, "Only create missing namespaces and tables below existing table buckets and report the gaps")
+	importCmd.Flags().IntVar(&importParallel, "parallel", 1, "Number of table buckets to process concurrently (entries of one bucket always run in order)")
+	importCmd.Flags().BoolVar(&importContinueOnError, "continue-on-error", false, "Keep creating the entries of other table buckets after an entry fails")
+	importCmd.Flags().StringVar(&importExistenceCheck, "existence-check", "auto", "How namespace and table existence is checked: auto (Get, falling back to List when forbidden), get or list")
+	importCmd.Flags().BoolVar(&importValidateOnly, "validate-only", false, "Validate every entry of the spec file and report all problems without calling AWS")
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	if importParallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1")
+	}
+	existenceCheck, err := s3tables.ParseExistenceCheck(importExistenceCheck)
+	if err != nil {
+		return err
+	}
+
+	var format s3tables.InputFormat
+	if importInputFormat != "" {
+		format, err = s3tables.ParseInputFormat(importInputFormat)
+		if err != nil {
+			return err
+		}
+	}
+
+	specs, err := s3tables.LoadSpecFile(args[0], format)
+	if err != nil {
+		return err
+	}
+
+	if importValidateOnly {
+		return reportSpecProblems(stdout(), args[0], s3tables.ValidateSpecs(specs))
+	}
+
+	if err := validateSpecs(specs); err != nil {
+		return err
+	}
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	ctx := context.Background()
+	creator := s3tables.NewS3TablesCreator(client)
+	creator.SetWarningOutput(stderr())
+	audit, closeAudit := openAuditLogger()
+	defer closeAudit()
+	creator.SetAuditLogger(audit)
+	creator.SetExpectedRegion(clientRegion)
+	creator.SetExistenceCheck(existenceCheck)
+
+	if importRepair {
+		for _, spec := range specs {
+			report, err := creator.RepairHierarchy(ctx, spec)
+			if err != nil {
+				return err
+			}
+			printRepairReport(stdout(), report)
+		}
+		return nil
+	}
+
+	creator.SetProgressFunc(progressPrinter(stderr()))
+	results, err := creator.CreateBatch(ctx, specs, importParallel, importContinueOnError)
+	for _, entry := range results {
+		if entry.Result != nil {
+			printResult(stdout(), stderr(), entry.Result)
+		}
+		if entry.NotRun() {
+			fmt.Fprintf(stderr(), "Warning: skipped %s/%s after an earlier failure\n", entry.TableBucket, entry.Namespace)
+		}
+	}
+	return err
+}
+
+// progressPrinter returns a progress consumer that prints one line per event to w
+func progressPrinter(w io.Writer) func(s3tables.ProgressEvent) {
+	return func(event s3tables.ProgressEvent) {
+		prefix := fmt.Sprintf("[%d/%d]", event.Entry, event.Total)
+		switch event.Kind {
+		case s3tables.ProgressStarted:
+			fmt.Fprintf(w, "%s %s/%s: started\n", prefix, event.TableBucket, event.Namespace)
+		case s3tables.ProgressCreated:
+			fmt.Fprintf(w, "%s created %s '%s'\n", prefix, event.Resource, event.Name)
+		case s3tables.ProgressFailed:
+			fmt.Fprintf(w, "%s %s/%s: failed: %v\n", prefix, event.TableBucket, event.Namespace, event.Err)
+		}
+	}
+}
+
+// reportSpecProblems lists the problems of a spec file, returning an error when there are any
+func reportSpecProblems(w io.Writer, path string, problems []error) error {
+	if len(problems) == 0 {
+		fmt.Fprintf(w, "%s: OK\n", path)
+		return nil
+	}
+	for _, problem := range problems {
+		fmt.Fprintf(w, "%s: %v\n", path, problem)
+	}
+	return fmt.Errorf("%s has %d problem(s)", path, len(problems))
+}
+
+// printRepairReport prints the present and filled resources of a repaired table bucket
+func printRepairReport(w io.Writer, report *s3tables.RepairReport) {
+	fmt.Fprintf(w, "\n=== Repair of Table Bucket '%s' ===\n\n", report.TableBucket)
+	for _, item := range report.Items {
+		status := "present"
+		if item.Created {
+			status = "created"
+		}
+		fmt.Fprintf(w, "  %-8s  %-9s  %s\n", status, item.Kind, item.Name)
+	}
+	gaps := report.Gaps()
+	fmt.Fprintf(w, "\nFilled %d gap(s), %d resource(s) already present\n", len(gaps), len(report.Items)-len(gaps))
+}
+
+// validateSpecs validates every bucket, namespace and table name in specs
+func validateSpecs(specs []s3tables.HierarchySpec) error {
+	for _, spec := range specs {
+		if err := s3tables.ValidateTableBucket(spec.TableBucket); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+		for _, ns := range spec.Namespaces {
+			if err := s3tables.ValidateNamespace(ns.Name); err != nil {
+				return fmt.Errorf("validation error: %w", err)
+			}
+			if err := validateCreateArgs(spec.TableBucket, ns.Name, ns.Tables); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
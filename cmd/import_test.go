@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunImportValidateOnly tests that --validate-only reports every problem without an S3 Tables client
+func TestRunImportValidateOnly(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name:    "all problems reported",
+			spec:    "table_bucket,namespace,table\nBad_Bucket,sales,orders\ngood-bucket,Sales,orders\ngood-bucket,ops,events\ngood-bucket,ops,events\n",
+			wantErr: "has 3 problem(s)",
+		},
+		{
+			name: "valid spec",
+			spec: "table_bucket,namespace,table\ngood-bucket,sales,orders\ngood-bucket,sales,customers\n",
+		},
+	}
+
+	savedClient, savedValidate := getS3TablesClient(), importValidateOnly
+	t.Cleanup(func() {
+		SetS3TablesClient(savedClient)
+		importValidateOnly = savedValidate
+	})
+	SetS3TablesClient(nil)
+	importValidateOnly = true
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "spec.csv")
+			if err := os.WriteFile(path, []byte(tt.spec), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			err := runImport(importCmd, []string{path})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("runImport() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runImport() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
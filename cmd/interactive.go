@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+// ciEnvVars are environment variables set by common CI systems
+var ciEnvVars = []string{
+	"CI",
+	"GITHUB_ACTIONS",
+	"GITLAB_CI",
+	"CIRCLECI",
+	"BUILDKITE",
+	"JENKINS_URL",
+	"TF_BUILD",
+	"TEAMCITY_VERSION",
+	"CODEBUILD_BUILD_ID",
+}
+
+// errNonInteractive is returned when a prompt would be shown while interactivity is disabled
+var errNonInteractive = errors.New("a prompt is required but interactivity is disabled (CI environment detected or --interactive=false); pass the value explicitly or use --interactive to allow prompts")
+
+// isCIEnvironment reports whether any CI variable is set.
+// "false" and "0" count as unset so that CI=false re-enables prompts.
+func isCIEnvironment(getenv func(string) string) bool {
+	for _, name := range ciEnvVars {
+		switch strings.ToLower(getenv(name)) {
+		case "", "false", "0":
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// resolveInteractive defaults --interactive to false in CI unless it was set explicitly
+func resolveInteractive(cmd *cobra.Command, getenv func(string) string) {
+	if flag := cmd.Flags().Lookup("interactive"); flag != nil && flag.Changed {
+		return
+	}
+	interactive = !isCIEnvironment(getenv)
+}
+
+// nonInteractiveSelector fails every selection instead of prompting
+type nonInteractiveSelector struct{}
+
+func (nonInteractiveSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+	return &s3tables.SelectionResult{Action: s3tables.ActionExit}, errNonInteractive
+}
+
+// newSelector returns the prompt selector, or one that fails when interactivity is disabled
+func newSelector() s3tables.InteractiveSelector {
+	if !interactive {
+		return nonInteractiveSelector{}
+	}
+	selector := s3tables.NewFilterablePromptSelector()
+	selector.SetPromptTimeout(promptTimeout)
+	selector.SetGlyphs(promptGlyphs)
+	if commandTimings != nil {
+		return s3tables.NewTimedSelector(selector, commandTimings)
+	}
+	return selector
+}
+
+// glyphsAuto is the --glyphs value that picks the glyph set from --ascii and the locale
+const glyphsAuto = "auto"
+
+// resolveGlyphs returns the prompt glyph set for the --glyphs value. In auto mode
+// ASCII is used when --ascii is set, TERM is "dumb", or the locale (LC_ALL,
+// LC_CTYPE, then LANG) names a non-UTF-8 encoding; an unset locale keeps Unicode.
+func resolveGlyphs(spec string, ascii bool, getenv func(string) string) (s3tables.Glyphs, error) {
+	if !strings.EqualFold(spec, glyphsAuto) {
+		return s3tables.ParseGlyphs(spec)
+	}
+	if ascii || getenv("TERM") == "dumb" {
+		return s3tables.GlyphsASCII, nil
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		locale := strings.ToUpper(getenv(name))
+		if locale == "" {
+			continue
+		}
+		if strings.Contains(locale, "UTF-8") || strings.Contains(locale, "UTF8") {
+			return s3tables.GlyphsUnicode, nil
+		}
+		return s3tables.GlyphsASCII, nil
+	}
+	return s3tables.GlyphsUnicode, nil
+}
+
+// inputFunc returns the text prompt, or one that fails when interactivity is disabled
+func inputFunc() func(ctx context.Context, label string) (string, error) {
+	if !interactive {
+		return func(context.Context, string) (string, error) { return "", errNonInteractive }
+	}
+	if commandTimings != nil {
+		return s3tables.TimedInput(s3tables.Input, commandTimings)
+	}
+	return s3tables.Input
+}
+
+// confirmFunc returns the confirmation prompt, or one that fails when interactivity is disabled
+func confirmFunc() func(ctx context.Context, label string) (bool, error) {
+	if !interactive {
+		return func(context.Context, string) (bool, error) { return false, errNonInteractive }
+	}
+	if commandTimings != nil {
+		return s3tables.TimedConfirm(s3tables.Confirm, commandTimings)
+	}
+	return s3tables.Confirm
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+// TestResolveGlyphs tests explicit glyph sets and the auto fallback to ASCII
+func TestResolveGlyphs(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		ascii   bool
+		env     map[string]string
+		want    s3tables.Glyphs
+		wantErr bool
+	}{
+		{name: "explicit unicode", spec: "unicode", ascii: true, env: map[string]string{"LANG": "C"}, want: s3tables.GlyphsUnicode},
+		{name: "explicit ascii", spec: "ascii", env: map[string]string{"LANG": "en_US.UTF-8"}, want: s3tables.GlyphsASCII},
+		{name: "invalid", spec: "emoji", wantErr: true},
+		{name: "auto UTF-8 locale", spec: "auto", env: map[string]string{"LANG": "ja_JP.UTF-8"}, want: s3tables.GlyphsUnicode},
+		{name: "auto unset locale", spec: "auto", env: map[string]string{}, want: s3tables.GlyphsUnicode},
+		{name: "auto with --ascii", spec: "auto", ascii: true, env: map[string]string{"LANG": "en_US.UTF-8"}, want: s3tables.GlyphsASCII},
+		{name: "auto C locale", spec: "auto", env: map[string]string{"LANG": "C"}, want: s3tables.GlyphsASCII},
+		{name: "auto LC_ALL overrides LANG", spec: "auto", env: map[string]string{"LC_ALL": "POSIX", "LANG": "en_US.utf8"}, want: s3tables.GlyphsASCII},
+		{name: "auto dumb terminal", spec: "auto", env: map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"}, want: s3tables.GlyphsASCII},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveGlyphs(tt.spec, tt.ascii, func(key string) string { return tt.env[key] })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveGlyphs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("resolveGlyphs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsCIEnvironment tests CI detection from environment variables
+func TestIsCIEnvironment(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "no CI variables", env: map[string]string{}, want: false},
+		{name: "CI=true", env: map[string]string{"CI": "true"}, want: true},
+		{name: "GITHUB_ACTIONS", env: map[string]string{"GITHUB_ACTIONS": "true"}, want: true},
+		{name: "JENKINS_URL", env: map[string]string{"JENKINS_URL": "https://jenkins.example.com"}, want: true},
+		{name: "CI=false", env: map[string]string{"CI": "false"}, want: false},
+		{name: "CI=0", env: map[string]string{"CI": "0"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(name string) string { return tt.env[name] }
+			if got := isCIEnvironment(getenv); got != tt.want {
+				t.Errorf("isCIEnvironment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolveInteractive tests the --interactive default in and outside CI and the flag override
+func TestResolveInteractive(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		want bool
+	}{
+		{name: "terminal session", env: map[string]string{}, want: true},
+		{name: "CI disables prompts", env: map[string]string{"CI": "true"}, want: false},
+		{name: "flag re-enables prompts in CI", env: map[string]string{"GITHUB_ACTIONS": "true"}, args: []string{"--interactive"}, want: true},
+		{name: "flag disables prompts outside CI", env: map[string]string{}, args: []string{"--interactive=false"}, want: false},
+	}
+
+	saved := interactive
+	t.Cleanup(func() { interactive = saved })
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			cmd.Flags().BoolVar(&interactive, "interactive", true, "")
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			resolveInteractive(cmd, func(name string) string { return tt.env[name] })
+			if interactive != tt.want {
+				t.Errorf("interactive = %v, want %v", interactive, tt.want)
+			}
+		})
+	}
+}
+
+// TestNonInteractivePrompts tests that prompts fail clearly when interactivity is disabled
+func TestNonInteractivePrompts(t *testing.T) {
+	saved := interactive
+	t.Cleanup(func() { interactive = saved })
+	interactive = false
+
+	if _, err := newSelector().SelectWithFilter(context.Background(), "Select", []string{"a"}, false); !errors.Is(err, errNonInteractive) {
+		t.Errorf("SelectWithFilter() error = %v, want errNonInteractive", err)
+	}
+	if _, err := confirmFunc()(context.Background(), "Delete?"); !errors.Is(err, errNonInteractive) {
+		t.Errorf("confirm error = %v, want errNonInteractive", err)
+	}
+	if _, err := inputFunc()(context.Background(), "table prefix"); !errors.Is(err, errNonInteractive) {
+		t.Errorf("input error = %v, want errNonInteractive", err)
+	}
+}
@@ -2,7 +2,14 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
 
 	"s3t/internal/s3tables"
 
@@ -20,10 +27,22 @@ Arguments are optional:
   - table-bucket namespace: List tables in the specified namespace
   - table-bucket namespace table: Show table details
 
+The same scopes can be given with flags instead of positional arguments:
+  - --buckets: List table buckets
+  - --bucket <name> --namespaces: List namespaces in the bucket
+  - --bucket <name> --namespace <ns> --tables: List tables in the namespace
+  - --bucket <name> --namespace <ns> --table <table>: Show table details
+Without a scope flag, the deepest of --bucket/--namespace/--table decides.
+
+If the table bucket has no exact match but other bucket names start with it,
+you are asked to pick one of them.
+
 Interactive Features:
   - Type to filter: Press "/" then type to filter resources in real-time
   - .. (Back): Select this option to go back to previous level
-  - Ctrl+C: Exit the application
+  - … (Load more): Fetch the next page (with --paged or --after-token)
+  - Ctrl+C: Exit the application (with --no-back-option, go back one level
+    from namespaces and tables instead; the back entry is then hidden)
   - Enter: Select the highlighted resource
 
 Examples:
@@ -37,76 +56,760 @@ Examples:
   s3t list my-bucket my-namespace
 
   # Show details of a specific table
-  s3t list my-bucket my-namespace my-table`,
-	Args: cobra.MaximumNArgs(3),
-	RunE: runList,
+  s3t list my-bucket my-namespace my-table
+
+  # Show table details on a single line (for logs)
+  s3t list my-bucket my-namespace my-table --compact
+
+  # List table buckets in every region where S3 Tables is available
+  s3t list --all-regions
+
+  # Shorten long ARNs in the output
+  s3t list --all-regions --truncate-arn
+
+  # List every table with ARN, type and timestamps (the default shows name and namespace)
+  s3t list my-bucket --all-tables -o wide
+
+  # Report table names that are used in more than one namespace
+  s3t list my-bucket --all-tables --report-collisions
+
+  # Export every table in a bucket as CSV
+  s3t list my-bucket --all-tables -o csv --columns name,namespace,arn
+
+  # Count the tables in a bucket, or in every bucket
+  s3t list my-bucket --recursive --count
+  s3t list --recursive --count --verbose
+  s3t list my-bucket --recursive --count --verbose --namespace-prefix sales --table-prefix order
+
+  # Print only the number of tables per namespace and the total
+  s3t list my-bucket --recursive --summary-only
+  s3t list my-bucket --recursive --summary-only -o json
+
+  # Report how many pages and items were fetched
+  s3t list my-bucket --all-tables --stats
+
+  # Fetch huge buckets one page at a time, resuming from a continuation token
+  s3t list my-bucket --paged
+  s3t list my-bucket --after-token <token>
+
+  # Print names for scripts: buckets (with ARNs), namespaces, or tables
+  s3t list --plain --include-arns
+  s3t list my-bucket --plain
+  s3t list my-bucket my-namespace --plain --include-arns
+
+When stdout is not a terminal (e.g. piped to another command), --plain is the
+default for the bucket, namespace and table levels; pass --plain=false to
+navigate anyway.
+
+  # Jump straight to a table (or to the namespaces of a bucket) by ARN
+  s3t list --goto-arn arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/<table-id>
+
+  # Print a random sample of up to 20 tables of a namespace (reproducible with --seed)
+  s3t list my-bucket my-namespace --sample 20
+
+  # Navigate non-interactively by selecting named items at each level
+  s3t list --bucket-select my-bucket --namespace-select my-namespace --table-select my-table`,
+	Args:              cobra.MaximumNArgs(3),
+	ValidArgsFunction: completeResourceArgs,
+	RunE:              runList,
 }
 
+var (
+	// listCompact prints table details as a single key=value line
+	listCompact bool
+	// listAllRegions lists table buckets across all S3 Tables regions
+	listAllRegions bool
+	// listBucketSelect, listNamespaceSelect and listTableSelect auto-select items by name
+	listBucketSelect    string
+	listNamespaceSelect string
+	listTableSelect     string
+	// listTruncateARN shortens ARNs in human-readable output
+	listTruncateARN bool
+	// listAllTables lists every table in the bucket without interactive navigation
+	listAllTables bool
+	// listOutput is the output format for non-interactive listings
+	listOutput string
+	// listColumns selects the columns for non-interactive listings
+	listColumns string
+	// listRecursive and listCount report the total number of tables below the given scope
+	listRecursive bool
+	listCount     bool
+	// listVerbose prints a per-namespace breakdown together with the count
+	listVerbose bool
+	// listSummaryOnly prints only per-namespace table counts and the total of a recursive walk
+	listSummaryOnly bool
+	// listNamespacePrefix limits --recursive --count to namespaces starting with the prefix
+	listNamespacePrefix string
+	// listPlain prints the names at the requested level, one per line, without prompting
+	listPlain bool
+	// listIncludeARNs adds a tab-separated ARN to each --plain line
+	listIncludeARNs bool
+	// listNoBackOption hides ".. (Back)" at sub-levels; ESC goes back instead
+	listNoBackOption bool
+	// listStats prints the number of pages and items fetched to stderr
+	listStats bool
+	// listTablePrefix limits --recursive --count to tables starting with the prefix
+	listTablePrefix string
+	// listMaxItemsPerLevel asks for a prefix when a level has more items than this
+	listMaxItemsPerLevel int
+	// listShowLocation adds the warehouse location to table details
+	listShowLocation bool
+	// listAutoSingle skips the interactive prompt at levels with exactly one item
+	listAutoSingle bool
+	// listSample prints a random sample of up to this many tables instead of all of them
+	listSample int
+	// listShowAge annotates interactive namespace and table items with their relative age
+	listShowAge bool
+	// listPaged fetches one page per level and offers "Load more" for the rest
+	listPaged bool
+	// listAfterToken resumes the starting level from a continuation token (implies --paged)
+	listAfterToken string
+	// listReverse lists namespaces in reverse alphabetical order
+	listReverse bool
+	// listBucketType restricts table bucket listings to one bucket type
+	listBucketType string
+	// listContinueOnError skips failing sub-fetches with a warning instead of aborting
+	listContinueOnError bool
+	// listReportCollisions reports table names shared by several namespaces (--all-tables)
+	listReportCollisions bool
+	// listScopeBucket, listScopeNamespace and listScopeTable name the resources
+	// for flag-based scope selection (alternative to positional arguments)
+	listScopeBucket    string
+	listScopeNamespace string
+	listScopeTable     string
+	// listScopeBuckets, listScopeNamespaces and listScopeTables select the level to list
+	listScopeBuckets    bool
+	listScopeNamespaces bool
+	listScopeTables     bool
+
+	// listGotoARN jumps to the table bucket or table with this ARN
+	listGotoARN string
+)
+
 func init() {
+	listCmd.Flags().BoolVar(&listCompact, "compact", false, "Print table details on a single line")
+	listCmd.Flags().StringVar(&listBucketSelect, "bucket-select", "", "Select the named table bucket without prompting")
+	listCmd.Flags().StringVar(&listNamespaceSelect, "namespace-select", "", "Select the named namespace without prompting")
+	listCmd.Flags().StringVar(&listTableSelect, "table-select", "", "Select the named table without prompting")
+	listCmd.Flags().BoolVar(&listAllRegions, "all-regions", false, "List table buckets in every region where S3 Tables is available")
+	listCmd.Flags().BoolVar(&listTruncateARN, "truncate-arn", false, "Shorten long ARNs in table details and region listings")
+	listCmd.Flags().BoolVar(&listAllTables, "all-tables", false, "List every table in the bucket across all namespaces")
+	listCmd.Flags().StringVarP(&listOutput, "output", "o", outputFormatTable, "Output format for --all-tables (table, wide or csv) and --summary-only (table or json)")
+	listCmd.Flags().StringVar(&listColumns, "columns", "", "Comma-separated columns for --all-tables (name,namespace,arn,type,created,modified)")
+	listCmd.Flags().BoolVar(&listContinueOnError, "continue-on-error", false, "Skip namespaces (and table buckets) that fail to list with a warning instead of aborting (--all-tables, --recursive --count)")
+	listCmd.Flags().BoolVar(&listReportCollisions, "report-collisions", false, "Report table names used in more than one namespace to stderr (--all-tables)")
+	listCmd.Flags().BoolVar(&listRecursive, "recursive", false, "Walk every namespace (and every bucket when none is given); use with --count")
+	listCmd.Flags().BoolVar(&listCount, "count", false, "Print the total number of tables; use with --recursive")
+	listCmd.Flags().BoolVar(&listSummaryOnly, "summary-only", false, "Print only the number of tables per namespace and the total; use with --recursive")
+	listCmd.Flags().BoolVar(&listVerbose, "verbose", false, "Print a per-namespace breakdown with --count")
+	listCmd.Flags().BoolVar(&listPlain, "plain", false, "Print names one per line without interactive navigation")
+	listCmd.Flags().BoolVar(&listIncludeARNs, "include-arns", false, "Append a tab and the ARN to each --plain line (table buckets and tables)")
+	listCmd.Flags().BoolVar(&listNoBackOption, "no-back-option", false, "Hide the '.. (Back)' entry; press ESC to go back")
+	listCmd.Flags().BoolVar(&listStats, "stats", false, "Print the number of pages and items fetched per API to stderr")
+	listCmd.Flags().StringVar(&listNamespacePrefix, "namespace-prefix", "", "Only count namespaces starting with this prefix (with --recursive --count)")
+	listCmd.Flags().StringVar(&listTablePrefix, "table-prefix", "", "Only count tables starting with this prefix (with --recursive --count)")
+	listCmd.Flags().StringVar(&listBucketType, "bucket-type", "", "Only list table buckets of this type (customer or aws)")
+	listCmd.Flags().IntVar(&listMaxItemsPerLevel, "max-items-per-level", 0, "Ask for a prefix before showing more than this many namespaces or tables (0 = no limit)")
+	listCmd.Flags().BoolVar(&listReverse, "reverse", false, "List namespaces in reverse alphabetical order")
+	listCmd.Flags().BoolVar(&listPaged, "paged", false, "Fetch one page at a time and load more on demand")
+	listCmd.Flags().StringVar(&listAfterToken, "after-token", "", "Resume the starting level from this continuation token (implies --paged)")
+	listCmd.Flags().StringVar(&listGotoARN, "goto-arn", "", "Jump to the table bucket or table with this ARN")
+	listCmd.Flags().StringVar(&listScopeBucket, "bucket", "", "Table bucket to list in (instead of the first argument)")
+	listCmd.Flags().StringVar(&listScopeNamespace, "namespace", "", "Namespace to list in (instead of the second argument; requires --bucket)")
+	listCmd.Flags().StringVar(&listScopeTable, "table", "", "Table to show (instead of the third argument; requires --bucket and --namespace)")
+	listCmd.Flags().BoolVar(&listScopeBuckets, "buckets", false, "List table buckets")
+	listCmd.Flags().BoolVar(&listScopeNamespaces, "namespaces", false, "List the namespaces of --bucket")
+	listCmd.Flags().BoolVar(&listScopeTables, "tables", false, "List the tables of --bucket and --namespace")
+	listCmd.Flags().BoolVar(&listShowLocation, "show-location", false, "Show the warehouse location in table details")
+	listCmd.Flags().BoolVar(&listAutoSingle, "auto-single", false, "Select the only item of a level without prompting (going back still prompts)")
+	listCmd.Flags().IntVar(&listSample, "sample", 0, "Print a random sample of up to N tables of a namespace, one per line (reproducible with --seed)")
+	listCmd.Flags().BoolVar(&listShowAge, "show-age", false, "Annotate interactive namespace and table items with their age, e.g. \"orders (3d ago)\"")
 	rootCmd.AddCommand(listCmd)
 }
 
 func runList(cmd *cobra.Command, args []string) error {
+	args, err := resolveListScope(args)
+	if err != nil {
+		return err
+	}
+
+	// newListLister applies --bucket-type to the lister of every region listed
+	newListLister := newLister
+	if listBucketType != "" {
+		bucketType, err := s3tables.ParseBucketType(listBucketType)
+		if err != nil {
+			return err
+		}
+		newListLister = func(client s3tables.S3TablesAPI) *s3tables.S3TablesLister {
+			lister := newLister(client)
+			lister.SetBucketType(bucketType)
+			return lister
+		}
+	}
+
+	if listAllRegions {
+		if len(args) > 0 {
+			return fmt.Errorf("--all-regions cannot be combined with positional arguments")
+		}
+		return listAllRegionBuckets(context.Background(), s3tables.S3TablesRegions, newRegionalClient, newListLister)
+	}
+
 	client := getS3TablesClient()
 	if client == nil {
 		return fmt.Errorf("S3 Tables client not initialized")
 	}
 
 	ctx := context.Background()
-	lister := s3tables.NewS3TablesLister(client)
-	selector := s3tables.NewFilterablePromptSelector()
+	lister := newListLister(client)
+	if listStats {
+		stats := &listStatsCollector{}
+		lister.SetStatsFunc(stats.add)
+		defer stats.print(stderr())
+	}
+
+	if (listNamespacePrefix != "" || listTablePrefix != "") && !(listRecursive && listCount) {
+		return fmt.Errorf("--namespace-prefix and --table-prefix require --recursive --count")
+	}
+
+	if listSummaryOnly {
+		if !listRecursive || listCount {
+			return fmt.Errorf("--summary-only requires --recursive and cannot be combined with --count")
+		}
+		if len(args) != 1 {
+			return fmt.Errorf("--recursive --summary-only requires exactly one table bucket argument")
+		}
+		return summarizeNamespaces(ctx, lister, args[0])
+	}
+
+	if listRecursive || listCount {
+		if !listRecursive || !listCount {
+			return fmt.Errorf("--recursive and --count must be used together")
+		}
+		if len(args) > 1 {
+			return fmt.Errorf("--recursive --count accepts at most one table bucket argument")
+		}
+		return countTables(ctx, lister, args)
+	}
+
+	if cmd.Flags().Changed("sample") {
+		if listSample < 1 {
+			return fmt.Errorf("--sample must be at least 1")
+		}
+		if len(args) != 2 {
+			return fmt.Errorf("--sample requires exactly two arguments (table-bucket namespace)")
+		}
+		return listSampleTables(ctx, stdout(), lister, args[0], args[1], listSample)
+	}
+
+	plain := usePlainListing(cmd, terminal(), len(args))
+	if listIncludeARNs && !plain {
+		return fmt.Errorf("--include-arns requires --plain")
+	}
+	if plain {
+		return listPlainNames(ctx, lister, args)
+	}
+
+	if listReportCollisions && !listAllTables {
+		return fmt.Errorf("--report-collisions requires --all-tables")
+	}
+	if listAllTables {
+		if len(args) != 1 {
+			return fmt.Errorf("--all-tables requires exactly one table bucket argument")
+		}
+		return listAllBucketTables(ctx, lister, args[0])
+	}
+
+	// A table argument shows its details, so there is no listing for the token to resume
+	if listAfterToken != "" && len(args) == 3 {
+		return fmt.Errorf("--after-token accepts at most two arguments (table-bucket namespace)")
+	}
+
+	selector := newSelector()
 	controller := s3tables.NewNavigationController(lister, selector)
+	controller.SetCompactDetails(listCompact)
+	controller.SetARNMaxLen(arnDisplayWidth())
+	controller.SetMaxItemsPerLevel(listMaxItemsPerLevel)
+	controller.SetInputFunc(inputFunc())
+	controller.SetReverseSort(listReverse)
+	controller.SetShowLocation(listShowLocation)
+	controller.SetShowAge(listShowAge)
+	controller.SetAutoSingle(listAutoSingle)
+	controller.SetLevelLabels(loadedConfig.Labels.levelLabels())
+	controller.SetRedactARNs(redactOutput)
+	controller.SetWarningOutput(stderr())
+	controller.SetNoBackOption(listNoBackOption)
+	controller.SetPaged(listPaged)
+	// The starting level (LevelTableBucket, LevelNamespace, LevelTable) follows the argument count
+	if listAfterToken != "" {
+		controller.SetAfterToken(s3tables.NavigationLevel(len(args)), listAfterToken)
+	}
+	applySelectTargets(controller)
+
+	if listGotoARN != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("--goto-arn cannot be combined with positional arguments")
+		}
+		controller.SetExpectedLocation(clientRegion, currentAccountID())
+		return controller.NavigateTo(ctx, listGotoARN)
+	}
 
 	switch len(args) {
 	case 0:
 		// Start from Table Bucket level
 		return controller.Navigate(ctx, s3tables.LevelTableBucket)
+	case 1, 2:
+		// Start from Namespace level with specified bucket, or from Table level
+		// with specified bucket and namespace
+		bucketName, bucketARN, err := resolveBucket(ctx, lister, selector, args[0])
+		if err != nil {
+			return err
+		}
+		path := s3tables.ResourcePath{Bucket: bucketName, BucketARN: bucketARN}
+		if len(args) == 2 {
+			path.Namespace = args[1]
+		}
+		controller.SetInitialState(path)
+		return controller.Navigate(ctx, path.Level())
+	case 3:
+		// Show table details directly
+		table, err := showTableDetails(ctx, lister, s3tables.ResourcePath{Bucket: args[0], Namespace: args[1], Table: args[2]})
+		if err != nil {
+			return err
+		}
+		printTableDetails(stdout(), table)
+		return nil
+	default:
+		return fmt.Errorf("too many arguments")
+	}
+}
+
+// resolveListScope turns the scope flags into the equivalent positional
+// arguments, whose count selects the starting level: none for table buckets,
+// the bucket for namespaces, bucket and namespace for tables, and all three
+// for table details
+func resolveListScope(args []string) ([]string, error) {
+	named := listScopeBucket != "" || listScopeNamespace != "" || listScopeTable != ""
+	scopes := 0
+	for _, set := range []bool{listScopeBuckets, listScopeNamespaces, listScopeTables} {
+		if set {
+			scopes++
+		}
+	}
+	if !named && scopes == 0 {
+		return args, nil
+	}
+	if len(args) > 0 {
+		return nil, fmt.Errorf("--bucket, --namespace, --table and the scope flags cannot be combined with positional arguments")
+	}
+	if scopes > 1 {
+		return nil, fmt.Errorf("--buckets, --namespaces and --tables cannot be used together")
+	}
+	if listScopeNamespace != "" && listScopeBucket == "" {
+		return nil, fmt.Errorf("--namespace requires --bucket")
+	}
+	if listScopeTable != "" && listScopeNamespace == "" {
+		return nil, fmt.Errorf("--table requires --bucket and --namespace")
+	}
+
+	var scoped []string
+	for _, name := range []string{listScopeBucket, listScopeNamespace, listScopeTable} {
+		if name != "" {
+			scoped = append(scoped, name)
+		}
+	}
+
+	switch {
+	case listScopeBuckets && named:
+		return nil, fmt.Errorf("--buckets lists every table bucket and takes no --bucket, --namespace or --table")
+	case listScopeBuckets:
+		return nil, nil
+	case listScopeNamespaces && len(scoped) != 1:
+		return nil, fmt.Errorf("--namespaces requires --bucket and takes no --namespace or --table")
+	case listScopeTables && len(scoped) != 2:
+		return nil, fmt.Errorf("--tables requires --bucket and --namespace and takes no --table")
+	}
+	return scoped, nil
+}
+
+// resolveBucket returns the name and ARN of the table bucket given on the command line.
+// When the name has no exact match but other buckets start with it, the user picks
+// one of them instead of getting an error; leaving the prompt returns the not-found error.
+func resolveBucket(ctx context.Context, lister *s3tables.S3TablesLister, selector s3tables.InteractiveSelector, name string) (string, string, error) {
+	arn, candidates, err := lister.ResolveTableBucket(ctx, name)
+	if err == nil || len(candidates) == 0 {
+		return name, arn, err
+	}
+
+	items := make([]string, len(candidates))
+	for i, bucket := range candidates {
+		items[i] = bucket.Name
+	}
+	label := fmt.Sprintf("Table bucket '%s' not found. Select a matching bucket", name)
+	result, selectErr := selector.SelectWithFilter(ctx, label, items, false)
+	if selectErr != nil || result.Action != s3tables.ActionSelect {
+		return "", "", err
+	}
+	for _, bucket := range candidates {
+		if bucket.Name == result.Selected {
+			return bucket.Name, bucket.ARN, nil
+		}
+	}
+	return "", "", err
+}
+
+// applySelectTargets configures the controller with the --*-select flag values
+func applySelectTargets(controller *s3tables.NavigationController) {
+	if listBucketSelect != "" {
+		controller.SetTarget(s3tables.LevelTableBucket, listBucketSelect)
+	}
+	if listNamespaceSelect != "" {
+		controller.SetTarget(s3tables.LevelNamespace, listNamespaceSelect)
+	}
+	if listTableSelect != "" {
+		controller.SetTarget(s3tables.LevelTable, listTableSelect)
+	}
+}
+
+// arnLabelWidth is the width of the "  ARN:       " label preceding ARNs in details
+const arnLabelWidth = 13
+
+// minARNDisplayWidth keeps truncated ARNs readable on very narrow terminals
+const minARNDisplayWidth = 20
+
+// arnDisplayWidth returns the maximum ARN length for display, or 0 for full ARNs
+func arnDisplayWidth() int {
+	return arnDisplayWidthFor(terminal())
+}
+
+// arnDisplayWidthFor fits truncated ARNs to the terminal width when stdout is a
+// terminal of known width, and falls back to DefaultARNDisplayWidth otherwise
+func arnDisplayWidthFor(info TerminalInfo) int {
+	if !listTruncateARN {
+		return 0
+	}
+	if !info.StdoutTTY || info.Width == 0 {
+		return s3tables.DefaultARNDisplayWidth
+	}
+	return max(info.Width-arnLabelWidth, minARNDisplayWidth)
+}
+
+// showTableDetails fetches detailed information about a specific table.
+// Rendering is left to the caller (see printTableDetails).
+func showTableDetails(ctx context.Context, lister *s3tables.S3TablesLister, path s3tables.ResourcePath) (*s3tables.TableInfo, error) {
+	// Get table bucket ARN
+	tableBucketARN := path.BucketARN
+	if tableBucketARN == "" {
+		var err error
+		if tableBucketARN, err = lister.GetTableBucketARN(ctx, path.Bucket); err != nil {
+			return nil, err
+		}
+	}
+
+	return lister.GetTableDetails(ctx, tableBucketARN, path.Namespace, path.Table)
+}
+
+// printTableDetails writes table details in the format selected by --compact
+func printTableDetails(w io.Writer, table *s3tables.TableInfo) {
+	shown := *table
+	if redactOutput {
+		shown.ARN = s3tables.RedactARN(table.ARN)
+	}
+	shown.WriteDetails(w, listCompact, listShowLocation, arnDisplayWidth())
+}
+
+// countTables prints the number of tables in the given bucket, or in every bucket when none is given
+func countTables(ctx context.Context, lister *s3tables.S3TablesLister, args []string) error {
+	var scope s3tables.CountScope
+	if len(args) == 1 {
+		bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		scope = s3tables.CountScope{TableBucketName: args[0], TableBucketARN: bucketARN}
+	}
+	scope.NamespacePrefix = listNamespacePrefix
+	scope.TablePrefix = listTablePrefix
+	scope.ContinueOnError = listContinueOnError
+
+	result, err := lister.CountRecursive(ctx, scope)
+	if err != nil {
+		return err
+	}
+	printSkipped("table bucket", result.SkippedBuckets)
+	printSkipped("namespace", result.SkippedNamespaces)
+
+	if !listVerbose {
+		fmt.Fprintln(stdout(), result.Total)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(stdout(), 0, 0, 2, ' ', 0)
+	for _, c := range result.Breakdown {
+		fmt.Fprintf(w, "%s/%s\t%d\n", c.TableBucket, c.Namespace, c.Tables)
+	}
+	fmt.Fprintf(w, "Total\t%d\n", result.Total)
+	return w.Flush()
+}
+
+// summarizeNamespaces prints the number of tables in each namespace of a bucket
+// and the total, without the table names
+func summarizeNamespaces(ctx context.Context, lister *s3tables.S3TablesLister, tableBucketName string) error {
+	bucketARN, err := lister.GetTableBucketARN(ctx, tableBucketName)
+	if err != nil {
+		return err
+	}
+	summary, perNamespace, err := lister.SummarizeNamespaces(ctx, bucketARN)
+	if err != nil {
+		return err
+	}
+	return writeNamespaceSummary(stdout(), listOutput, tableBucketName, summary, perNamespace)
+}
+
+// writeNamespaceSummary writes per-namespace table counts and the total in the given format: table or json
+func writeNamespaceSummary(w io.Writer, format, bucketName string, summary *s3tables.BucketSummary, perNamespace []s3tables.NamespaceSummary) error {
+	switch format {
+	case outputFormatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "NAMESPACE\tTABLES")
+		for _, ns := range perNamespace {
+			fmt.Fprintf(tw, "%s\t%d\n", ns.Namespace, ns.Tables)
+		}
+		fmt.Fprintf(tw, "TOTAL\t%d\n", summary.Tables)
+		return tw.Flush()
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			TableBucket string                      `json:"table_bucket"`
+			Namespaces  []s3tables.NamespaceSummary `json:"namespaces"`
+			TotalTables int                         `json:"total_tables"`
+		}{bucketName, perNamespace, summary.Tables})
+	default:
+		return fmt.Errorf("unknown output format '%s' for --summary-only (available: %s, json)", format, outputFormatTable)
+	}
+}
+
+// listAllBucketTables prints every table in a bucket in the --output format
+func listAllBucketTables(ctx context.Context, lister *s3tables.S3TablesLister, tableBucketName string) error {
+	columns, err := columnsForOutput(listOutput, listColumns)
+	if err != nil {
+		return err
+	}
+
+	bucketARN, err := lister.GetTableBucketARN(ctx, tableBucketName)
+	if err != nil {
+		return err
+	}
+
+	tables, skipped, err := lister.ListAllTables(ctx, bucketARN, listContinueOnError)
+	if err != nil {
+		return err
+	}
+	printSkipped("namespace", skipped)
+
+	collisions := s3tables.FindNameCollisions(tables)
+	if listReportCollisions {
+		writeNameCollisions(stderr(), collisions)
+	}
+	if !slices.Contains(columns, "namespace") {
+		tables = qualifyCollidingNames(tables, collisions)
+	}
+
+	out, closePager := stdoutPaged()
+	defer closePager()
+	return writeTables(out, listOutput, tables, columns)
+}
+
+// qualifyCollidingNames returns tables with every name used in several namespaces
+// written as namespace/name, for output that has no namespace column
+func qualifyCollidingNames(tables []s3tables.TableInfo, collisions map[string][]string) []s3tables.TableInfo {
+	if len(collisions) == 0 {
+		return tables
+	}
+	qualified := make([]s3tables.TableInfo, len(tables))
+	for i, tbl := range tables {
+		if _, ok := collisions[tbl.Name]; ok {
+			tbl.Name = tbl.Namespace + "/" + tbl.Name
+		}
+		qualified[i] = tbl
+	}
+	return qualified
+}
+
+// writeNameCollisions prints each table name used in several namespaces, sorted by name
+func writeNameCollisions(w io.Writer, collisions map[string][]string) {
+	if len(collisions) == 0 {
+		fmt.Fprintln(w, "No table names are shared between namespaces")
+		return
+	}
+	names := make([]string, 0, len(collisions))
+	for name := range collisions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(w, "%d table name(s) used in more than one namespace:\n", len(names))
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s: %s\n", name, strings.Join(collisions[name], ", "))
+	}
+}
+
+// listStatsCollector sums the ListStats of every listing made by one command
+type listStatsCollector struct {
+	mu    sync.Mutex
+	stats []s3tables.ListStats // Operation ごとに最初の出現順で集計
+}
+
+func (c *listStatsCollector) add(stats s3tables.ListStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.stats {
+		if c.stats[i].Operation == stats.Operation {
+			c.stats[i].Pages += stats.Pages
+			c.stats[i].Items += stats.Items
+			return
+		}
+	}
+	c.stats = append(c.stats, stats)
+}
+
+// print writes one line per API, e.g. "ListTables: 1200 item(s) in 12 page(s)"
+func (c *listStatsCollector) print(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, stats := range c.stats {
+		fmt.Fprintf(w, "%s: %d item(s) in %d page(s)\n", stats.Operation, stats.Items, stats.Pages)
+	}
+}
+
+// usePlainListing reports whether list prints plain names instead of navigating.
+// An explicit --plain always wins. Otherwise plain output is the default when
+// stdout is not a terminal, except for table details (three arguments),
+// --all-tables, scripted navigation with --*-select and the navigation flags
+// (--goto-arn, --after-token, --paged, --compact, --show-age) that plain
+// output would otherwise ignore.
+func usePlainListing(cmd *cobra.Command, info TerminalInfo, argCount int) bool {
+	flags := cmd.Flags()
+	if flags.Changed("plain") {
+		plain, _ := flags.GetBool("plain")
+		return plain
+	}
+	if argCount >= 3 {
+		return false
+	}
+	for _, name := range []string{"all-tables", "bucket-select", "namespace-select", "table-select", "goto-arn", "after-token", "paged", "compact", "show-age"} {
+		if flags.Changed(name) {
+			return false
+		}
+	}
+	return !info.StdoutTTY
+}
+
+// listPlainNames prints the table buckets, namespaces or tables selected by args, one per line
+func listPlainNames(ctx context.Context, lister *s3tables.S3TablesLister, args []string) error {
+	switch len(args) {
+	case 0:
+		buckets, err := lister.ListTableBucketsAll(ctx, "")
+		if err != nil {
+			return err
+		}
+		writePlainBuckets(stdout(), buckets, listIncludeARNs)
+		return nil
 	case 1:
-		// Start from Namespace level with specified bucket
 		bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
 		if err != nil {
 			return err
 		}
-		controller.SetInitialState(args[0], bucketARN, "")
-		return controller.Navigate(ctx, s3tables.LevelNamespace)
+		namespaces, err := lister.ListNamespacesAll(ctx, bucketARN, "")
+		if err != nil {
+			return err
+		}
+		if listIncludeARNs {
+			fmt.Fprintln(stderr(), "Warning: namespaces have no ARN; --include-arns is ignored")
+		}
+		for _, ns := range namespaces {
+			fmt.Fprintln(stdout(), ns.Name)
+		}
+		return nil
 	case 2:
-		// Start from Table level with specified bucket and namespace
 		bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
 		if err != nil {
 			return err
 		}
-		controller.SetInitialState(args[0], bucketARN, args[1])
-		return controller.Navigate(ctx, s3tables.LevelTable)
-	case 3:
-		// Show table details directly
-		return showTableDetails(ctx, lister, args[0], args[1], args[2])
+		tables, err := lister.ListTablesAll(ctx, bucketARN, args[1], "")
+		if err != nil {
+			return err
+		}
+		writePlainTables(stdout(), tables, listIncludeARNs)
+		return nil
 	default:
-		return fmt.Errorf("too many arguments")
+		return fmt.Errorf("--plain accepts at most two arguments (table-bucket namespace)")
 	}
 }
 
-// showTableDetails displays detailed information about a specific table
-func showTableDetails(ctx context.Context, lister *s3tables.S3TablesLister, tableBucketName, namespace, tableName string) error {
-	// Get table bucket ARN
-	tableBucketARN, err := lister.GetTableBucketARN(ctx, tableBucketName)
+// listSampleTables writes a random sample of up to n tables of a namespace to w,
+// one per line in listing order. The sample follows --seed when it is given.
+func listSampleTables(ctx context.Context, w io.Writer, lister *s3tables.S3TablesLister, bucket, namespace string, n int) error {
+	bucketARN, err := lister.GetTableBucketARN(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	tables, err := lister.ListTablesAll(ctx, bucketARN, namespace, "")
 	if err != nil {
 		return err
 	}
+	writePlainTables(w, s3tables.Sample(tables, n, nil), listIncludeARNs)
+	return nil
+}
+
+// writePlainBuckets writes one table bucket name per line, followed by a tab and the ARN when includeARNs is set
+func writePlainBuckets(w io.Writer, buckets []s3tables.TableBucketInfo, includeARNs bool) {
+	for _, b := range buckets {
+		if includeARNs {
+			fmt.Fprintf(w, "%s\t%s\n", b.Name, b.ARN)
+			continue
+		}
+		fmt.Fprintln(w, b.Name)
+	}
+}
+
+// writePlainTables writes one table name per line, followed by a tab and the ARN when includeARNs is set
+func writePlainTables(w io.Writer, tables []s3tables.TableInfo, includeARNs bool) {
+	for _, t := range tables {
+		if includeARNs {
+			fmt.Fprintf(w, "%s\t%s\n", t.Name, t.ARN)
+			continue
+		}
+		fmt.Fprintln(w, t.Name)
+	}
+}
+
+// printSkipped prints a warning for each item skipped while aggregating results
+func printSkipped(kind string, skipped []s3tables.ItemError) {
+	for _, s := range skipped {
+		fmt.Fprintf(stderr(), "Warning: skipped %s %s: %v\n", kind, s.Item, s.Err)
+	}
+}
 
-	table, err := lister.GetTableDetails(ctx, tableBucketARN, namespace, tableName)
+// listAllRegionBuckets prints the table buckets found in every given region.
+// Regions where S3 Tables is unavailable are reported as warnings and skipped.
+// newLister creates the lister of each region.
+func listAllRegionBuckets(ctx context.Context, regions []string, factory s3tables.ClientFactory, newLister func(s3tables.S3TablesAPI) *s3tables.S3TablesLister) error {
+	buckets, skipped, err := s3tables.ListTableBucketsInRegions(ctx, regions, factory, newLister)
 	if err != nil {
 		return err
 	}
 
-	// Display table details
-	fmt.Printf("\nTable Details:\n")
-	fmt.Println()
-	fmt.Printf("  Name:      %s\n", table.Name)
-	fmt.Printf("  Namespace: %s\n", table.Namespace)
-	fmt.Printf("  ARN:       %s\n", table.ARN)
-	fmt.Printf("  Type:      %s\n", table.Type)
-	fmt.Printf("  Created:   %s\n", table.CreatedAt.Format("2006-01-02 15:04:05"))
-	fmt.Println()
+	printSkipped("region", skipped)
 
-	return nil
+	if len(buckets) == 0 {
+		fmt.Fprintln(stdout(), "No table buckets found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(stdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REGION\tNAME\tARN")
+	for _, b := range buckets {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", b.Region, b.Name, displayBucketARN(b.TableBucketInfo, arnDisplayWidth()))
+	}
+	return w.Flush()
 }
@@ -1,7 +1,14 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -41,6 +48,14 @@ func (m *mockS3TablesAPI) ListTables(ctx context.Context, params *awss3tables.Li
 	return &awss3tables.ListTablesOutput{}, nil
 }
 
+func (m *mockS3TablesAPI) DeleteNamespace(ctx context.Context, params *awss3tables.DeleteNamespaceInput, optFns ...func(*awss3tables.Options)) (*awss3tables.DeleteNamespaceOutput, error) {
+	return &awss3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *mockS3TablesAPI) DeleteTable(ctx context.Context, params *awss3tables.DeleteTableInput, optFns ...func(*awss3tables.Options)) (*awss3tables.DeleteTableOutput, error) {
+	return &awss3tables.DeleteTableOutput{}, nil
+}
+
 func (m *mockS3TablesAPI) GetTable(ctx context.Context, params *awss3tables.GetTableInput, optFns ...func(*awss3tables.Options)) (*awss3tables.GetTableOutput, error) {
 	if m.getTableFunc != nil {
 		return m.getTableFunc(ctx, params, optFns...)
@@ -73,7 +88,7 @@ type mockInteractiveSelector struct {
 	selectWithFilterFunc func(label string, items []string, showBack bool) (*s3tables.SelectionResult, error)
 }
 
-func (m *mockInteractiveSelector) SelectWithFilter(label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+func (m *mockInteractiveSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
 	if m.selectWithFilterFunc != nil {
 		return m.selectWithFilterFunc(label, items, showBack)
 	}
@@ -212,9 +227,20 @@ func TestShowTableDetails(t *testing.T) {
 	lister := s3tables.NewS3TablesLister(mock)
 
 	ctx := context.Background()
-	err := showTableDetails(ctx, lister, bucketName, namespace, tableName)
+	table, err := showTableDetails(ctx, lister, s3tables.ResourcePath{Bucket: bucketName, Namespace: namespace, Table: tableName})
 	if err != nil {
-		t.Errorf("unexpected error: %v", err)
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &s3tables.TableInfo{
+		Name:      tableName,
+		ARN:       tableARN,
+		Namespace: namespace,
+		CreatedAt: now,
+		Type:      string(types.TableTypeCustomer),
+	}
+	if !reflect.DeepEqual(table, want) {
+		t.Errorf("showTableDetails() = %+v, want %+v", table, want)
 	}
 }
 
@@ -248,3 +274,430 @@ func TestNavigationController_TableBucketNotFound(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+// TestListAllRegionBuckets_SkipsFailingRegions tests that failing regions do not abort the listing
+func TestListAllRegionBuckets_SkipsFailingRegions(t *testing.T) {
+	bucketName := "test-bucket"
+	bucketARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"
+
+	var mu sync.Mutex
+	var requested []string
+	factory := func(ctx context.Context, region string) (s3tables.S3TablesAPI, error) {
+		mu.Lock()
+		requested = append(requested, region)
+		mu.Unlock()
+		if region != "us-east-1" {
+			return nil, fmt.Errorf("S3 Tables is not available in %s", region)
+		}
+		return &mockS3TablesAPI{
+			listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+				return &awss3tables.ListTableBucketsOutput{
+					TableBuckets: []types.TableBucketSummary{{Name: &bucketName, Arn: &bucketARN}},
+				}, nil
+			},
+		}, nil
+	}
+
+	if err := listAllRegionBuckets(context.Background(), []string{"us-east-1", "me-south-1"}, factory, newLister); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(requested) != 2 {
+		t.Errorf("expected clients for 2 regions, got %d", len(requested))
+	}
+}
+
+// TestPrintTableDetails tests rendering of fetched table details
+func TestPrintTableDetails(t *testing.T) {
+	table := &s3tables.TableInfo{
+		Name:      "orders",
+		ARN:       "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/orders",
+		Namespace: "sales",
+		Type:      "customer",
+	}
+
+	var buf bytes.Buffer
+	printTableDetails(&buf, table)
+
+	for _, want := range []string{"  Name:      orders\n", "  Namespace: sales\n", "  Type:      customer\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("printTableDetails() output = %q, want line %q", buf.String(), want)
+		}
+	}
+}
+
+// newBucketsMock returns a mock whose ListTableBuckets honors the prefix over the given bucket names
+func newBucketsMock(names ...string) *mockS3TablesAPI {
+	return &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			var buckets []types.TableBucketSummary
+			for _, name := range names {
+				if params.Prefix == nil || strings.HasPrefix(name, *params.Prefix) {
+					arn := "arn:aws:s3tables:us-east-1:123456789012:bucket/" + name
+					buckets = append(buckets, types.TableBucketSummary{Name: &name, Arn: &arn})
+				}
+			}
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: buckets}, nil
+		},
+	}
+}
+
+// TestResolveBucket tests exact matches and interactive disambiguation among prefix matches
+func TestResolveBucket(t *testing.T) {
+	lister := s3tables.NewS3TablesLister(newBucketsMock("sales", "sales-eu", "sales-us", "logs"))
+
+	t.Run("exact match does not prompt", func(t *testing.T) {
+		selector := &mockInteractiveSelector{
+			selectWithFilterFunc: func(label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+				t.Error("selector should not be called for an exact match")
+				return &s3tables.SelectionResult{Action: s3tables.ActionExit}, nil
+			},
+		}
+		name, arn, err := resolveBucket(context.Background(), lister, selector, "sales")
+		if err != nil || name != "sales" || !strings.HasSuffix(arn, "/sales") {
+			t.Errorf("resolveBucket() = %q, %q, %v", name, arn, err)
+		}
+	})
+
+	t.Run("multiple candidates prompt for a choice", func(t *testing.T) {
+		var offered []string
+		selector := &mockInteractiveSelector{
+			selectWithFilterFunc: func(label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+				offered = items
+				return &s3tables.SelectionResult{Selected: "sales-us", Action: s3tables.ActionSelect}, nil
+			},
+		}
+		name, arn, err := resolveBucket(context.Background(), lister, selector, "sales-")
+		if err != nil {
+			t.Fatalf("resolveBucket() error = %v", err)
+		}
+		if !reflect.DeepEqual(offered, []string{"sales-eu", "sales-us"}) {
+			t.Errorf("offered candidates = %v, want [sales-eu sales-us]", offered)
+		}
+		if name != "sales-us" || arn != "arn:aws:s3tables:us-east-1:123456789012:bucket/sales-us" {
+			t.Errorf("resolveBucket() = %q, %q, want sales-us and its ARN", name, arn)
+		}
+	})
+
+	t.Run("leaving the prompt keeps the not-found error", func(t *testing.T) {
+		selector := &mockInteractiveSelector{
+			selectWithFilterFunc: func(label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+				return &s3tables.SelectionResult{Action: s3tables.ActionExit}, nil
+			},
+		}
+		_, _, err := resolveBucket(context.Background(), lister, selector, "sales-")
+		if !s3tables.IsNotFoundError(err) {
+			t.Errorf("resolveBucket() error = %v, want not found", err)
+		}
+	})
+
+	t.Run("no candidates fails without prompting", func(t *testing.T) {
+		selector := &mockInteractiveSelector{
+			selectWithFilterFunc: func(label string, items []string, showBack bool) (*s3tables.SelectionResult, error) {
+				t.Error("selector should not be called without candidates")
+				return &s3tables.SelectionResult{Action: s3tables.ActionExit}, nil
+			},
+		}
+		_, _, err := resolveBucket(context.Background(), lister, selector, "metrics")
+		if !s3tables.IsNotFoundError(err) {
+			t.Errorf("resolveBucket() error = %v, want not found", err)
+		}
+	})
+}
+
+// TestWritePlainBuckets tests plain bucket output with and without ARNs
+func TestWritePlainBuckets(t *testing.T) {
+	buckets := []s3tables.TableBucketInfo{
+		{Name: "analytics", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/analytics"},
+		{Name: "logs", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/logs"},
+	}
+
+	tests := []struct {
+		name        string
+		includeARNs bool
+		want        string
+	}{
+		{name: "names only", want: "analytics\nlogs\n"},
+		{
+			name:        "with ARNs",
+			includeARNs: true,
+			want: "analytics\tarn:aws:s3tables:us-east-1:123456789012:bucket/analytics\n" +
+				"logs\tarn:aws:s3tables:us-east-1:123456789012:bucket/logs\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writePlainBuckets(&buf, buckets, tt.includeARNs)
+			if buf.String() != tt.want {
+				t.Errorf("writePlainBuckets() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWritePlainTables tests plain table output with ARNs
+func TestWritePlainTables(t *testing.T) {
+	tables := []s3tables.TableInfo{{Name: "orders", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/analytics/table/1"}}
+
+	var buf bytes.Buffer
+	writePlainTables(&buf, tables, true)
+	if want := "orders\tarn:aws:s3tables:us-east-1:123456789012:bucket/analytics/table/1\n"; buf.String() != want {
+		t.Errorf("writePlainTables() = %q, want %q", buf.String(), want)
+	}
+}
+
+// TestListSampleTables tests that --sample prints min(N, total) distinct tables and
+// that the same seed prints the same sample
+func TestListSampleTables(t *testing.T) {
+	bucketName, bucketARN := "analytics", "arn:aws:s3tables:us-east-1:123456789012:bucket/analytics"
+	mock := &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{{Name: &bucketName, Arn: &bucketARN}}}, nil
+		},
+		listTablesFunc: func(ctx context.Context, params *awss3tables.ListTablesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTablesOutput, error) {
+			tables := make([]types.TableSummary, 30)
+			for i := range tables {
+				name := fmt.Sprintf("table_%02d", i)
+				tables[i] = types.TableSummary{Name: &name}
+			}
+			return &awss3tables.ListTablesOutput{Tables: tables}, nil
+		},
+	}
+	lister := newLister(mock)
+	t.Cleanup(func() { s3tables.SetRandSource(nil) })
+
+	sample := func(n int) []string {
+		s3tables.SetRandSource(rand.NewSource(1))
+		var buf bytes.Buffer
+		if err := listSampleTables(context.Background(), &buf, lister, bucketName, "sales", n); err != nil {
+			t.Fatalf("listSampleTables() error = %v", err)
+		}
+		return strings.Fields(buf.String())
+	}
+
+	for _, n := range []int{5, 30, 100} {
+		names := sample(n)
+		if want := min(n, 30); len(names) != want {
+			t.Errorf("listSampleTables(%d) printed %d tables, want %d", n, len(names), want)
+		}
+		for i := 1; i < len(names); i++ {
+			if names[i] <= names[i-1] {
+				t.Errorf("listSampleTables(%d) = %v, want distinct tables in listing order", n, names)
+				break
+			}
+		}
+	}
+	if first, second := sample(5), sample(5); !reflect.DeepEqual(first, second) {
+		t.Errorf("listSampleTables() with the same seed = %v and %v, want equal", first, second)
+	}
+}
+
+// TestUsePlainListing tests the plain default for piped output and that explicit flags win
+func TestUsePlainListing(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		tty      bool
+		argCount int
+		want     bool
+	}{
+		{name: "terminal navigates", tty: true, want: false},
+		{name: "pipe prints plain names", tty: false, want: true},
+		{name: "pipe at namespace level", tty: false, argCount: 1, want: true},
+		{name: "explicit --plain on terminal", args: []string{"--plain"}, tty: true, want: true},
+		{name: "explicit --plain=false in pipe", args: []string{"--plain=false"}, tty: false, want: false},
+		{name: "table details in pipe", tty: false, argCount: 3, want: false},
+		{name: "--all-tables in pipe", args: []string{"--all-tables"}, tty: false, argCount: 1, want: false},
+		{name: "--bucket-select in pipe", args: []string{"--bucket-select", "b"}, tty: false, want: false},
+		{name: "--goto-arn in pipe", args: []string{"--goto-arn", "arn:aws:s3tables:us-east-1:123456789012:bucket/b"}, tty: false, want: false},
+		{name: "--after-token in pipe", args: []string{"--after-token", "token"}, tty: false, want: false},
+		{name: "--paged in pipe", args: []string{"--paged"}, tty: false, want: false},
+		{name: "--compact in pipe", args: []string{"--compact"}, tty: false, want: false},
+		{name: "--show-age in pipe", args: []string{"--show-age"}, tty: false, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "list"}
+			cmd.Flags().Bool("plain", false, "")
+			cmd.Flags().Bool("all-tables", false, "")
+			cmd.Flags().String("bucket-select", "", "")
+			cmd.Flags().String("namespace-select", "", "")
+			cmd.Flags().String("table-select", "", "")
+			cmd.Flags().String("goto-arn", "", "")
+			cmd.Flags().String("after-token", "", "")
+			cmd.Flags().Bool("paged", false, "")
+			cmd.Flags().Bool("compact", false, "")
+			cmd.Flags().Bool("show-age", false, "")
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			info := detectTerminal(fakeDetector{ttys: map[int]bool{1: tt.tty}}, 0, 1, 2)
+			if got := usePlainListing(cmd, info, tt.argCount); got != tt.want {
+				t.Errorf("usePlainListing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWriteNamespaceSummary tests the per-namespace counts and the total in text and JSON
+func TestWriteNamespaceSummary(t *testing.T) {
+	summary := &s3tables.BucketSummary{Namespaces: 3, Tables: 3}
+	perNamespace := []s3tables.NamespaceSummary{
+		{Namespace: "empty", Tables: 0},
+		{Namespace: "logs", Tables: 1},
+		{Namespace: "sales", Tables: 2},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeNamespaceSummary(&buf, outputFormatTable, "my-bucket", summary, perNamespace); err != nil {
+			t.Fatalf("writeNamespaceSummary() error = %v", err)
+		}
+		want := "NAMESPACE  TABLES\n" +
+			"empty      0\n" +
+			"logs       1\n" +
+			"sales      2\n" +
+			"TOTAL      3\n"
+		if buf.String() != want {
+			t.Errorf("writeNamespaceSummary() =\n%s\nwant\n%s", buf.String(), want)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeNamespaceSummary(&buf, "json", "my-bucket", summary, perNamespace); err != nil {
+			t.Fatalf("writeNamespaceSummary() error = %v", err)
+		}
+		var got struct {
+			TableBucket string `json:"table_bucket"`
+			Namespaces  []struct {
+				Namespace string `json:"namespace"`
+				Tables    int    `json:"tables"`
+			} `json:"namespaces"`
+			TotalTables int `json:"total_tables"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+		}
+		if got.TableBucket != "my-bucket" || got.TotalTables != 3 || len(got.Namespaces) != 3 {
+			t.Fatalf("writeNamespaceSummary() JSON = %+v", got)
+		}
+		if got.Namespaces[2].Namespace != "sales" || got.Namespaces[2].Tables != 2 {
+			t.Errorf("Namespaces[2] = %+v, want sales with 2 tables", got.Namespaces[2])
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if err := writeNamespaceSummary(&bytes.Buffer{}, outputFormatCSV, "my-bucket", summary, perNamespace); err == nil {
+			t.Error("writeNamespaceSummary() expected error for csv")
+		}
+	})
+}
+
+// TestNameCollisionOutput tests the collision report and the qualified names for output without a namespace column
+func TestNameCollisionOutput(t *testing.T) {
+	tables := []s3tables.TableInfo{
+		{Name: "orders", Namespace: "sales"},
+		{Name: "orders", Namespace: "archive"},
+		{Name: "customers", Namespace: "sales"},
+	}
+	collisions := s3tables.FindNameCollisions(tables)
+
+	var report bytes.Buffer
+	writeNameCollisions(&report, collisions)
+	if want := "1 table name(s) used in more than one namespace:\n  orders: archive, sales\n"; report.String() != want {
+		t.Errorf("writeNameCollisions() = %q, want %q", report.String(), want)
+	}
+
+	var names []string
+	for _, tbl := range qualifyCollidingNames(tables, collisions) {
+		names = append(names, tbl.Name)
+	}
+	if want := []string{"sales/orders", "archive/orders", "customers"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("qualified names = %v, want %v", names, want)
+	}
+
+	report.Reset()
+	writeNameCollisions(&report, nil)
+	if !strings.Contains(report.String(), "No table names are shared") {
+		t.Errorf("writeNameCollisions(nil) = %q, want the no-collision notice", report.String())
+	}
+}
+
+// TestPrintTableDetailsZeroCreatedAt tests the placeholder for a table without a creation time
+func TestPrintTableDetailsZeroCreatedAt(t *testing.T) {
+	savedCompact := listCompact
+	t.Cleanup(func() { listCompact = savedCompact })
+	listCompact = false
+
+	var buf bytes.Buffer
+	printTableDetails(&buf, &s3tables.TableInfo{Name: "orders", Namespace: "sales", Type: "customer"})
+	if got := buf.String(); !strings.Contains(got, "Created:   -\n") || strings.Contains(got, "0001-01-01") {
+		t.Errorf("printTableDetails() = %q, want the created placeholder", got)
+	}
+}
+
+// TestResolveListScope tests that every scope flag combination maps to the arguments of the matching level
+func TestResolveListScope(t *testing.T) {
+	type scope struct {
+		bucket, namespace, table    string
+		buckets, namespaces, tables bool
+	}
+	tests := []struct {
+		name      string
+		scope     scope
+		args      []string
+		wantArgs  []string
+		wantLevel s3tables.NavigationLevel
+		wantErr   bool
+	}{
+		{name: "no flags keeps args", args: []string{"b"}, wantArgs: []string{"b"}, wantLevel: s3tables.LevelNamespace},
+		{name: "buckets", scope: scope{buckets: true}, wantLevel: s3tables.LevelTableBucket},
+		{name: "namespaces of bucket", scope: scope{bucket: "b", namespaces: true}, wantArgs: []string{"b"}, wantLevel: s3tables.LevelNamespace},
+		{name: "tables of namespace", scope: scope{bucket: "b", namespace: "ns", tables: true}, wantArgs: []string{"b", "ns"}, wantLevel: s3tables.LevelTable},
+		{name: "bucket only", scope: scope{bucket: "b"}, wantArgs: []string{"b"}, wantLevel: s3tables.LevelNamespace},
+		{name: "bucket and namespace", scope: scope{bucket: "b", namespace: "ns"}, wantArgs: []string{"b", "ns"}, wantLevel: s3tables.LevelTable},
+		{name: "table details", scope: scope{bucket: "b", namespace: "ns", table: "t"}, wantArgs: []string{"b", "ns", "t"}, wantLevel: 3},
+		{name: "namespaces without bucket", scope: scope{namespaces: true}, wantErr: true},
+		{name: "tables without namespace", scope: scope{bucket: "b", tables: true}, wantErr: true},
+		{name: "namespace without bucket", scope: scope{namespace: "ns"}, wantErr: true},
+		{name: "table without namespace", scope: scope{bucket: "b", table: "t"}, wantErr: true},
+		{name: "buckets with bucket", scope: scope{bucket: "b", buckets: true}, wantErr: true},
+		{name: "two scopes", scope: scope{bucket: "b", namespaces: true, tables: true}, wantErr: true},
+		{name: "flags with args", scope: scope{bucket: "b"}, args: []string{"b"}, wantErr: true},
+	}
+
+	saved := scope{listScopeBucket, listScopeNamespace, listScopeTable, listScopeBuckets, listScopeNamespaces, listScopeTables}
+	t.Cleanup(func() {
+		listScopeBucket, listScopeNamespace, listScopeTable = saved.bucket, saved.namespace, saved.table
+		listScopeBuckets, listScopeNamespaces, listScopeTables = saved.buckets, saved.namespaces, saved.tables
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listScopeBucket, listScopeNamespace, listScopeTable = tt.scope.bucket, tt.scope.namespace, tt.scope.table
+			listScopeBuckets, listScopeNamespaces, listScopeTables = tt.scope.buckets, tt.scope.namespaces, tt.scope.tables
+
+			args, err := resolveListScope(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("resolveListScope() = %v, want error", args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveListScope() error = %v", err)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("resolveListScope() = %v, want %v", args, tt.wantArgs)
+			}
+			// runList starts at the level given by the number of arguments
+			if level := s3tables.NavigationLevel(len(args)); level != tt.wantLevel {
+				t.Errorf("level = %v, want %v", level, tt.wantLevel)
+			}
+		})
+	}
+}
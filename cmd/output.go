@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"s3t/internal/s3tables"
+)
+
+// Output formats accepted by -o/--output
+const (
+	outputFormatTable = "table"
+	outputFormatWide  = "wide"
+	outputFormatCSV   = "csv"
+)
+
+// tableColumns lists the columns available for table listings, in default order.
+// It is also the column set of -o wide and of CSV output.
+var tableColumns = []string{"name", "namespace", "arn", "type", "created", "modified"}
+
+// narrowColumns is the column set of -o table when --columns is not given
+var narrowColumns = []string{"name", "namespace"}
+
+// columnsForOutput returns the columns for an output format: the --columns
+// selection when given, otherwise the preset of the format
+func columnsForOutput(format, spec string) ([]string, error) {
+	if strings.TrimSpace(spec) != "" {
+		return parseColumns(spec)
+	}
+	if format == outputFormatTable {
+		return narrowColumns, nil
+	}
+	return tableColumns, nil
+}
+
+// parseColumns parses a comma-separated --columns value.
+// An empty value selects every column.
+func parseColumns(spec string) ([]string, error) {
+	if strings.TrimSpace(spec) == "" {
+		return tableColumns, nil
+	}
+
+	var columns []string
+	for _, col := range strings.Split(spec, ",") {
+		col = strings.ToLower(strings.TrimSpace(col))
+		if !isTableColumn(col) {
+			return nil, fmt.Errorf("unknown column '%s' (available: %s)", col, strings.Join(tableColumns, ","))
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+// isTableColumn reports whether col is a known table column
+func isTableColumn(col string) bool {
+	for _, c := range tableColumns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// tableColumnValue returns the value of a column for a table
+func tableColumnValue(tbl s3tables.TableInfo, col string, arnMaxLen int) string {
+	switch col {
+	case "name":
+		return tbl.Name
+	case "namespace":
+		return tbl.Namespace
+	case "arn":
+		return displayTableARN(tbl, arnMaxLen)
+	case "type":
+		return tbl.Type
+	case "created":
+		return s3tables.FormatTime(tbl.CreatedAt, time.RFC3339)
+	case "modified":
+		return s3tables.FormatTime(tbl.ModifiedAt, time.RFC3339)
+	default:
+		return ""
+	}
+}
+
+// writeTables writes tables in the given output format
+func writeTables(w io.Writer, format string, tables []s3tables.TableInfo, columns []string) error {
+	switch format {
+	case outputFormatTable, outputFormatWide:
+		return writeTablesTable(w, tables, columns)
+	case outputFormatCSV:
+		return writeTablesCSV(w, tables, columns)
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: %s, %s, %s)", format, outputFormatTable, outputFormatWide, outputFormatCSV)
+	}
+}
+
+// writeTablesTable writes tables as aligned columns for the terminal
+func writeTablesTable(w io.Writer, tables []s3tables.TableInfo, columns []string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, tbl := range tables {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			values[i] = tableColumnValue(tbl, col, arnDisplayWidth())
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// writeTablesCSV writes tables as CSV with a header row.
+// ARNs are never truncated in CSV output.
+func writeTablesCSV(w io.Writer, tables []s3tables.TableInfo, columns []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	for _, tbl := range tables {
+		record := make([]string, len(columns))
+		for i, col := range columns {
+			record[i] = tableColumnValue(tbl, col, 0)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"s3t/internal/s3tables"
+)
+
+func TestWriteTablesCSV(t *testing.T) {
+	tables := []s3tables.TableInfo{
+		{
+			Name:       "orders",
+			Namespace:  "sales",
+			ARN:        "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/abc",
+			Type:       "customer",
+			CreatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			ModifiedAt: time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC),
+		},
+		{
+			Name:       "odd,name",
+			Namespace:  "sales",
+			Type:       "customer",
+			CreatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			ModifiedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeTablesCSV(&buf, tables, tableColumns); err != nil {
+		t.Fatalf("writeTablesCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+
+	want := [][]string{
+		{"name", "namespace", "arn", "type", "created", "modified"},
+		{"orders", "sales", "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/abc", "customer", "2024-01-02T03:04:05Z", "2024-02-03T04:05:06Z"},
+		{"odd,name", "sales", "", "customer", "2024-01-02T03:04:05Z", "2024-01-02T03:04:05Z"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %v, want %v", records, want)
+	}
+}
+
+func TestWriteTablesCSV_SelectedColumns(t *testing.T) {
+	columns, err := parseColumns("namespace, name")
+	if err != nil {
+		t.Fatalf("parseColumns() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	tables := []s3tables.TableInfo{{Name: "orders", Namespace: "sales"}}
+	if err := writeTablesCSV(&buf, tables, columns); err != nil {
+		t.Fatalf("writeTablesCSV() error = %v", err)
+	}
+
+	if got, want := buf.String(), "namespace,name\nsales,orders\n"; got != want {
+		t.Errorf("writeTablesCSV() = %q, want %q", got, want)
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	columns, err := parseColumns("")
+	if err != nil || !reflect.DeepEqual(columns, tableColumns) {
+		t.Errorf("parseColumns(\"\") = %v, %v; want all columns", columns, err)
+	}
+
+	if _, err := parseColumns("name,size"); err == nil {
+		t.Error("parseColumns() with unknown column should fail")
+	}
+}
+
+func TestWriteTables_UnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeTables(&buf, "xml", nil, tableColumns); err == nil {
+		t.Error("writeTables() with unknown format should fail")
+	}
+}
+
+// TestColumnsForOutput tests the column presets of the narrow and wide table formats
+func TestColumnsForOutput(t *testing.T) {
+	narrow, err := columnsForOutput(outputFormatTable, "")
+	if err != nil || !reflect.DeepEqual(narrow, []string{"name", "namespace"}) {
+		t.Errorf("columnsForOutput(table) = %v, %v; want name, namespace", narrow, err)
+	}
+	wide, err := columnsForOutput(outputFormatWide, "")
+	if err != nil || !reflect.DeepEqual(wide, []string{"name", "namespace", "arn", "type", "created", "modified"}) {
+		t.Errorf("columnsForOutput(wide) = %v, %v; want every column", wide, err)
+	}
+	selected, err := columnsForOutput(outputFormatWide, "arn")
+	if err != nil || !reflect.DeepEqual(selected, []string{"arn"}) {
+		t.Errorf("columnsForOutput(wide, arn) = %v, %v; want --columns to win", selected, err)
+	}
+
+	tables := []s3tables.TableInfo{{
+		Name: "orders", Namespace: "sales", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/abc", Type: "customer",
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ModifiedAt: time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC),
+	}}
+	var narrowOut, wideOut bytes.Buffer
+	if err := writeTables(&narrowOut, outputFormatTable, tables, narrow); err != nil {
+		t.Fatalf("writeTables(table) error = %v", err)
+	}
+	if err := writeTables(&wideOut, outputFormatWide, tables, wide); err != nil {
+		t.Fatalf("writeTables(wide) error = %v", err)
+	}
+	for _, extra := range []string{"ARN", "TYPE", "CREATED", "MODIFIED", "2024-02-03T04:05:06Z"} {
+		if strings.Contains(narrowOut.String(), extra) {
+			t.Errorf("narrow output contains %q:\n%s", extra, narrowOut.String())
+		}
+		if !strings.Contains(wideOut.String(), extra) {
+			t.Errorf("wide output lacks %q:\n%s", extra, wideOut.String())
+		}
+	}
+}
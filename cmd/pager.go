@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// defaultPager is used with --pager when $PAGER is not set.
+// -F quits when the output fits on one screen, -R keeps colors, -X leaves the screen intact.
+const defaultPager = "less -FRX"
+
+// pagerStarter starts command with its output going to out and returns its stdin.
+// Closing the returned writer waits for the pager to exit.
+type pagerStarter func(command string, out io.Writer) (io.WriteCloser, error)
+
+// startPager is the pagerStarter used by the CLI; tests replace it with a fake
+var startPager pagerStarter = startShellPager
+
+// pagerCommand returns the pager to pipe output through, or "" to write directly.
+// Paging needs --pager and a terminal on stdout; --no-pager always wins.
+// $PAGER selects the pager (defaultPager when unset); "cat" disables paging.
+func pagerCommand(enabled, disabled bool, info TerminalInfo, getenv func(string) string) string {
+	if !enabled || disabled || !info.StdoutTTY {
+		return ""
+	}
+	pager := getenv("PAGER")
+	if pager == "" {
+		pager = defaultPager
+	}
+	if pager == "cat" {
+		return ""
+	}
+	return pager
+}
+
+// pagedOutput returns the writer for long human-readable output and a function that
+// must be called when the output is complete. When the pager cannot be started,
+// a warning is printed and output goes to out directly.
+func pagedOutput(out io.Writer, command string, start pagerStarter) (io.Writer, func()) {
+	if command == "" {
+		return out, func() {}
+	}
+	pager, err := start(command, out)
+	if err != nil {
+		fmt.Fprintf(stderr(), "Warning: failed to start pager '%s': %v\n", command, err)
+		return out, func() {}
+	}
+	return pager, func() { _ = pager.Close() }
+}
+
+// stdoutPaged returns stdout, piped through the pager selected by --pager/--no-pager
+// and redacted when --redact is set
+func stdoutPaged() (io.Writer, func()) {
+	out, closePager := pagedOutput(os.Stdout, pagerCommand(usePager, noPager, terminal(), os.Getenv), startPager)
+	return redacted(out), closePager
+}
+
+// shellPager is a running pager process fed through its stdin
+type shellPager struct {
+	stdin io.WriteCloser
+	cmd   *exec.Cmd
+}
+
+func (p *shellPager) Write(b []byte) (int, error) { return p.stdin.Write(b) }
+
+// Close ends the pager input and waits for the user to quit the pager
+func (p *shellPager) Close() error {
+	closeErr := p.stdin.Close()
+	if err := p.cmd.Wait(); err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// startShellPager runs command through the shell so that $PAGER may carry arguments
+func startShellPager(command string, out io.Writer) (io.WriteCloser, error) {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &shellPager{stdin: stdin, cmd: cmd}, nil
+}
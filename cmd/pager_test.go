@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// TestPagerCommand tests when output is piped through a pager and which one
+func TestPagerCommand(t *testing.T) {
+	tty := TerminalInfo{StdoutTTY: true}
+	tests := []struct {
+		name     string
+		enabled  bool
+		disabled bool
+		info     TerminalInfo
+		pager    string
+		want     string
+	}{
+		{name: "not requested", info: tty, pager: "more", want: ""},
+		{name: "requested on a terminal", enabled: true, info: tty, pager: "more", want: "more"},
+		{name: "default pager", enabled: true, info: tty, want: defaultPager},
+		{name: "stdout is not a terminal", enabled: true, info: TerminalInfo{}, pager: "more", want: ""},
+		{name: "no-pager wins", enabled: true, disabled: true, info: tty, pager: "more", want: ""},
+		{name: "cat disables paging", enabled: true, info: tty, pager: "cat", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string {
+				if key == "PAGER" {
+					return tt.pager
+				}
+				return ""
+			}
+			if got := pagerCommand(tt.enabled, tt.disabled, tt.info, getenv); got != tt.want {
+				t.Errorf("pagerCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakePager records what was written to it and whether it was closed
+type fakePager struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (f *fakePager) Close() error {
+	f.closed = true
+	return nil
+}
+
+// TestPagedOutput tests that output goes through the started pager, or directly when not paging
+func TestPagedOutput(t *testing.T) {
+	t.Run("pager started", func(t *testing.T) {
+		var stdout bytes.Buffer
+		pager := &fakePager{}
+		var started string
+		start := func(command string, out io.Writer) (io.WriteCloser, error) {
+			started = command
+			return pager, nil
+		}
+
+		w, done := pagedOutput(&stdout, "less -FRX", start)
+		_, _ = io.WriteString(w, "tree output\n")
+		done()
+
+		if started != "less -FRX" {
+			t.Errorf("started pager = %q, want %q", started, "less -FRX")
+		}
+		if pager.String() != "tree output\n" || stdout.Len() != 0 {
+			t.Errorf("pager got %q, stdout got %q; want output in the pager only", pager.String(), stdout.String())
+		}
+		if !pager.closed {
+			t.Error("pager was not closed")
+		}
+	})
+
+	t.Run("no pager", func(t *testing.T) {
+		var stdout bytes.Buffer
+		start := func(command string, out io.Writer) (io.WriteCloser, error) {
+			t.Error("pager should not be started")
+			return nil, nil
+		}
+
+		w, done := pagedOutput(&stdout, "", start)
+		_, _ = io.WriteString(w, "tree output\n")
+		done()
+
+		if stdout.String() != "tree output\n" {
+			t.Errorf("stdout = %q, want the output", stdout.String())
+		}
+	})
+
+	t.Run("pager fails to start", func(t *testing.T) {
+		var stdout bytes.Buffer
+		start := func(command string, out io.Writer) (io.WriteCloser, error) {
+			return nil, errors.New("executable file not found")
+		}
+
+		w, done := pagedOutput(&stdout, "missing-pager", start)
+		_, _ = io.WriteString(w, "tree output\n")
+		done()
+
+		if stdout.String() != "tree output\n" {
+			t.Errorf("stdout = %q, want fallback to direct output", stdout.String())
+		}
+	})
+}
+
+// TestStartShellPager tests that the shell pager forwards its input to out and exits on Close
+func TestStartShellPager(t *testing.T) {
+	var out bytes.Buffer
+	pager, err := startShellPager("cat", &out)
+	if err != nil {
+		t.Fatalf("startShellPager() error = %v", err)
+	}
+	_, _ = io.WriteString(pager, "line 1\nline 2\n")
+	if err := pager.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if out.String() != "line 1\nline 2\n" {
+		t.Errorf("pager output = %q", out.String())
+	}
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var profilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List the AWS profiles available for --profile",
+	Long: `List the profile names found in the shared AWS config and credentials files
+(~/.aws/config and ~/.aws/credentials, or AWS_CONFIG_FILE and
+AWS_SHARED_CREDENTIALS_FILE when set). The profile in effect is marked with "*".
+
+Examples:
+  s3t profiles
+  s3t profiles -o json`,
+	Args: cobra.NoArgs,
+	// Listing profiles must work even when no credentials are configured
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+	RunE:              runProfiles,
+}
+
+var (
+	// profilesOutput is the output format: text or json
+	profilesOutput string
+)
+
+func init() {
+	profilesCmd.Flags().StringVarP(&profilesOutput, "output", "o", "text", "Output format: text or json")
+	rootCmd.AddCommand(profilesCmd)
+}
+
+// profileEntry is a profile name and whether it is the one in effect
+type profileEntry struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+func runProfiles(cmd *cobra.Command, args []string) error {
+	profiles, err := listAvailableProfiles()
+	if err != nil {
+		return err
+	}
+	return writeProfiles(stdout(), profilesOutput, profiles, activeProfile())
+}
+
+// writeProfiles prints profiles in the given format, marking active
+func writeProfiles(w io.Writer, format string, profiles []string, active string) error {
+	entries := make([]profileEntry, len(profiles))
+	for i, name := range profiles {
+		entries[i] = profileEntry{Name: name, Active: name == active}
+	}
+
+	switch format {
+	case "text":
+		for _, e := range entries {
+			marker := " "
+			if e.Active {
+				marker = "*"
+			}
+			fmt.Fprintf(w, "%s %s\n", marker, e.Name)
+		}
+		return nil
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", format)
+	}
+}
+
+// activeProfile returns the profile used when --profile is not given
+func activeProfile() string {
+	if awsProfile != "" {
+		return awsProfile
+	}
+	if p := os.Getenv("AWS_PROFILE"); p != "" {
+		return p
+	}
+	return "default"
+}
+
+// listAvailableProfiles returns the sorted, de-duplicated profile names found in
+// the shared AWS config and credentials files. Missing files are ignored.
+func listAvailableProfiles() ([]string, error) {
+	configPath, credentialsPath := sharedConfigPaths()
+
+	seen := make(map[string]bool)
+	if err := parseProfileNames(configPath, true, seen); err != nil {
+		return nil, err
+	}
+	if err := parseProfileNames(credentialsPath, false, seen); err != nil {
+		return nil, err
+	}
+
+	profiles := make([]string, 0, len(seen))
+	for name := range seen {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// sharedConfigPaths returns the config and credentials file paths, honoring the AWS environment variables
+func sharedConfigPaths() (configPath, credentialsPath string) {
+	home, _ := os.UserHomeDir()
+	configPath = os.Getenv("AWS_CONFIG_FILE")
+	if configPath == "" {
+		configPath = filepath.Join(home, ".aws", "config")
+	}
+	credentialsPath = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if credentialsPath == "" {
+		credentialsPath = filepath.Join(home, ".aws", "credentials")
+	}
+	return configPath, credentialsPath
+}
+
+// parseProfileNames adds the section names of an INI-style AWS file to seen.
+// In the config file non-default profiles are written as [profile name];
+// other sections such as [sso-session x] are skipped.
+func parseProfileNames(path string, isConfig bool, seen map[string]bool) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+			continue
+		}
+		section := strings.TrimSpace(line[1 : len(line)-1])
+
+		name := section
+		if isConfig && section != "default" {
+			rest, ok := strings.CutPrefix(section, "profile ")
+			if !ok {
+				continue
+			}
+			name = strings.TrimSpace(rest)
+		}
+		if name != "" {
+			seen[name] = true
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeProfileFiles writes AWS config and credentials files to a temp dir and points the environment at them
+func writeProfileFiles(t *testing.T, config, credentials string) {
+	t.Helper()
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	credentialsPath := filepath.Join(dir, "credentials")
+	if config != "" {
+		if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if credentials != "" {
+		if err := os.WriteFile(credentialsPath, []byte(credentials), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("AWS_CONFIG_FILE", configPath)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credentialsPath)
+}
+
+func TestListAvailableProfiles(t *testing.T) {
+	writeProfileFiles(t, `[default]
+region = us-east-1
+
+[profile foo]
+region = ap-northeast-1
+
+[ profile  bar ]
+[sso-session corp]
+sso_region = us-east-1
+`, `[default]
+aws_access_key_id = x
+
+[ci]
+aws_access_key_id = y
+`)
+
+	profiles, err := listAvailableProfiles()
+	if err != nil {
+		t.Fatalf("listAvailableProfiles() error = %v", err)
+	}
+	if want := []string{"bar", "ci", "default", "foo"}; !reflect.DeepEqual(profiles, want) {
+		t.Errorf("listAvailableProfiles() = %v, want %v", profiles, want)
+	}
+}
+
+func TestListAvailableProfiles_MissingFiles(t *testing.T) {
+	writeProfileFiles(t, "", "")
+
+	profiles, err := listAvailableProfiles()
+	if err != nil {
+		t.Fatalf("listAvailableProfiles() error = %v", err)
+	}
+	if len(profiles) != 0 {
+		t.Errorf("listAvailableProfiles() = %v, want empty", profiles)
+	}
+}
+
+func TestWriteProfiles(t *testing.T) {
+	var text bytes.Buffer
+	if err := writeProfiles(&text, "text", []string{"default", "foo"}, "default"); err != nil {
+		t.Fatalf("writeProfiles(text) error = %v", err)
+	}
+	if got, want := text.String(), "* default\n  foo\n"; got != want {
+		t.Errorf("writeProfiles(text) = %q, want %q", got, want)
+	}
+
+	var js bytes.Buffer
+	if err := writeProfiles(&js, "json", []string{"default", "foo"}, "foo"); err != nil {
+		t.Fatalf("writeProfiles(json) error = %v", err)
+	}
+	want := `[
+  {
+    "name": "default",
+    "active": false
+  },
+  {
+    "name": "foo",
+    "active": true
+  }
+]
+`
+	if js.String() != want {
+		t.Errorf("writeProfiles(json) = %q, want %q", js.String(), want)
+	}
+}
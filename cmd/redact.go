@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"s3t/internal/s3tables"
+)
+
+// redactingWriter masks the account ID of every ARN written through it.
+// Each Write is redacted on its own, so an ARN must not be split across writes;
+// fmt, tabwriter and encoding/json all write an ARN in a single call.
+type redactingWriter struct {
+	w io.Writer
+}
+
+func (r redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(r.w, s3tables.RedactARNs(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// redacted wraps w so that ARNs are redacted when --redact is set
+func redacted(w io.Writer) io.Writer {
+	if !redactOutput {
+		return w
+	}
+	return redactingWriter{w: w}
+}
+
+// displayTableARN returns the ARN of tbl truncated to maxLen characters. With
+// --redact the account ID is masked before truncation, since a truncated ARN may
+// lose the prefix that the redacting writer recognizes ARNs by.
+func displayTableARN(tbl s3tables.TableInfo, maxLen int) string {
+	if redactOutput {
+		tbl.ARN = s3tables.RedactARN(tbl.ARN)
+	}
+	return tbl.DisplayARN(maxLen)
+}
+
+// displayBucketARN returns the ARN of bucket like displayTableARN
+func displayBucketARN(bucket s3tables.TableBucketInfo, maxLen int) string {
+	if redactOutput {
+		bucket.ARN = s3tables.RedactARN(bucket.ARN)
+	}
+	return bucket.DisplayARN(maxLen)
+}
+
+// stdout returns the writer for rendered output, honoring --redact
+func stdout() io.Writer {
+	return redacted(os.Stdout)
+}
+
+// stderr returns the writer for human-readable decoration, honoring --redact
+func stderr() io.Writer {
+	return redacted(os.Stderr)
+}
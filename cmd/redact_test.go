@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"s3t/internal/s3tables"
+)
+
+// TestRedactedJSONOutput tests that -o json output is redacted through the writer when --redact is set
+func TestRedactedJSONOutput(t *testing.T) {
+	saved := redactOutput
+	t.Cleanup(func() { redactOutput = saved })
+
+	summary := &s3tables.BucketSummary{
+		TableBucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket",
+		OldestTable:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, redact := range []bool{false, true} {
+		redactOutput = redact
+		var buf bytes.Buffer
+		if err := writeSummary(redacted(&buf), "json", "my-bucket", summary); err != nil {
+			t.Fatalf("writeSummary() error = %v", err)
+		}
+		hasAccount := bytes.Contains(buf.Bytes(), []byte("123456789012"))
+		if hasAccount == redact {
+			t.Errorf("redact=%v: output = %s", redact, buf.String())
+		}
+		if redact && !bytes.Contains(buf.Bytes(), []byte("arn:aws:s3tables:us-east-1:************:bucket/my-bucket")) {
+			t.Errorf("redacted output lost the rest of the ARN: %s", buf.String())
+		}
+	}
+}
+
+// TestDisplayARNRedactsBeforeTruncating tests that truncated ARNs never show the account ID with --redact
+func TestDisplayARNRedactsBeforeTruncating(t *testing.T) {
+	saved := redactOutput
+	t.Cleanup(func() { redactOutput = saved })
+	redactOutput = true
+
+	tbl := s3tables.TableInfo{ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/0123456789abcdef"}
+	bucket := s3tables.TableBucketInfo{ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket"}
+	for _, maxLen := range []int{0, 20, 50, 200} {
+		for _, shown := range []string{displayTableARN(tbl, maxLen), displayBucketARN(bucket, maxLen)} {
+			if strings.Contains(shown, "123456789012") {
+				t.Errorf("maxLen=%d: shown ARN %q contains the account ID", maxLen, shown)
+			}
+		}
+	}
+	if got, want := displayTableARN(tbl, 0), "arn:aws:s3tables:us-east-1:************:bucket/my-bucket/table/0123456789abcdef"; got != want {
+		t.Errorf("displayTableARN() = %q, want %q", got, want)
+	}
+}
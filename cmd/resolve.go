@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var resolveARNCmd = &cobra.Command{
+	Use:   "resolve-arn <table-bucket> [namespace [table]]",
+	Short: "Print the ARN of a table bucket or table",
+	Long: `Print the fully-qualified ARN of a table, or of the table bucket for a
+bucket or namespace path. Namespaces have no ARN of their own, so a namespace
+path is checked to exist and its table bucket ARN is printed.
+
+The table bucket may be given by name or by ARN, and the whole path may be
+given as a single bucket/namespace/table argument. A table bucket ARN is
+checked to exist before it is printed.
+
+Examples:
+  s3t resolve-arn my-bucket
+  s3t resolve-arn my-bucket my-namespace my-table
+  s3t resolve-arn my-bucket/my-namespace/my-table
+  s3t resolve-arn arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket my-namespace`,
+	Args:              cobra.RangeArgs(1, 3),
+	ValidArgsFunction: completeResourceArgs,
+	RunE:              runResolveARN,
+}
+
+func init() {
+	rootCmd.AddCommand(resolveARNCmd)
+}
+
+func runResolveARN(cmd *cobra.Command, args []string) error {
+	path, err := resolvePathArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	resolved, err := newLister(client).ResolveARN(context.Background(), path)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(redacted(cmd.OutOrStdout()), resolved)
+	return err
+}
+
+// resolvePathArgs builds the path named by the resolve-arn arguments: a table
+// bucket name or ARN followed by an optional namespace and table, or a single
+// bucket/namespace/table argument
+func resolvePathArgs(args []string) (s3tables.ResourcePath, error) {
+	path := s3tables.ResourcePath{Bucket: args[0]}
+	switch {
+	case strings.HasPrefix(args[0], "arn:"):
+		resource, err := s3tables.ParseResourceARN(args[0])
+		if err != nil {
+			return s3tables.ResourcePath{}, err
+		}
+		if resource.TableID != "" {
+			return s3tables.ResourcePath{}, &s3tables.ValidationError{Field: "ARN", Message: fmt.Sprintf("'%s' is a table ARN, want a table bucket ARN", args[0])}
+		}
+		path.Bucket, path.BucketARN = resource.BucketName, resource.BucketARN()
+	case len(args) == 1:
+		return s3tables.ParseResourcePath(args[0])
+	}
+
+	if len(args) > 1 {
+		path.Namespace = args[1]
+	}
+	if len(args) > 2 {
+		path.Table = args[2]
+	}
+	return path, nil
+}
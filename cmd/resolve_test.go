@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"s3t/internal/s3tables"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3tables "github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestResolvePathArgs tests the argument forms accepted by resolve-arn
+func TestResolvePathArgs(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/sales"
+	tests := []struct {
+		name    string
+		args    []string
+		want    s3tables.ResourcePath
+		wantErr bool
+	}{
+		{name: "bucket", args: []string{"sales"}, want: s3tables.ResourcePath{Bucket: "sales"}},
+		{name: "namespace", args: []string{"sales", "orders"}, want: s3tables.ResourcePath{Bucket: "sales", Namespace: "orders"}},
+		{name: "table", args: []string{"sales", "orders", "daily"}, want: s3tables.ResourcePath{Bucket: "sales", Namespace: "orders", Table: "daily"}},
+		{name: "qualified namespace", args: []string{"sales/orders"}, want: s3tables.ResourcePath{Bucket: "sales", Namespace: "orders"}},
+		{name: "qualified table", args: []string{"sales/orders/daily"}, want: s3tables.ResourcePath{Bucket: "sales", Namespace: "orders", Table: "daily"}},
+		{name: "bucket ARN", args: []string{bucketARN, "orders"}, want: s3tables.ResourcePath{Bucket: "sales", BucketARN: bucketARN, Namespace: "orders"}},
+		{name: "table ARN", args: []string{bucketARN + "/table/0123"}, wantErr: true},
+		{name: "malformed path", args: []string{"sales//daily"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolvePathArgs(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("resolvePathArgs(%q) = %+v, want error", tt.args, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("resolvePathArgs(%q) = %+v, %v, want %+v", tt.args, got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestRunResolveARN tests the ARN printed for each path length
+func TestRunResolveARN(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/sales"
+	const tableARN = bucketARN + "/table/0123-abcd"
+	mock := &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{{Name: aws.String("sales"), Arn: aws.String(bucketARN)}}}, nil
+		},
+		getTableFunc: func(ctx context.Context, params *awss3tables.GetTableInput, optFns ...func(*awss3tables.Options)) (*awss3tables.GetTableOutput, error) {
+			return &awss3tables.GetTableOutput{Name: params.Name, TableARN: aws.String(tableARN)}, nil
+		},
+	}
+	savedClient := s3tablesClient
+	t.Cleanup(func() { s3tablesClient = savedClient })
+	SetS3TablesClient(mock)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"sales"}, bucketARN},
+		{[]string{"sales", "orders"}, bucketARN},
+		{[]string{"sales", "orders", "daily"}, tableARN},
+		{[]string{"sales/orders/daily"}, tableARN},
+		{[]string{bucketARN, "orders", "daily"}, tableARN},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		resolveARNCmd.SetOut(&buf)
+		if err := runResolveARN(resolveARNCmd, tt.args); err != nil {
+			t.Errorf("runResolveARN(%q) error = %v", tt.args, err)
+			continue
+		}
+		if got := buf.String(); got != tt.want+"\n" {
+			t.Errorf("runResolveARN(%q) printed %q, want %q", tt.args, got, tt.want)
+		}
+	}
+	resolveARNCmd.SetOut(nil)
+}
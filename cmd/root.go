@@ -3,8 +3,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	"github.com/spf13/cobra"
@@ -19,6 +23,55 @@ var (
 	// Global flags for AWS configuration
 	awsProfile string
 	awsRegion  string
+
+	// auditLogPath is the file that mutating operations append audit records to
+	auditLogPath string
+
+	// asciiOutput replaces decorative Unicode characters with plain ASCII
+	asciiOutput bool
+
+	// clientRegion is the region the S3 Tables client was configured with
+	clientRegion string
+
+	// awsConfig is the AWS configuration resolved by initAWSClient (used by show-config)
+	awsConfig aws.Config
+
+	// configPath is the config file given with --config ("" uses the default location)
+	configPath string
+
+	// strictARN fails on malformed table bucket ARNs returned by the API instead of warning
+	strictARN bool
+
+	// language selects the message catalog for error messages and suggestions
+	language string
+
+	// traceSpec selects SDK client log modes for --trace ("" disables tracing)
+	traceSpec string
+
+	// usePager pipes long output (tree, summary, list --all-tables) through $PAGER
+	usePager bool
+	// noPager disables the pager even when enabled by --pager or the config file
+	noPager bool
+
+	// interactive allows prompts; it defaults to false in CI environments
+	interactive bool
+	// promptTimeout exits interactive prompts left without input for this long (0: no timeout)
+	promptTimeout time.Duration
+	// glyphsSpec selects the prompt item markers: auto, unicode or ascii
+	glyphsSpec string
+	// promptGlyphs is the glyph set resolved from glyphsSpec, --ascii and the locale
+	promptGlyphs s3tablesinternal.Glyphs
+
+	// showTimings prints the total, API and interactive time to stderr after the command
+	showTimings bool
+	// commandTimings measures the running command when --timings is set (nil otherwise)
+	commandTimings *s3tablesinternal.Timings
+
+	// redactOutput masks account IDs in ARNs of rendered output
+	redactOutput bool
+
+	// randSeed makes retry jitter reproducible when set (for debugging bug reports)
+	randSeed int64
 )
 
 var rootCmd = &cobra.Command{
@@ -33,8 +86,33 @@ Configure your credentials using:
   - IAM roles (for EC2/ECS/Lambda)
 
 Global Options:
-  --profile  Use a specific AWS profile from ~/.aws/credentials or ~/.aws/config
-  --region   Override the AWS region for API calls
+  --config     Read default flag values from a YAML file (default ~/.config/s3t/config.yaml)
+  --profile    Use a specific AWS profile from ~/.aws/credentials or ~/.aws/config
+  --region     Override the AWS region for API calls
+  --audit-log  Append JSON-lines audit records of mutating operations to a file
+  --ascii      Use plain ASCII instead of Unicode bullets and tree connectors
+  --strict-arn Fail instead of warning on malformed table bucket ARNs
+  --lang       Language of error messages and suggestions (en, ja)
+  --pager      Pipe long output (tree, summary, list --all-tables) through $PAGER
+               (default "less -FRX") when stdout is a terminal; --no-pager disables it
+  --interactive Allow prompts (default true, false when a CI environment such
+               as CI or GITHUB_ACTIONS is detected); prompts then fail instead
+  --glyphs     Prompt markers: unicode ("▸", "✔") or ascii (">", "*"); the default
+               auto uses ascii with --ascii or when the locale is not UTF-8
+  --redact     Mask the 12-digit account ID in ARNs of all output, including
+               -o json (e.g. arn:aws:s3tables:us-east-1:************:bucket/b)
+  --timings    Print the total time of the command to stderr, split into
+               API calls, interactive prompts and everything else
+  --trace      Log raw AWS request/response metadata to stderr; select parts
+               with --trace=requests,responses,retries (credentials are redacted)
+
+Defaults for --profile, --region, --output, --lang, --audit-log, --ascii and
+--pager can be kept in the config file; environment variables (AWS_PROFILE,
+AWS_REGION, S3T_OUTPUT, S3T_LANG, S3T_AUDIT_LOG, S3T_ASCII) override it and
+command-line flags override both:
+  profile: dev
+  region: ap-northeast-1
+  output: csv
 
 Examples:
   # Use default credentials and region
@@ -55,7 +133,20 @@ Examples:
 
 // Execute runs the root command
 func Execute() error {
-	return rootCmd.Execute()
+	err := rootCmd.Execute()
+	if commandTimings != nil {
+		fmt.Fprintln(stderr(), commandTimings.Report())
+	}
+	return err
+}
+
+// FormatError renders a command error for the terminal. An S3TablesError lists
+// several suggestions one per line; other errors are printed as they are.
+func FormatError(err error) string {
+	if s3tErr, ok := err.(*s3tablesinternal.S3TablesError); ok {
+		return s3tErr.Detail()
+	}
+	return err.Error()
 }
 
 // buildConfigOptions creates config options based on profile and region flags.
@@ -74,12 +165,27 @@ func buildConfigOptions(profile, region string) []func(*config.LoadOptions) erro
 	return opts
 }
 
+// clientConfigOptions builds the config options for region from the global
+// --profile and --trace flags
+func clientConfigOptions(region string) ([]func(*config.LoadOptions) error, error) {
+	traceMode, err := parseTraceModes(traceSpec)
+	if err != nil {
+		return nil, err
+	}
+	opts := buildConfigOptions(awsProfile, region)
+	return append(opts, buildTraceOptions(traceMode, stderr())...), nil
+}
+
 // handleConfigError wraps AWS configuration errors with user-friendly messages.
 // When a profile is specified, it returns a profile-specific error message.
 // Otherwise, it returns a general configuration error message with guidance.
 func handleConfigError(err error, profile string) error {
 	if profile != "" {
-		return fmt.Errorf("failed to load AWS profile '%s': %w\n\nPlease ensure the profile exists in ~/.aws/credentials or ~/.aws/config", profile, err)
+		hint := "Please ensure the profile exists in ~/.aws/credentials or ~/.aws/config"
+		if profiles, listErr := listAvailableProfiles(); listErr == nil && len(profiles) > 0 {
+			hint += "\nAvailable profiles: " + strings.Join(profiles, ", ") + " (see 's3t profiles')"
+		}
+		return fmt.Errorf("failed to load AWS profile '%s': %w\n\n%s", profile, err, hint)
 	}
 	return fmt.Errorf("failed to load AWS configuration: %w\n\nPlease configure AWS credentials using:\n  - AWS CLI: aws configure\n  - Environment variables: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY\n  - IAM roles (for EC2/ECS/Lambda)", err)
 }
@@ -91,28 +197,123 @@ func initAWSClient(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if err := loadConfig(cmd); err != nil {
+		return err
+	}
+	if showTimings {
+		commandTimings = s3tablesinternal.NewTimings(nil)
+	}
+	resolveInteractive(cmd, os.Getenv)
+	if err := s3tablesinternal.SetLanguage(language); err != nil {
+		return err
+	}
+	glyphs, err := resolveGlyphs(glyphsSpec, asciiOutput, os.Getenv)
+	if err != nil {
+		return err
+	}
+	promptGlyphs = glyphs
+	if cmd.Flags().Changed("seed") {
+		s3tablesinternal.SetRandSource(rand.NewSource(randSeed))
+	}
+
 	ctx := context.Background()
 
 	// Build config options based on flags
-	configOpts := buildConfigOptions(awsProfile, awsRegion)
+	configOpts, err := clientConfigOptions(awsRegion)
+	if err != nil {
+		return err
+	}
 
 	// Load AWS configuration using default credential chain with options
 	cfg, err := config.LoadDefaultConfig(ctx, configOpts...)
 	if err != nil {
 		return handleConfigError(err, awsProfile)
 	}
+	if err := s3tablesinternal.CheckRegionFormat(ctx, cfg.Region); err != nil {
+		return err
+	}
 
 	// Create S3 Tables client
-	s3tablesClient = s3tables.NewFromConfig(cfg)
+	s3tablesClient = timedClient(s3tables.NewFromConfig(cfg))
+	clientRegion = cfg.Region
+	awsConfig = cfg
 
 	return nil
 }
 
+// newRegionalClient creates an S3 Tables client for the given region,
+// honoring the global --profile flag
+func newRegionalClient(ctx context.Context, region string) (s3tablesinternal.S3TablesAPI, error) {
+	configOpts, err := clientConfigOptions(region)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, configOpts...)
+	if err != nil {
+		return nil, handleConfigError(err, awsProfile)
+	}
+	return timedClient(s3tables.NewFromConfig(cfg)), nil
+}
+
+// timedClient wraps client to record API time when --timings is set
+func timedClient(client s3tablesinternal.S3TablesAPI) s3tablesinternal.S3TablesAPI {
+	if commandTimings == nil {
+		return client
+	}
+	return s3tablesinternal.NewTimedClient(client, commandTimings)
+}
+
+// openAuditLogger opens the audit log configured via --audit-log.
+// Returns a nil logger when auditing is disabled or the file cannot be opened;
+// in the latter case a warning is printed and the operation continues.
+func openAuditLogger() (*s3tablesinternal.AuditLogger, func()) {
+	if auditLogPath == "" {
+		return nil, func() {}
+	}
+	audit, closer, err := s3tablesinternal.OpenAuditLog(auditLogPath)
+	if err != nil {
+		fmt.Fprintf(stderr(), "Warning: %v\n", err)
+		return nil, func() {}
+	}
+	return audit, func() { _ = closer.Close() }
+}
+
+// bullet returns the list bullet for human-readable output, honoring --ascii
+func bullet() string {
+	if asciiOutput {
+		return "-"
+	}
+	return "\u2022"
+}
+
+// currentAccountID returns the account ID of the configured credentials, or ""
+// when the credential provider does not report one
+func currentAccountID() string {
+	if awsConfig.Credentials == nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), credentialSourceTimeout)
+	defer cancel()
+	creds, err := awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return ""
+	}
+	return creds.AccountID
+}
+
 // getS3TablesClient returns the initialized S3 Tables client
 func getS3TablesClient() s3tablesinternal.S3TablesAPI {
 	return s3tablesClient
 }
 
+// newLister creates a lister for client configured with the global flags
+func newLister(client s3tablesinternal.S3TablesAPI) *s3tablesinternal.S3TablesLister {
+	lister := s3tablesinternal.NewS3TablesLister(client)
+	lister.SetStrictARN(strictARN)
+	lister.SetWarningOutput(stderr())
+	return lister
+}
+
 // SetS3TablesClient sets the S3 Tables client (useful for testing)
 func SetS3TablesClient(client s3tablesinternal.S3TablesAPI) {
 	s3tablesClient = client
@@ -122,6 +323,22 @@ func init() {
 	// Add global flags
 	rootCmd.PersistentFlags().StringVar(&awsProfile, "profile", "", "AWS profile name to use for authentication")
 	rootCmd.PersistentFlags().StringVar(&awsRegion, "region", "", "AWS region to use for API calls")
+	rootCmd.PersistentFlags().BoolVar(&asciiOutput, "ascii", false, "Use plain ASCII instead of Unicode bullets and tree connectors")
+	rootCmd.PersistentFlags().StringVar(&auditLogPath, "audit-log", "", "Append JSON-lines audit records of mutating operations to this file")
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Config file with default flag values (default ~/.config/s3t/config.yaml)")
+	rootCmd.PersistentFlags().BoolVar(&strictARN, "strict-arn", false, "Fail instead of warning when the API returns a malformed table bucket ARN")
+	rootCmd.PersistentFlags().StringVar(&language, "lang", s3tablesinternal.DefaultLanguage, "Language of error messages and suggestions ("+strings.Join(s3tablesinternal.Languages(), ", ")+")")
+	rootCmd.PersistentFlags().StringVar(&traceSpec, "trace", "", "Log raw AWS requests/responses/retries to stderr (requests, responses, retries, all)")
+	rootCmd.PersistentFlags().Lookup("trace").NoOptDefVal = traceAll
+	rootCmd.PersistentFlags().BoolVar(&usePager, "pager", false, "Pipe long output through $PAGER when stdout is a terminal")
+	rootCmd.PersistentFlags().BoolVar(&noPager, "no-pager", false, "Never pipe output through a pager")
+	rootCmd.PersistentFlags().BoolVar(&showTimings, "timings", false, "Print the total, API and interactive time of the command to stderr")
+	rootCmd.PersistentFlags().BoolVar(&redactOutput, "redact", false, "Mask the account ID in ARNs of rendered output (for sharing in bug reports)")
+	rootCmd.PersistentFlags().BoolVar(&interactive, "interactive", true, "Allow interactive prompts (defaults to false in CI environments)")
+	rootCmd.PersistentFlags().StringVar(&glyphsSpec, "glyphs", glyphsAuto, "Markers of the active and selected prompt items: auto (ASCII with --ascii or a non-UTF-8 locale), unicode or ascii")
+	rootCmd.PersistentFlags().DurationVar(&promptTimeout, "prompt-timeout", 0, "Exit an interactive prompt after this long without input, e.g. 5m (0: no timeout)")
+	rootCmd.PersistentFlags().Int64Var(&randSeed, "seed", 0, "Seed for randomized behavior such as retry jitter (for reproducing bugs)")
+	_ = rootCmd.PersistentFlags().MarkHidden("seed")
 
 	// Add version flag
 	rootCmd.Version = "0.1.0"
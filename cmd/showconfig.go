@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/spf13/cobra"
+)
+
+var showConfigCmd = &cobra.Command{
+	Use:   "show-config",
+	Short: "Show the effective configuration after all precedence rules",
+	Long: `Show the region, profile, credential source, endpoint and list output format
+that s3t would use, together with where each value came from (flag, environment
+variable, config file, AWS shared config or default).
+
+No S3 Tables API is called. Credentials are resolved to report their source,
+which may contact the credential provider (e.g. SSO or instance metadata).
+
+Examples:
+  s3t show-config
+  s3t --profile dev --region us-west-2 show-config -o json`,
+	Args: cobra.NoArgs,
+	RunE: runShowConfig,
+}
+
+var (
+	// showConfigOutput is the output format: text or json
+	showConfigOutput string
+)
+
+// credentialSourceTimeout bounds credential resolution so that an unreachable provider does not block
+const credentialSourceTimeout = 5 * time.Second
+
+func init() {
+	showConfigCmd.Flags().StringVarP(&showConfigOutput, "output", "o", "text", "Output format: text or json")
+	rootCmd.AddCommand(showConfigCmd)
+}
+
+// effectiveConfig is the configuration in effect and the source of each value
+type effectiveConfig struct {
+	ConfigFile       string `json:"config_file"`
+	Region           string `json:"region"`
+	RegionSource     string `json:"region_source"`
+	Profile          string `json:"profile"`
+	ProfileSource    string `json:"profile_source"`
+	CredentialSource string `json:"credential_source"`
+	Endpoint         string `json:"endpoint"`
+	Output           string `json:"output"`
+	OutputSource     string `json:"output_source"`
+}
+
+func runShowConfig(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialSourceTimeout)
+	defer cancel()
+
+	effective := resolveEffectiveConfig(ctx, awsConfig, awsProfile, os.Getenv)
+	return writeEffectiveConfig(stdout(), showConfigOutput, effective)
+}
+
+// resolveEffectiveConfig combines the resolved AWS config with the sources recorded
+// by loadConfig. Values that neither a flag, the environment nor the config file
+// set are attributed to the AWS shared config or to the default.
+func resolveEffectiveConfig(ctx context.Context, cfg aws.Config, profile string, getenv func(string) string) effectiveConfig {
+	effective := effectiveConfig{
+		ConfigFile:       loadedConfigPath,
+		Region:           cfg.Region,
+		RegionSource:     configSources["region"],
+		Profile:          profile,
+		ProfileSource:    configSources["profile"],
+		CredentialSource: credentialSource(ctx, cfg),
+		Endpoint:         aws.ToString(cfg.BaseEndpoint),
+	}
+
+	if effective.RegionSource == "" {
+		effective.RegionSource = "AWS shared config"
+		if cfg.Region == "" {
+			effective.Region, effective.RegionSource = "(none)", "unset"
+		}
+	}
+	if effective.Profile == "" {
+		if effective.Profile = getenv("AWS_PROFILE"); effective.Profile != "" {
+			effective.ProfileSource = "env AWS_PROFILE"
+		}
+	}
+	if effective.Profile == "" {
+		effective.Profile, effective.ProfileSource = "default", sourceDefault
+	}
+	if effective.Endpoint == "" {
+		effective.Endpoint = fmt.Sprintf("https://s3tables.%s.amazonaws.com (SDK default)", cfg.Region)
+	}
+
+	for _, setting := range configSettings {
+		if setting.flag == "output" {
+			effective.Output, effective.OutputSource = settingValue(setting, loadedConfig, getenv)
+		}
+	}
+	if effective.Output == "" {
+		effective.Output, effective.OutputSource = outputFormatTable, sourceDefault
+	}
+	return effective
+}
+
+// credentialSource retrieves the credentials of cfg and returns the provider that supplied them
+func credentialSource(ctx context.Context, cfg aws.Config) string {
+	if cfg.Credentials == nil {
+		return "(none)"
+	}
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Sprintf("unavailable (%v)", err)
+	}
+	if creds.Source == "" {
+		return "(unknown)"
+	}
+	return creds.Source
+}
+
+// writeEffectiveConfig writes effective in the given format: text or json
+func writeEffectiveConfig(w io.Writer, format string, effective effectiveConfig) error {
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		configFile := effective.ConfigFile
+		if configFile == "" {
+			configFile = "(none)"
+		}
+		fmt.Fprintf(tw, "config file\t%s\t\n", configFile)
+		fmt.Fprintf(tw, "region\t%s\t(%s)\n", effective.Region, effective.RegionSource)
+		fmt.Fprintf(tw, "profile\t%s\t(%s)\n", effective.Profile, effective.ProfileSource)
+		fmt.Fprintf(tw, "credentials\t%s\t\n", effective.CredentialSource)
+		fmt.Fprintf(tw, "endpoint\t%s\t\n", effective.Endpoint)
+		fmt.Fprintf(tw, "output\t%s\t(%s)\n", effective.Output, effective.OutputSource)
+		return tw.Flush()
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(effective)
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", format)
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// TestResolveEffectiveConfig tests the reported values and sources for flag, env and config file inputs
+func TestResolveEffectiveConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      *fileConfig
+		env       map[string]string
+		args      []string
+		sdkRegion string // cfg.Region as the SDK would resolve it
+		want      effectiveConfig
+	}{
+		{
+			name:      "flags win over the config file",
+			file:      &fileConfig{Profile: "file-profile", Region: "ap-northeast-1"},
+			args:      []string{"--profile", "dev", "--region", "us-west-2"},
+			sdkRegion: "us-west-2",
+			want: effectiveConfig{
+				Region: "us-west-2", RegionSource: sourceFlag,
+				Profile: "dev", ProfileSource: sourceFlag,
+				Output: outputFormatTable, OutputSource: sourceDefault,
+			},
+		},
+		{
+			name:      "config file values",
+			file:      &fileConfig{Profile: "file-profile", Region: "ap-northeast-1", Output: "csv"},
+			sdkRegion: "ap-northeast-1",
+			want: effectiveConfig{
+				Region: "ap-northeast-1", RegionSource: sourceConfigFile,
+				Profile: "file-profile", ProfileSource: sourceConfigFile,
+				Output: "csv", OutputSource: sourceConfigFile,
+			},
+		},
+		{
+			name:      "environment overrides the config file",
+			file:      &fileConfig{Region: "ap-northeast-1", Output: "csv"},
+			env:       map[string]string{"AWS_REGION": "eu-west-1", "AWS_PROFILE": "env-profile", "S3T_OUTPUT": "table"},
+			sdkRegion: "eu-west-1",
+			want: effectiveConfig{
+				Region: "eu-west-1", RegionSource: "env AWS_REGION",
+				Profile: "env-profile", ProfileSource: "env AWS_PROFILE",
+				Output: "table", OutputSource: "env S3T_OUTPUT",
+			},
+		},
+		{
+			name:      "nothing set",
+			file:      &fileConfig{},
+			sdkRegion: "us-east-1",
+			want: effectiveConfig{
+				Region: "us-east-1", RegionSource: "AWS shared config",
+				Profile: "default", ProfileSource: sourceDefault,
+				Output: outputFormatTable, OutputSource: sourceDefault,
+			},
+		},
+	}
+
+	savedConfig, savedPath, savedSources := loadedConfig, loadedConfigPath, configSources
+	t.Cleanup(func() { loadedConfig, loadedConfigPath, configSources = savedConfig, savedPath, savedSources })
+	loadedConfigPath = "/home/user/.config/s3t/config.yaml"
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newConfigTestCommand()
+			cmd.Use = "show-config"
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatalf("failed to parse args: %v", err)
+			}
+			getenv := func(key string) string { return tt.env[key] }
+			if err := applyConfig(cmd, tt.file, getenv); err != nil {
+				t.Fatalf("applyConfig() error = %v", err)
+			}
+			loadedConfig = tt.file
+
+			cfg := aws.Config{
+				Region: tt.sdkRegion,
+				Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+					return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", Source: "SharedConfigCredentials"}, nil
+				}),
+			}
+			profile := cmd.Flags().Lookup("profile").Value.String()
+
+			got := resolveEffectiveConfig(context.Background(), cfg, profile, getenv)
+			tt.want.ConfigFile = loadedConfigPath
+			tt.want.CredentialSource = "SharedConfigCredentials"
+			tt.want.Endpoint = "https://s3tables." + tt.sdkRegion + ".amazonaws.com (SDK default)"
+			if got != tt.want {
+				t.Errorf("resolveEffectiveConfig() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWriteEffectiveConfigJSON tests the JSON field names of show-config
+func TestWriteEffectiveConfigJSON(t *testing.T) {
+	var buf bytes.Buffer
+	effective := effectiveConfig{Region: "us-east-1", RegionSource: sourceFlag, Profile: "dev"}
+	if err := writeEffectiveConfig(&buf, "json", effective); err != nil {
+		t.Fatalf("writeEffectiveConfig() error = %v", err)
+	}
+
+	var decoded map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded["region"] != "us-east-1" || decoded["region_source"] != sourceFlag || decoded["profile"] != "dev" {
+		t.Errorf("decoded = %v", decoded)
+	}
+	if err := writeEffectiveConfig(&buf, "yaml", effective); err == nil {
+		t.Error("writeEffectiveConfig(yaml) error = nil, want unknown format")
+	}
+}
+
+// TestResolveEffectiveConfigProfileFromEnvironment tests that a profile taken from
+// AWS_PROFILE is attributed to it even when no source was recorded
+func TestResolveEffectiveConfigProfileFromEnvironment(t *testing.T) {
+	savedSources := configSources
+	t.Cleanup(func() { configSources = savedSources })
+	configSources = map[string]string{}
+
+	getenv := func(key string) string { return map[string]string{"AWS_PROFILE": "env-profile"}[key] }
+	got := resolveEffectiveConfig(context.Background(), aws.Config{Region: "us-east-1"}, "", getenv)
+	if got.Profile != "env-profile" || got.ProfileSource != "env AWS_PROFILE" {
+		t.Errorf("profile = %q (%s), want env-profile (env AWS_PROFILE)", got.Profile, got.ProfileSource)
+	}
+}
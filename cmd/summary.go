@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var summaryCmd = &cobra.Command{
+	Use:   "summary <table-bucket>",
+	Short: "Show a compact dashboard of a table bucket",
+	Long: `Show the number of namespaces and tables in a table bucket, the tables by
+type, and the creation times of the oldest and newest tables.
+
+Pass "-" to read table bucket names from stdin, one per line, and summarize
+each of them followed by the total. Blank lines are skipped, and anything
+after a tab is ignored so that "s3t list --plain --include-arns" can be piped
+in. A bucket that fails is reported on stderr without stopping the others.
+
+Examples:
+  s3t summary my-bucket
+  s3t summary my-bucket -o json
+  s3t list --plain | s3t summary -`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeResourceArgs,
+	RunE:              runSummary,
+}
+
+var (
+	// summaryOutput is the output format: text or json
+	summaryOutput string
+
+	// summaryStdin is where bucket names are read from when the argument is "-"
+	summaryStdin io.Reader = os.Stdin
+)
+
+func init() {
+	summaryCmd.Flags().StringVarP(&summaryOutput, "output", "o", "text", "Output format: text or json")
+	rootCmd.AddCommand(summaryCmd)
+}
+
+func runSummary(cmd *cobra.Command, args []string) error {
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	ctx := context.Background()
+	lister := newLister(client)
+
+	if args[0] == "-" {
+		names, err := readBucketNames(summaryStdin)
+		if err != nil {
+			return fmt.Errorf("failed to read table bucket names from stdin: %w", err)
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("no table bucket names on stdin")
+		}
+		out, closePager := stdoutPaged()
+		defer closePager()
+		return summarizeBuckets(ctx, lister, names, out, stderr())
+	}
+
+	bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	summary, err := lister.SummarizeBucket(ctx, bucketARN)
+	if err != nil {
+		return err
+	}
+	out, closePager := stdoutPaged()
+	defer closePager()
+	return writeSummary(out, summaryOutput, args[0], summary)
+}
+
+// writeSummary prints the summary of the named bucket in the given format
+func writeSummary(w io.Writer, format, bucketName string, summary *s3tables.BucketSummary) error {
+	switch format {
+	case "text":
+		fmt.Fprintf(w, "Table Bucket: %s\n", bucketName)
+		return writeSummaryFields(w, summary)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(namedSummary{bucketName, summary})
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", format)
+	}
+}
+
+// namedSummary is the JSON form of a bucket summary together with the bucket name
+type namedSummary struct {
+	TableBucket string `json:"table_bucket"`
+	*s3tables.BucketSummary
+}
+
+// bucketsTotal is the JSON form of the total over several bucket summaries
+type bucketsTotal struct {
+	TableBuckets int `json:"table_buckets"`
+	*s3tables.BucketSummary
+}
+
+// writeSummaryFields prints the indented text lines of summary
+func writeSummaryFields(w io.Writer, summary *s3tables.BucketSummary) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "  Namespaces:\t%d\n", summary.Namespaces)
+	fmt.Fprintf(tw, "  Tables:\t%d\n", summary.Tables)
+	types := make([]string, 0, len(summary.TablesByType))
+	for t := range summary.TablesByType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		fmt.Fprintf(tw, "    %s:\t%d\n", t, summary.TablesByType[t])
+	}
+	if !summary.OldestTable.IsZero() {
+		fmt.Fprintf(tw, "  Oldest:\t%s\n", s3tables.FormatTime(summary.OldestTable, s3tables.DisplayTimeLayout))
+		fmt.Fprintf(tw, "  Newest:\t%s\n", s3tables.FormatTime(summary.NewestTable, s3tables.DisplayTimeLayout))
+	}
+	return tw.Flush()
+}
+
+// readBucketNames reads one table bucket name per line from r.
+// Blank lines are skipped and anything after a tab is dropped, so the
+// output of "list --plain --include-arns" can be fed in directly.
+func readBucketNames(r io.Reader) ([]string, error) {
+	var names []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name, _, _ := strings.Cut(scanner.Text(), "\t")
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, scanner.Err()
+}
+
+// summarizeBuckets summarizes every named bucket and the total of those that succeeded.
+// A bucket that fails is reported to errOut and skipped; the returned error counts the failures.
+func summarizeBuckets(ctx context.Context, lister *s3tables.S3TablesLister, names []string, w, errOut io.Writer) error {
+	var summaries []namedSummary
+	total := &s3tables.BucketSummary{TablesByType: make(map[string]int)}
+	for _, name := range names {
+		summary, err := summarizeBucket(ctx, lister, name)
+		if err != nil {
+			fmt.Fprintf(errOut, "Error: table bucket '%s': %v\n", name, err)
+			continue
+		}
+		summaries = append(summaries, namedSummary{name, summary})
+		total.Merge(summary)
+	}
+
+	if err := writeBucketSummaries(w, summaryOutput, summaries, total); err != nil {
+		return err
+	}
+	if failed := len(names) - len(summaries); failed > 0 {
+		return fmt.Errorf("failed to summarize %d of %d table bucket(s)", failed, len(names))
+	}
+	return nil
+}
+
+// summarizeBucket resolves the named bucket and summarizes it
+func summarizeBucket(ctx context.Context, lister *s3tables.S3TablesLister, name string) (*s3tables.BucketSummary, error) {
+	bucketARN, err := lister.GetTableBucketARN(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return lister.SummarizeBucket(ctx, bucketARN)
+}
+
+// writeBucketSummaries prints every bucket summary followed by the total in the given format
+func writeBucketSummaries(w io.Writer, format string, summaries []namedSummary, total *s3tables.BucketSummary) error {
+	switch format {
+	case "text":
+		for _, s := range summaries {
+			if err := writeSummary(w, format, s.TableBucket, s.BucketSummary); err != nil {
+				return err
+			}
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "Total: %d table bucket(s)\n", len(summaries))
+		return writeSummaryFields(w, total)
+	case "json":
+		if summaries == nil {
+			summaries = []namedSummary{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Buckets []namedSummary `json:"buckets"`
+			Total   bucketsTotal   `json:"total"`
+		}{summaries, bucketsTotal{len(summaries), total}})
+	default:
+		return fmt.Errorf("unknown output format '%s' (available: text, json)", format)
+	}
+}
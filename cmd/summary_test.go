@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"s3t/internal/s3tables"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3tables "github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestWriteSummary tests the text and JSON renderings of a bucket summary
+func TestWriteSummary(t *testing.T) {
+	summary := &s3tables.BucketSummary{
+		TableBucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket",
+		Namespaces:     2,
+		Tables:         3,
+		TablesByType:   map[string]int{"customer": 2, "aws": 1},
+		OldestTable:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		NewestTable:    time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC),
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeSummary(&buf, "text", "my-bucket", summary); err != nil {
+			t.Fatalf("writeSummary() error = %v", err)
+		}
+		want := "Table Bucket: my-bucket\n" +
+			"  Namespaces:  2\n" +
+			"  Tables:      3\n" +
+			"    aws:       1\n" +
+			"    customer:  2\n" +
+			"  Oldest:      2024-01-02 03:04:05\n" +
+			"  Newest:      2025-03-04 05:06:07\n"
+		if buf.String() != want {
+			t.Errorf("writeSummary() =\n%s\nwant\n%s", buf.String(), want)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeSummary(&buf, "json", "my-bucket", summary); err != nil {
+			t.Fatalf("writeSummary() error = %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+		}
+		if got["table_bucket"] != "my-bucket" || got["tables"] != float64(3) || got["oldest_table_at"] != "2024-01-02T03:04:05Z" {
+			t.Errorf("writeSummary() JSON = %v", got)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if err := writeSummary(&bytes.Buffer{}, "yaml", "my-bucket", summary); err == nil {
+			t.Error("writeSummary() expected error for unknown format")
+		}
+	})
+}
+
+// TestRunSummaryFromStdin tests summarizing bucket names piped on stdin, skipping blank
+// lines and reporting a failing bucket without stopping the others
+func TestRunSummaryFromStdin(t *testing.T) {
+	bucketARN := func(name string) string { return "arn:aws:s3tables:us-east-1:123456789012:bucket/" + name }
+	tablesByBucket := map[string][]string{
+		bucketARN("bucket-a"): {"orders", "refunds"},
+		bucketARN("bucket-b"): {"events"},
+	}
+	mock := &mockS3TablesAPI{
+		listTableBucketsFunc: func(ctx context.Context, params *awss3tables.ListTableBucketsInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTableBucketsOutput, error) {
+			var buckets []types.TableBucketSummary
+			for _, name := range []string{"bucket-a", "bucket-b"} {
+				if strings.HasPrefix(name, aws.ToString(params.Prefix)) {
+					buckets = append(buckets, types.TableBucketSummary{Name: aws.String(name), Arn: aws.String(bucketARN(name))})
+				}
+			}
+			return &awss3tables.ListTableBucketsOutput{TableBuckets: buckets}, nil
+		},
+		listNamespacesFunc: func(ctx context.Context, params *awss3tables.ListNamespacesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListNamespacesOutput, error) {
+			return &awss3tables.ListNamespacesOutput{Namespaces: []types.NamespaceSummary{{Namespace: []string{"ns"}}}}, nil
+		},
+		listTablesFunc: func(ctx context.Context, params *awss3tables.ListTablesInput, optFns ...func(*awss3tables.Options)) (*awss3tables.ListTablesOutput, error) {
+			var tables []types.TableSummary
+			for _, name := range tablesByBucket[aws.ToString(params.TableBucketARN)] {
+				tables = append(tables, types.TableSummary{Name: aws.String(name), Type: types.TableTypeCustomer})
+			}
+			return &awss3tables.ListTablesOutput{Tables: tables}, nil
+		},
+	}
+
+	savedClient, savedStdin, savedOutput := s3tablesClient, summaryStdin, summaryOutput
+	t.Cleanup(func() { s3tablesClient, summaryStdin, summaryOutput = savedClient, savedStdin, savedOutput })
+	SetS3TablesClient(mock)
+	summaryOutput = "json"
+	summaryStdin = strings.NewReader("bucket-a\n\n  \nmissing\nbucket-b\tarn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b\n")
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	names, err := readBucketNames(summaryStdin)
+	if err != nil {
+		t.Fatalf("readBucketNames() error = %v", err)
+	}
+	if want := []string{"bucket-a", "missing", "bucket-b"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("readBucketNames() = %v, want %v", names, want)
+	}
+
+	err = summarizeBuckets(context.Background(), newLister(mock), names, &stdoutBuf, &stderrBuf)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3") {
+		t.Errorf("summarizeBuckets() error = %v, want 1 of 3 failed", err)
+	}
+	if !strings.Contains(stderrBuf.String(), "table bucket 'missing'") {
+		t.Errorf("stderr = %q, want the missing bucket reported", stderrBuf.String())
+	}
+
+	var got struct {
+		Buckets []struct {
+			TableBucket string `json:"table_bucket"`
+			Tables      int    `json:"tables"`
+		} `json:"buckets"`
+		Total struct {
+			TableBuckets int `json:"table_buckets"`
+			Namespaces   int `json:"namespaces"`
+			Tables       int `json:"tables"`
+		} `json:"total"`
+	}
+	if err := json.Unmarshal(stdoutBuf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", stdoutBuf.String(), err)
+	}
+	if len(got.Buckets) != 2 || got.Buckets[0].TableBucket != "bucket-a" || got.Buckets[0].Tables != 2 ||
+		got.Buckets[1].TableBucket != "bucket-b" || got.Buckets[1].Tables != 1 {
+		t.Errorf("buckets = %+v, want bucket-a with 2 tables and bucket-b with 1", got.Buckets)
+	}
+	if got.Total.TableBuckets != 2 || got.Total.Namespaces != 2 || got.Total.Tables != 3 {
+		t.Errorf("total = %+v, want 2 buckets, 2 namespaces, 3 tables", got.Total)
+	}
+}
+
+// TestWriteBucketSummariesText tests the text rendering of several buckets and the total
+func TestWriteBucketSummariesText(t *testing.T) {
+	a := &s3tables.BucketSummary{Namespaces: 1, Tables: 2, TablesByType: map[string]int{"customer": 2}}
+	b := &s3tables.BucketSummary{Namespaces: 1, Tables: 1, TablesByType: map[string]int{"customer": 1}}
+	total := &s3tables.BucketSummary{}
+	total.Merge(a)
+	total.Merge(b)
+
+	var buf bytes.Buffer
+	if err := writeBucketSummaries(&buf, "text", []namedSummary{{"bucket-a", a}, {"bucket-b", b}}, total); err != nil {
+		t.Fatalf("writeBucketSummaries() error = %v", err)
+	}
+	want := "Table Bucket: bucket-a\n" +
+		"  Namespaces:  1\n" +
+		"  Tables:      2\n" +
+		"    customer:  2\n" +
+		"\n" +
+		"Table Bucket: bucket-b\n" +
+		"  Namespaces:  1\n" +
+		"  Tables:      1\n" +
+		"    customer:  1\n" +
+		"\n" +
+		"Total: 2 table bucket(s)\n" +
+		"  Namespaces:  2\n" +
+		"  Tables:      3\n" +
+		"    customer:  3\n"
+	if buf.String() != want {
+		t.Errorf("writeBucketSummaries() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
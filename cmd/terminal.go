@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// TerminalInfo describes what the attached standard streams support.
+// It is detected once per process; features read it instead of probing file descriptors themselves.
+type TerminalInfo struct {
+	StdoutTTY bool
+	StderrTTY bool
+	StdinTTY  bool
+	Width     int // stdout の桁数（不明な場合は 0）
+}
+
+// terminalDetector abstracts terminal probing so tests can inject fake descriptors
+type terminalDetector interface {
+	IsTerminal(fd int) bool
+	Width(fd int) (int, error)
+}
+
+// xtermDetector probes real file descriptors with golang.org/x/term
+type xtermDetector struct{}
+
+func (xtermDetector) IsTerminal(fd int) bool { return term.IsTerminal(fd) }
+
+func (xtermDetector) Width(fd int) (int, error) {
+	width, _, err := term.GetSize(fd)
+	return width, err
+}
+
+// detectTerminal builds TerminalInfo for the given stdin/stdout/stderr descriptors
+func detectTerminal(d terminalDetector, stdinFd, stdoutFd, stderrFd int) TerminalInfo {
+	info := TerminalInfo{
+		StdinTTY:  d.IsTerminal(stdinFd),
+		StdoutTTY: d.IsTerminal(stdoutFd),
+		StderrTTY: d.IsTerminal(stderrFd),
+	}
+	if info.StdoutTTY {
+		if width, err := d.Width(stdoutFd); err == nil && width > 0 {
+			info.Width = width
+		}
+	}
+	return info
+}
+
+var (
+	terminalOnce sync.Once
+	terminalInfo TerminalInfo
+)
+
+// terminal returns the TerminalInfo of the current process, detecting it on first use
+func terminal() TerminalInfo {
+	terminalOnce.Do(func() {
+		terminalInfo = detectTerminal(xtermDetector{}, int(os.Stdin.Fd()), int(os.Stdout.Fd()), int(os.Stderr.Fd()))
+	})
+	return terminalInfo
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"s3t/internal/s3tables"
+)
+
+// fakeDetector reports fixed terminal state per file descriptor
+type fakeDetector struct {
+	ttys     map[int]bool
+	width    int
+	widthErr error
+}
+
+func (f fakeDetector) IsTerminal(fd int) bool { return f.ttys[fd] }
+
+func (f fakeDetector) Width(fd int) (int, error) { return f.width, f.widthErr }
+
+// TestDetectTerminal tests TerminalInfo construction from injected descriptors
+func TestDetectTerminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		detector fakeDetector
+		want     TerminalInfo
+	}{
+		{
+			name:     "all terminals",
+			detector: fakeDetector{ttys: map[int]bool{0: true, 1: true, 2: true}, width: 120},
+			want:     TerminalInfo{StdinTTY: true, StdoutTTY: true, StderrTTY: true, Width: 120},
+		},
+		{
+			name:     "stdout piped",
+			detector: fakeDetector{ttys: map[int]bool{0: true, 2: true}, width: 120},
+			want:     TerminalInfo{StdinTTY: true, StderrTTY: true},
+		},
+		{
+			name:     "width unavailable",
+			detector: fakeDetector{ttys: map[int]bool{1: true}, widthErr: errors.New("no size")},
+			want:     TerminalInfo{StdoutTTY: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectTerminal(tt.detector, 0, 1, 2); got != tt.want {
+				t.Errorf("detectTerminal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestARNDisplayWidthFor tests that truncated ARNs follow the detected terminal width
+func TestARNDisplayWidthFor(t *testing.T) {
+	orig := listTruncateARN
+	defer func() { listTruncateARN = orig }()
+
+	tests := []struct {
+		name     string
+		truncate bool
+		info     TerminalInfo
+		want     int
+	}{
+		{name: "truncation off", truncate: false, info: TerminalInfo{StdoutTTY: true, Width: 100}, want: 0},
+		{name: "not a terminal", truncate: true, info: TerminalInfo{}, want: s3tables.DefaultARNDisplayWidth},
+		{name: "terminal width", truncate: true, info: TerminalInfo{StdoutTTY: true, Width: 100}, want: 100 - arnLabelWidth},
+		{name: "narrow terminal", truncate: true, info: TerminalInfo{StdoutTTY: true, Width: 25}, want: minARNDisplayWidth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listTruncateARN = tt.truncate
+			if got := arnDisplayWidthFor(tt.info); got != tt.want {
+				t.Errorf("arnDisplayWidthFor(%+v) = %d, want %d", tt.info, got, tt.want)
+			}
+		})
+	}
+}
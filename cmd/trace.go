@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/smithy-go/logging"
+)
+
+// traceAll is the --trace value used when the flag is given without sub-values
+const traceAll = "all"
+
+// traceModes maps --trace sub-values to SDK client log modes
+var traceModes = map[string]aws.ClientLogMode{
+	"requests":  aws.LogRequest,
+	"responses": aws.LogResponse,
+	"retries":   aws.LogRetries,
+	traceAll:    aws.LogRequest | aws.LogResponse | aws.LogRetries,
+}
+
+// parseTraceModes converts a comma-separated --trace value into a ClientLogMode.
+// An empty value disables tracing.
+func parseTraceModes(value string) (aws.ClientLogMode, error) {
+	var mode aws.ClientLogMode
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		m, ok := traceModes[part]
+		if !ok {
+			return 0, fmt.Errorf("invalid --trace value '%s': must be one of requests, responses, retries, all", part)
+		}
+		mode |= m
+	}
+	return mode, nil
+}
+
+// buildTraceOptions returns the config options enabling SDK client logging for mode,
+// written to w with credentials redacted. No options are returned when mode is zero.
+func buildTraceOptions(mode aws.ClientLogMode, w io.Writer) []func(*config.LoadOptions) error {
+	if mode == 0 {
+		return nil
+	}
+	return []func(*config.LoadOptions) error{
+		config.WithClientLogMode(mode),
+		config.WithLogger(&redactingLogger{out: w}),
+	}
+}
+
+// sensitiveHeaderPattern matches header lines carrying credentials in SDK request dumps
+var sensitiveHeaderPattern = regexp.MustCompile(`(?im)^(authorization|x-amz-security-token):[^\r\n]*`)
+
+// redactSensitiveHeaders replaces the values of credential-bearing headers
+func redactSensitiveHeaders(s string) string {
+	return sensitiveHeaderPattern.ReplaceAllString(s, "$1: [REDACTED]")
+}
+
+// redactingLogger is an SDK logger that strips credentials before writing
+type redactingLogger struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// Logf implements logging.Logger
+func (l *redactingLogger) Logf(classification logging.Classification, format string, v ...interface{}) {
+	line := redactSensitiveHeaders(fmt.Sprintf(format, v...))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.out, "[trace] %s %s\n", classification, line)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/smithy-go/logging"
+)
+
+// TestParseTraceModes tests the --trace value to ClientLogMode mapping
+func TestParseTraceModes(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    aws.ClientLogMode
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "all", want: aws.LogRequest | aws.LogResponse | aws.LogRetries},
+		{value: "requests", want: aws.LogRequest},
+		{value: "requests,responses", want: aws.LogRequest | aws.LogResponse},
+		{value: " Retries , requests ", want: aws.LogRetries | aws.LogRequest},
+		{value: "requests,bodies", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseTraceModes(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTraceModes(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseTraceModes(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBuildTraceOptions tests that the options set the log mode and a redacting logger
+func TestBuildTraceOptions(t *testing.T) {
+	if opts := buildTraceOptions(0, &bytes.Buffer{}); len(opts) != 0 {
+		t.Errorf("buildTraceOptions(0) returned %d options, want 0", len(opts))
+	}
+
+	var buf bytes.Buffer
+	var lo config.LoadOptions
+	for _, opt := range buildTraceOptions(aws.LogRequest|aws.LogRetries, &buf) {
+		if err := opt(&lo); err != nil {
+			t.Fatalf("option error = %v", err)
+		}
+	}
+	if lo.ClientLogMode == nil || *lo.ClientLogMode != aws.LogRequest|aws.LogRetries {
+		t.Errorf("ClientLogMode = %v, want LogRequest|LogRetries", lo.ClientLogMode)
+	}
+	if _, ok := lo.Logger.(*redactingLogger); !ok {
+		t.Fatalf("Logger = %T, want *redactingLogger", lo.Logger)
+	}
+
+	lo.Logger.Logf(logging.Debug, "Request\r\nAuthorization: AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/x\r\nX-Amz-Security-Token: secret\r\nHost: s3tables\r\n")
+	out := buf.String()
+	if strings.Contains(out, "AKIDEXAMPLE") || strings.Contains(out, "secret") {
+		t.Errorf("trace output leaked credentials: %q", out)
+	}
+	if !strings.Contains(out, "Authorization: [REDACTED]\r\n") || !strings.Contains(out, "Host: s3tables") {
+		t.Errorf("trace output = %q, want redacted headers and other headers kept", out)
+	}
+}
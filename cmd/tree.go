@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"s3t/internal/s3tables"
+
+	"github.com/spf13/cobra"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree <table-bucket>",
+	Short: "Show namespaces and tables of a table bucket as a tree",
+	Long: `Show the namespaces and tables of a table bucket as an indented tree,
+like the tree command.
+
+Examples:
+  # Show the hierarchy of a bucket
+  s3t tree my-bucket
+
+  # Use plain ASCII connectors
+  s3t tree my-bucket --ascii
+
+  # Show only namespaces
+  s3t tree my-bucket --depth 1
+
+  # Put namespaces holding a single table on one line
+  s3t tree my-bucket --compact-tree`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTree,
+}
+
+var (
+	// treeDepth limits how many levels below the bucket are drawn (0: unlimited)
+	treeDepth int
+	// treeCompact collapses namespaces with a single table onto one line
+	treeCompact bool
+)
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+	treeCmd.Flags().IntVar(&treeDepth, "depth", 0, "Limit the tree depth below the bucket (1: namespaces only, 0: unlimited)")
+	treeCmd.Flags().BoolVar(&treeCompact, "compact-tree", false, "Show a namespace with a single table as one namespace/table line")
+}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	if treeDepth < 0 {
+		return fmt.Errorf("--depth must be 0 or greater, got %d", treeDepth)
+	}
+
+	client := getS3TablesClient()
+	if client == nil {
+		return fmt.Errorf("S3 Tables client not initialized")
+	}
+
+	ctx := context.Background()
+	lister := newLister(client)
+
+	bucketARN, err := lister.GetTableBucketARN(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	spec, err := lister.ExportHierarchy(ctx, args[0], bucketARN)
+	if err != nil {
+		return err
+	}
+
+	out, closePager := stdoutPaged()
+	defer closePager()
+
+	renderer := &s3tables.TreeRenderer{ASCII: asciiOutput, Depth: treeDepth, Compact: treeCompact}
+	return renderer.Render(out, *spec)
+}
@@ -0,0 +1,140 @@
+package s3tables
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// DefaultARNDisplayWidth is the maximum ARN length used when truncation is enabled
+const DefaultARNDisplayWidth = 60
+
+// arnEllipsis marks the omitted part of a truncated ARN
+const arnEllipsis = "..."
+
+// truncateARN shortens arn for display so that it fits in maxLen characters.
+// The partition, region and account are collapsed first, e.g.
+// arn:...:bucket/<bucket>/.../<table>; if that is still too long the head of
+// the ARN is cut off. A maxLen of zero or less disables truncation.
+func truncateARN(arn string, maxLen int) string {
+	if maxLen <= 0 || len(arn) <= maxLen {
+		return arn
+	}
+
+	if idx := strings.LastIndex(arn, ":"); idx >= 0 {
+		parts := strings.Split(arn[idx+1:], "/")
+		short := "arn:" + arnEllipsis + ":" + parts[0]
+		if len(parts) >= 2 {
+			short += "/" + parts[1]
+		}
+		if len(parts) > 2 {
+			short += "/" + arnEllipsis + "/" + parts[len(parts)-1]
+		}
+		if len(short) <= maxLen {
+			return short
+		}
+	}
+
+	if maxLen <= len(arnEllipsis) {
+		return arn[len(arn)-maxLen:]
+	}
+	return arnEllipsis + arn[len(arn)-(maxLen-len(arnEllipsis)):]
+}
+
+// DisplayARN returns the bucket ARN truncated to maxLen characters (0 = full ARN)
+func (b *TableBucketInfo) DisplayARN(maxLen int) string {
+	return truncateARN(b.ARN, maxLen)
+}
+
+// DisplayARN returns the table ARN truncated to maxLen characters (0 = full ARN)
+func (t *TableInfo) DisplayARN(maxLen int) string {
+	return truncateARN(t.ARN, maxLen)
+}
+
+// redactedAccount replaces the account ID of a redacted ARN
+const redactedAccount = "************"
+
+// arnAccountPattern matches the account ID field of an ARN embedded in text
+var arnAccountPattern = regexp.MustCompile(`(arn:[^:\s"]*:[^:\s"]*:[^:\s"]*:)[0-9]{12}\b`)
+
+// RedactARN masks the 12-digit account ID of arn, leaving the rest intact.
+// Strings that are not ARNs with an account ID are returned unchanged.
+func RedactARN(s string) string {
+	parsed, err := arn.Parse(s)
+	if err != nil || len(parsed.AccountID) != len(redactedAccount) {
+		return s
+	}
+	parsed.AccountID = redactedAccount
+	return parsed.String()
+}
+
+// RedactARNs masks the account ID of every ARN found in text
+func RedactARNs(text string) string {
+	return arnAccountPattern.ReplaceAllString(text, "${1}"+redactedAccount)
+}
+
+// ValidateTableBucketARN checks that s is a well-formed S3 Tables bucket ARN,
+// i.e. arn:<partition>:s3tables:<region>:<account>:bucket/<name>
+func ValidateTableBucketARN(s string) error {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return err
+	}
+	if parsed.Service != "s3tables" {
+		return fmt.Errorf("arn: unexpected service '%s'", parsed.Service)
+	}
+	if parsed.Region == "" || parsed.AccountID == "" {
+		return fmt.Errorf("arn: missing region or account")
+	}
+	name, ok := strings.CutPrefix(parsed.Resource, "bucket/")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("arn: unexpected resource '%s'", parsed.Resource)
+	}
+	return nil
+}
+
+// ResourceARN is a parsed S3 Tables table bucket or table ARN
+type ResourceARN struct {
+	Partition  string
+	Region     string
+	AccountID  string
+	BucketName string
+	TableID    string // Table ARN の場合のみ（Table 名ではなく ID）
+}
+
+// BucketARN returns the ARN of the table bucket the resource belongs to
+func (r ResourceARN) BucketARN() string {
+	return arn.ARN{
+		Partition: r.Partition,
+		Service:   "s3tables",
+		Region:    r.Region,
+		AccountID: r.AccountID,
+		Resource:  "bucket/" + r.BucketName,
+	}.String()
+}
+
+// ParseResourceARN parses a table bucket ARN (arn:<partition>:s3tables:<region>:<account>:bucket/<name>)
+// or a table ARN (...:bucket/<name>/table/<id>)
+func ParseResourceARN(s string) (*ResourceARN, error) {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return nil, &ValidationError{Field: "ARN", Message: err.Error()}
+	}
+	if parsed.Service != "s3tables" || parsed.Region == "" || parsed.AccountID == "" {
+		return nil, &ValidationError{Field: "ARN", Message: fmt.Sprintf("'%s' is not an S3 Tables ARN", s)}
+	}
+
+	resource := &ResourceARN{Partition: parsed.Partition, Region: parsed.Region, AccountID: parsed.AccountID}
+	parts := strings.Split(parsed.Resource, "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "bucket" && parts[1] != "":
+		resource.BucketName = parts[1]
+	case len(parts) == 4 && parts[0] == "bucket" && parts[1] != "" && parts[2] == "table" && parts[3] != "":
+		resource.BucketName, resource.TableID = parts[1], parts[3]
+	default:
+		return nil, &ValidationError{Field: "ARN", Message: fmt.Sprintf("unexpected resource '%s' (want bucket/<name> or bucket/<name>/table/<id>)", parsed.Resource)}
+	}
+	return resource, nil
+}
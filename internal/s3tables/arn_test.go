@@ -0,0 +1,154 @@
+package s3tables
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTruncateARN(t *testing.T) {
+	tableARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/0123456789abcdef"
+	bucketARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket"
+
+	tests := []struct {
+		name   string
+		arn    string
+		maxLen int
+		want   string
+	}{
+		{"disabled", tableARN, 0, tableARN},
+		{"shorter than limit", bucketARN, 100, bucketARN},
+		{"exactly at limit", bucketARN, len(bucketARN), bucketARN},
+		{"one over limit collapses prefix", bucketARN, len(bucketARN) - 1, "arn:...:bucket/my-bucket"},
+		{"table ARN collapses middle", tableARN, 50, "arn:...:bucket/my-bucket/.../0123456789abcdef"},
+		{"collapsed form exactly at limit", tableARN, len("arn:...:bucket/my-bucket/.../0123456789abcdef"), "arn:...:bucket/my-bucket/.../0123456789abcdef"},
+		{"limit below collapsed form keeps tail", tableARN, 20, ".../0123456789abcdef"},
+		{"limit within ellipsis", tableARN, 3, "def"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateARN(tt.arn, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("truncateARN(%q, %d) = %q, want %q", tt.arn, tt.maxLen, got, tt.want)
+			}
+			if tt.maxLen > 0 && len(got) > tt.maxLen {
+				t.Errorf("truncateARN() length = %d, exceeds %d", len(got), tt.maxLen)
+			}
+		})
+	}
+}
+
+func TestDisplayARN(t *testing.T) {
+	tbl := TableInfo{ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/abc"}
+	if got := tbl.DisplayARN(0); got != tbl.ARN {
+		t.Errorf("DisplayARN(0) = %q, want full ARN", got)
+	}
+	if got, want := tbl.DisplayARN(40), "arn:...:bucket/my-bucket/.../abc"; got != want {
+		t.Errorf("DisplayARN(40) = %q, want %q", got, want)
+	}
+}
+
+func TestValidateTableBucketARN(t *testing.T) {
+	tests := []struct {
+		name    string
+		arn     string
+		wantErr bool
+	}{
+		{name: "valid", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket"},
+		{name: "not an arn", arn: "my-bucket", wantErr: true},
+		{name: "wrong service", arn: "arn:aws:s3:us-east-1:123456789012:bucket/my-bucket", wantErr: true},
+		{name: "missing account", arn: "arn:aws:s3tables:us-east-1::bucket/my-bucket", wantErr: true},
+		{name: "table arn", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/t", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTableBucketARN(tt.arn); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTableBucketARN(%q) error = %v, wantErr %v", tt.arn, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedactARN(t *testing.T) {
+	tests := []struct {
+		name string
+		arn  string
+		want string
+	}{
+		{name: "bucket arn", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", want: "arn:aws:s3tables:us-east-1:************:bucket/my-bucket"},
+		{name: "table arn", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/abc", want: "arn:aws:s3tables:us-east-1:************:bucket/my-bucket/table/abc"},
+		{name: "not an arn", arn: "my-bucket", want: "my-bucket"},
+		{name: "missing account", arn: "arn:aws:s3tables:us-east-1::bucket/my-bucket", want: "arn:aws:s3tables:us-east-1::bucket/my-bucket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactARN(tt.arn); got != tt.want {
+				t.Errorf("RedactARN(%q) = %q, want %q", tt.arn, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactARNs(t *testing.T) {
+	text := `{"table_bucket_arn":"arn:aws:s3tables:us-east-1:123456789012:bucket/b","name":"123456789012"}` + "\n" +
+		"Table ARN: arn:aws-cn:s3tables:cn-north-1:210987654321:bucket/b/table/t\n"
+	want := `{"table_bucket_arn":"arn:aws:s3tables:us-east-1:************:bucket/b","name":"123456789012"}` + "\n" +
+		"Table ARN: arn:aws-cn:s3tables:cn-north-1:************:bucket/b/table/t\n"
+
+	if got := RedactARNs(text); got != want {
+		t.Errorf("RedactARNs() = %q, want %q", got, want)
+	}
+}
+
+func TestParseResourceARN(t *testing.T) {
+	tests := []struct {
+		name          string
+		arn           string
+		wantBucket    string
+		wantTableID   string
+		wantBucketARN string
+		wantErr       bool
+	}{
+		{
+			name:          "table bucket",
+			arn:           "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket",
+			wantBucket:    "my-bucket",
+			wantBucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket",
+		},
+		{
+			name:          "table",
+			arn:           "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/0123-abcd",
+			wantBucket:    "my-bucket",
+			wantTableID:   "0123-abcd",
+			wantBucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket",
+		},
+		{name: "not an ARN", arn: "my-bucket", wantErr: true},
+		{name: "other service", arn: "arn:aws:s3:::my-bucket", wantErr: true},
+		{name: "namespace path", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/namespace/ns", wantErr: true},
+		{name: "missing table ID", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseResourceARN(tt.arn)
+			if tt.wantErr {
+				var valErr *ValidationError
+				if !errors.As(err, &valErr) {
+					t.Fatalf("ParseResourceARN(%q) error = %v, want ValidationError", tt.arn, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseResourceARN(%q) error = %v", tt.arn, err)
+			}
+			if got.BucketName != tt.wantBucket || got.TableID != tt.wantTableID {
+				t.Errorf("ParseResourceARN(%q) = bucket %q table %q, want %q %q", tt.arn, got.BucketName, got.TableID, tt.wantBucket, tt.wantTableID)
+			}
+			if got.BucketARN() != tt.wantBucketARN {
+				t.Errorf("BucketARN() = %q, want %q", got.BucketARN(), tt.wantBucketARN)
+			}
+		})
+	}
+}
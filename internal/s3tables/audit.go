@@ -0,0 +1,94 @@
+package s3tables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audit results recorded for mutating operations
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// AuditRecord is a single structured audit log entry for a mutating operation
+type AuditRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Operation    string    `json:"operation"`
+	ResourceType string    `json:"resource_type"`
+	Resource     string    `json:"resource"`
+	// ARN is the ARN of the resource, or of the parent table bucket for namespaces
+	ARN    string `json:"arn,omitempty"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// AuditLogger appends audit records as JSON lines to a writer.
+// Write failures are reported as warnings and never fail the audited operation.
+// A nil *AuditLogger is valid and discards all records.
+type AuditLogger struct {
+	mu   sync.Mutex
+	w    io.Writer
+	warn io.Writer
+	// clock timestamps records; nil uses the package clock
+	clock Clock
+}
+
+// NewAuditLogger creates an AuditLogger writing JSON lines to w
+func NewAuditLogger(w io.Writer) *AuditLogger {
+	return &AuditLogger{
+		w:    w,
+		warn: os.Stderr,
+	}
+}
+
+// OpenAuditLog opens (or creates) the audit log file at path for appending
+func OpenAuditLog(path string) (*AuditLogger, io.Closer, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open audit log '%s': %w", path, err)
+	}
+	return NewAuditLogger(f), f, nil
+}
+
+// Log appends a record, filling in the timestamp when it is zero
+func (l *AuditLogger) Log(rec AuditRecord) {
+	if l == nil {
+		return
+	}
+	if rec.Timestamp.IsZero() {
+		rec.Timestamp = clockOr(l.clock).Now().UTC()
+	}
+
+	line, err := json.Marshal(rec)
+	if err != nil {
+		fmt.Fprintf(l.warn, "Warning: failed to encode audit record: %v\n", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		fmt.Fprintf(l.warn, "Warning: failed to write audit log: %v\n", err)
+	}
+}
+
+// logResult records the outcome of a mutating operation
+func (l *AuditLogger) logResult(operation, resourceType, resource, arn string, err error) {
+	rec := AuditRecord{
+		Operation:    operation,
+		ResourceType: resourceType,
+		Resource:     resource,
+		ARN:          arn,
+		Result:       AuditResultSuccess,
+	}
+	if err != nil {
+		rec.Result = AuditResultFailure
+		rec.Error = err.Error()
+	}
+	l.Log(rec)
+}
@@ -0,0 +1,110 @@
+package s3tables
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestAuditLoggerCreateAllResources tests the records written when all three resources are created
+func TestAuditLoggerCreateAllResources(t *testing.T) {
+	var buf bytes.Buffer
+	audit := NewAuditLogger(&buf)
+	fixed := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	audit.clock = newFakeClock(fixed)
+
+	creator := NewS3TablesCreator(&FuncMockS3TablesAPI{})
+	creator.SetAuditLogger(audit)
+
+	if _, err := creator.Create(context.Background(), "my-bucket", "my_ns", "my_table"); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	var records []AuditRecord
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var rec AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("audit line %q is not valid JSON: %v", scanner.Text(), err)
+		}
+		records = append(records, rec)
+	}
+
+	want := []AuditRecord{
+		{Timestamp: fixed, Operation: "CreateTableBucket", ResourceType: "table-bucket", Resource: "my-bucket", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", Result: AuditResultSuccess},
+		{Timestamp: fixed, Operation: "CreateNamespace", ResourceType: "namespace", Resource: "my_ns", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", Result: AuditResultSuccess},
+		{Timestamp: fixed, Operation: "CreateTable", ResourceType: "table", Resource: "my_ns/my_table", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/my_table", Result: AuditResultSuccess},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d audit records, want %d: %+v", len(records), len(want), records)
+	}
+	for i := range want {
+		if !records[i].Timestamp.Equal(want[i].Timestamp) {
+			t.Errorf("record[%d].Timestamp = %v, want %v", i, records[i].Timestamp, want[i].Timestamp)
+		}
+		records[i].Timestamp = want[i].Timestamp
+		if records[i] != want[i] {
+			t.Errorf("record[%d] = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
+
+// TestAuditLoggerRecordsFailure tests that a failed creation is recorded with its error
+func TestAuditLoggerRecordsFailure(t *testing.T) {
+	var buf bytes.Buffer
+	creator := NewS3TablesCreator(&FuncMockS3TablesAPI{
+		CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+			return nil, &types.ForbiddenException{Message: aws.String("access denied")}
+		},
+	})
+	creator.SetAuditLogger(NewAuditLogger(&buf))
+
+	if _, err := creator.Create(context.Background(), "my-bucket", "my_ns", "my_table"); err == nil {
+		t.Fatal("Create() should return error")
+	}
+
+	var rec AuditRecord
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &rec); err != nil {
+		t.Fatalf("expected a single audit record, got %q: %v", buf.String(), err)
+	}
+	if rec.Result != AuditResultFailure || rec.Error == "" {
+		t.Errorf("record = %+v, want failure with error", rec)
+	}
+}
+
+// failingWriter always fails to write
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+// TestAuditLoggerWriteFailureWarns tests that write failures warn without failing the operation
+func TestAuditLoggerWriteFailureWarns(t *testing.T) {
+	var warn bytes.Buffer
+	audit := NewAuditLogger(failingWriter{})
+	audit.warn = &warn
+
+	creator := NewS3TablesCreator(&FuncMockS3TablesAPI{})
+	creator.SetAuditLogger(audit)
+
+	if _, err := creator.Create(context.Background(), "my-bucket", "my_ns", "my_table"); err != nil {
+		t.Fatalf("Create() error = %v, audit failures must not fail the operation", err)
+	}
+	if !strings.Contains(warn.String(), "failed to write audit log") {
+		t.Errorf("warning output = %q, want write failure warning", warn.String())
+	}
+}
+
+// TestAuditLoggerNil tests that a nil logger is a no-op
+func TestAuditLoggerNil(t *testing.T) {
+	var audit *AuditLogger
+	audit.Log(AuditRecord{Operation: "CreateTable"})
+}
@@ -0,0 +1,148 @@
+package s3tables
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ProgressKind identifies what a ProgressEvent reports
+type ProgressKind string
+
+// Progress event kinds emitted by CreateBatch
+const (
+	ProgressStarted ProgressKind = "started" // エントリの処理を開始
+	ProgressCreated ProgressKind = "created" // リソースを作成
+	ProgressFailed  ProgressKind = "failed"  // エントリが失敗
+)
+
+// ProgressEvent reports the progress of one entry of a batch create.
+// Entry is the 1-based position of the entry in input order.
+type ProgressEvent struct {
+	Kind        ProgressKind
+	Entry       int
+	Total       int
+	TableBucket string
+	Namespace   string
+	Resource    string // ProgressCreated の場合の種類: "table-bucket"、"namespace" または "table"
+	Name        string // ProgressCreated の場合の作成したリソース名
+	Err         error  // ProgressFailed の場合のエラー
+}
+
+// progressReporter serializes progress callbacks from concurrent batch groups
+type progressReporter struct {
+	mu sync.Mutex
+	fn func(ProgressEvent)
+}
+
+func (p *progressReporter) emit(event ProgressEvent) {
+	if p.fn == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fn(event)
+}
+
+// emitCreated reports every resource that result records as newly created
+func (p *progressReporter) emitCreated(base ProgressEvent, result *CreateResult) {
+	base.Kind = ProgressCreated
+	if result.TableBucketCreated {
+		p.emit(withResource(base, "table-bucket", base.TableBucket))
+	}
+	if result.NamespaceCreated {
+		p.emit(withResource(base, "namespace", base.Namespace))
+	}
+	for _, tbl := range result.Tables {
+		if tbl.Created {
+			p.emit(withResource(base, "table", base.Namespace+"/"+tbl.Name))
+		}
+	}
+}
+
+func withKind(event ProgressEvent, kind ProgressKind) ProgressEvent {
+	event.Kind = kind
+	return event
+}
+
+func withResource(event ProgressEvent, resource, name string) ProgressEvent {
+	event.Resource = resource
+	event.Name = name
+	return event
+}
+
+// BatchResult is the outcome of one namespace entry of a batch create
+type BatchResult struct {
+	TableBucket string
+	Namespace   string
+	Result      *CreateResult // エラー時はそれまでに作成したリソースの結果、未実行の場合は nil
+	Err         error
+}
+
+// NotRun reports whether the entry was left out because an earlier entry failed
+func (r BatchResult) NotRun() bool {
+	return r.Result == nil && r.Err == nil
+}
+
+// CreateBatch creates every namespace entry of specs with CreateTables.
+// Entries are grouped by table bucket: groups run concurrently with at most
+// parallel groups in flight, while the entries of one group run in order so
+// that a bucket is never created twice. Results are returned in input order;
+// a failing entry stops the rest of its group, and the first error by input
+// order is returned. After the first failure no further entry is started, as
+// with parallel 1, unless continueOnError is set; then the other groups run on.
+// Progress is reported to the function set with SetProgressFunc; calls are
+// serialized even when groups run concurrently.
+func (c *S3TablesCreator) CreateBatch(ctx context.Context, specs []HierarchySpec, parallel int, continueOnError bool) ([]BatchResult, error) {
+	var results []BatchResult
+	var tables [][]string
+	// groups holds the result indexes of each table bucket in order of first appearance
+	var groups [][]int
+	groupOf := make(map[string]int)
+	for _, spec := range specs {
+		g, ok := groupOf[spec.TableBucket]
+		if !ok {
+			g = len(groups)
+			groupOf[spec.TableBucket] = g
+			groups = append(groups, nil)
+		}
+		for _, ns := range spec.Namespaces {
+			groups[g] = append(groups[g], len(results))
+			results = append(results, BatchResult{TableBucket: spec.TableBucket, Namespace: ns.Name})
+			tables = append(tables, ns.Tables)
+		}
+	}
+
+	progress := &progressReporter{fn: c.progress}
+	var failed atomic.Bool
+	_ = runLimited(len(groups), parallel, func(g int) error {
+		for _, i := range groups[g] {
+			if failed.Load() && !continueOnError {
+				return nil
+			}
+			entry := &results[i]
+			event := ProgressEvent{Entry: i + 1, Total: len(results), TableBucket: entry.TableBucket, Namespace: entry.Namespace}
+			progress.emit(withKind(event, ProgressStarted))
+
+			entry.Result, entry.Err = c.CreateTables(ctx, entry.TableBucket, entry.Namespace, tables[i])
+			if entry.Err != nil {
+				event.Err = entry.Err
+				progress.emit(withKind(event, ProgressFailed))
+				failed.Store(true)
+				if continueOnError {
+					return nil
+				}
+				return entry.Err
+			}
+			progress.emitCreated(event, entry.Result)
+		}
+		return nil
+	})
+
+	for _, entry := range results {
+		if entry.Err != nil {
+			return results, entry.Err
+		}
+	}
+	return results, nil
+}
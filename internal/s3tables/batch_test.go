@@ -0,0 +1,242 @@
+package s3tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// batchOrderMock records the order and overlap of CreateTable calls per table bucket.
+// When rendezvous is set, the first calls block until two buckets are in flight at once.
+type batchOrderMock struct {
+	mu         sync.Mutex
+	active     map[string]int
+	inFlight   int
+	maxFlight  int
+	overlapped []string // 同じ Bucket で同時に実行されたテーブル
+	order      map[string][]string
+	rendezvous chan struct{}
+	arrived    int
+}
+
+func newBatchOrderMock(rendezvous bool) *batchOrderMock {
+	m := &batchOrderMock{active: map[string]int{}, order: map[string][]string{}}
+	if rendezvous {
+		m.rendezvous = make(chan struct{})
+	}
+	return m
+}
+
+func (m *batchOrderMock) api() *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			name := aws.ToString(params.Prefix)
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String(name), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/" + name)},
+			}}, nil
+		},
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			return &s3tables.GetNamespaceOutput{}, nil
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			bucketARN := aws.ToString(params.TableBucketARN)
+			bucket := bucketARN[strings.LastIndex(bucketARN, "/")+1:]
+			table := aws.ToString(params.Name)
+
+			m.mu.Lock()
+			m.active[bucket]++
+			if m.active[bucket] > 1 {
+				m.overlapped = append(m.overlapped, table)
+			}
+			m.inFlight++
+			m.maxFlight = max(m.maxFlight, m.inFlight)
+			m.order[bucket] = append(m.order[bucket], table)
+			wait := m.rendezvous != nil && m.arrived < 2
+			if wait {
+				m.arrived++
+				if m.arrived == 2 {
+					close(m.rendezvous)
+				}
+			}
+			m.mu.Unlock()
+
+			if wait {
+				select {
+				case <-m.rendezvous:
+				case <-time.After(time.Second):
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			m.mu.Lock()
+			m.active[bucket]--
+			m.inFlight--
+			m.mu.Unlock()
+			return &s3tables.CreateTableOutput{TableARN: aws.String(bucketARN + "/table/" + table)}, nil
+		},
+	}
+}
+
+// batchSpecs returns two entries for each of bucket-a and bucket-b
+func batchSpecs() []HierarchySpec {
+	return []HierarchySpec{
+		{TableBucket: "bucket-a", Namespaces: []NamespaceSpec{{Name: "ns1", Tables: []string{"a1"}}, {Name: "ns2", Tables: []string{"a2"}}}},
+		{TableBucket: "bucket-b", Namespaces: []NamespaceSpec{{Name: "ns1", Tables: []string{"b1"}}}},
+		{TableBucket: "bucket-a", Namespaces: []NamespaceSpec{{Name: "ns3", Tables: []string{"a3"}}}},
+		{TableBucket: "bucket-b", Namespaces: []NamespaceSpec{{Name: "ns2", Tables: []string{"b2"}}}},
+	}
+}
+
+// TestCreateBatchParallel tests that different buckets run concurrently while one bucket's entries serialize
+func TestCreateBatchParallel(t *testing.T) {
+	mock := newBatchOrderMock(true)
+
+	results, err := NewS3TablesCreator(mock.api()).CreateBatch(context.Background(), batchSpecs(), 2, false)
+	if err != nil {
+		t.Fatalf("CreateBatch() error = %v", err)
+	}
+
+	if mock.maxFlight != 2 {
+		t.Errorf("max concurrent creates = %d, want 2", mock.maxFlight)
+	}
+	if len(mock.overlapped) > 0 {
+		t.Errorf("tables created concurrently within one bucket: %v", mock.overlapped)
+	}
+	wantOrder := map[string][]string{"bucket-a": {"a1", "a2", "a3"}, "bucket-b": {"b1", "b2"}}
+	if !reflect.DeepEqual(mock.order, wantOrder) {
+		t.Errorf("per-bucket order = %v, want %v", mock.order, wantOrder)
+	}
+
+	var got []string
+	for _, entry := range results {
+		got = append(got, entry.TableBucket+"/"+entry.Namespace)
+	}
+	want := []string{"bucket-a/ns1", "bucket-a/ns2", "bucket-b/ns1", "bucket-a/ns3", "bucket-b/ns2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("results = %v, want input order %v", got, want)
+	}
+}
+
+// TestCreateBatchSequential tests that parallel 1 never overlaps creates
+func TestCreateBatchSequential(t *testing.T) {
+	mock := newBatchOrderMock(false)
+
+	if _, err := NewS3TablesCreator(mock.api()).CreateBatch(context.Background(), batchSpecs(), 1, false); err != nil {
+		t.Fatalf("CreateBatch() error = %v", err)
+	}
+	if mock.maxFlight != 1 {
+		t.Errorf("max concurrent creates = %d, want 1", mock.maxFlight)
+	}
+}
+
+// TestCreateBatchProgress tests the sequence of progress events received by a consuming goroutine
+func TestCreateBatchProgress(t *testing.T) {
+	mock := &FuncMockS3TablesAPI{
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			if aws.ToString(params.Name) == "existing" {
+				return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/existing")}, nil
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			if aws.ToString(params.Name) == "broken" {
+				return nil, &types.BadRequestException{Message: aws.String("invalid")}
+			}
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/" + aws.ToString(params.Name))}, nil
+		},
+	}
+
+	events := make(chan ProgressEvent)
+	var got []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for event := range events {
+			line := fmt.Sprintf("%d/%d %s", event.Entry, event.Total, event.Kind)
+			if event.Kind == ProgressCreated {
+				line += " " + event.Resource + " " + event.Name
+			}
+			got = append(got, line)
+		}
+	}()
+
+	creator := NewS3TablesCreator(mock)
+	creator.SetProgressFunc(func(event ProgressEvent) { events <- event })
+	specs := []HierarchySpec{{TableBucket: "b", Namespaces: []NamespaceSpec{
+		{Name: "sales", Tables: []string{"orders", "existing"}},
+		{Name: "ops", Tables: []string{"broken"}},
+	}}}
+	_, err := creator.CreateBatch(context.Background(), specs, 1, false)
+	close(events)
+	<-done
+
+	if err == nil {
+		t.Fatal("CreateBatch() error = nil, want the CreateTable failure")
+	}
+	want := []string{
+		"1/2 started",
+		"1/2 created table-bucket b",
+		"1/2 created namespace sales",
+		"1/2 created table sales/orders",
+		"2/2 started",
+		"2/2 failed",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+// TestCreateBatchStopsAfterFailure tests that no entry starts after a failure unless continueOnError is set
+func TestCreateBatchStopsAfterFailure(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		continueOnError bool
+		wantCreated     []string
+	}{
+		{"stop", false, nil},
+		{"continue on error", true, []string{"b1", "b2"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var created []string
+			mock := newBatchOrderMock(false).api()
+			create := mock.CreateTableFunc
+			mock.CreateTableFunc = func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+				if aws.ToString(params.Name) == "a1" {
+					return nil, &types.BadRequestException{Message: aws.String("invalid")}
+				}
+				mu.Lock()
+				created = append(created, aws.ToString(params.Name))
+				mu.Unlock()
+				return create(ctx, params)
+			}
+
+			results, err := NewS3TablesCreator(mock).CreateBatch(context.Background(), batchSpecs(), 1, tt.continueOnError)
+			if err == nil {
+				t.Fatal("CreateBatch() error = nil, want the CreateTable failure")
+			}
+			if !reflect.DeepEqual(created, tt.wantCreated) {
+				t.Errorf("created = %v, want %v", created, tt.wantCreated)
+			}
+			// bucket-a の残りのエントリーは継続指定に関係なく実行されない
+			for _, i := range []int{1, 3} {
+				if !results[i].NotRun() {
+					t.Errorf("results[%d] = %+v, want an entry that was not run", i, results[i])
+				}
+			}
+			for _, i := range []int{2, 4} {
+				if got := results[i].NotRun(); got == tt.continueOnError {
+					t.Errorf("results[%d].NotRun() = %v, want %v", i, got, !tt.continueOnError)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+)
+
+// S3TablesChecker performs read-only checks against S3 Tables
+type S3TablesChecker struct {
+	client S3TablesAPI
+	// existenceCheck selects Get or List calls for namespace and table existence checks
+	existenceCheck ExistenceCheck
+}
+
+// NewS3TablesChecker creates a new S3TablesChecker instance
+func NewS3TablesChecker(client S3TablesAPI) *S3TablesChecker {
+	return &S3TablesChecker{client: client}
+}
+
+// SetExistenceCheck selects the API used by CheckPaths to determine whether
+// namespaces and tables exist (ExistenceCheckAuto by default)
+func (c *S3TablesChecker) SetExistenceCheck(check ExistenceCheck) {
+	c.existenceCheck = check
+}
+
+// ProbePermissions issues a harmless ListTableBuckets call to verify that the
+// configured credentials can reach S3 Tables before a bulk operation starts.
+// Forbidden and credential errors are returned with actionable guidance.
+func (c *S3TablesChecker) ProbePermissions(ctx context.Context) error {
+	_, err := c.client.ListTableBuckets(ctx, &s3tables.ListTableBucketsInput{
+		MaxBuckets: aws.Int32(1),
+	})
+	if err == nil {
+		return nil
+	}
+
+	wrapped := WrapError("ProbePermissions", err)
+	s3tErr, ok := wrapped.(*S3TablesError)
+	if !ok {
+		return wrapped
+	}
+
+	switch s3tErr.Type {
+	case ErrorTypeForbidden:
+		s3tErr.Message = "access denied while probing S3 Tables permissions"
+		s3tErr.Suggestions = []string{msg(MsgSuggestGrantBulkActions)}
+	case ErrorTypeCredentials:
+		s3tErr.Message = "AWS credentials are missing or invalid"
+		s3tErr.Suggestions = []string{msg(MsgSuggestConfigureCreds), msg(MsgSuggestCheckProfile)}
+	}
+	return s3tErr
+}
+
+// ExistenceResult reports whether one path checked by CheckPaths exists.
+// Err is set instead when existence could not be determined.
+type ExistenceResult struct {
+	Path   ResourcePath
+	Exists bool
+	Err    error
+}
+
+// CheckPaths reports, in input order, whether each path exists: its table bucket,
+// and its namespace and table when given. Paths without a bucket ARN are resolved
+// by exact bucket name, once per name. At most parallel paths are checked at once.
+func (c *S3TablesChecker) CheckPaths(ctx context.Context, paths []ResourcePath, parallel int) []ExistenceResult {
+	results := make([]ExistenceResult, len(paths))
+	for i, path := range paths {
+		results[i].Path = path
+		if path.Bucket == "" && path.BucketARN == "" {
+			results[i].Err = &ValidationError{Field: "path", Message: "a table bucket is required"}
+		} else if path.Table != "" && path.Namespace == "" {
+			results[i].Err = &ValidationError{Field: "path", Message: fmt.Sprintf("table '%s' has no namespace", path.Table)}
+		}
+	}
+
+	// Resolve every bucket name once before checking the paths below it
+	var names []string
+	seen := make(map[string]bool)
+	for _, result := range results {
+		if result.Err == nil && result.Path.BucketARN == "" && !seen[result.Path.Bucket] {
+			seen[result.Path.Bucket] = true
+			names = append(names, result.Path.Bucket)
+		}
+	}
+	lister := NewS3TablesLister(c.client)
+	buckets := make([]*TableBucketInfo, len(names))
+	lookupErrs := make([]error, len(names))
+	_ = runLimited(len(names), parallel, func(i int) error {
+		buckets[i], _, lookupErrs[i] = lister.FindBucketByExactName(ctx, names[i])
+		return nil
+	})
+	bucketARNs := make(map[string]string, len(names))
+	bucketErrs := make(map[string]error)
+	for i, name := range names {
+		if lookupErrs[i] != nil {
+			bucketErrs[name] = lookupErrs[i]
+		} else if buckets[i] != nil {
+			bucketARNs[name] = buckets[i].ARN
+		}
+	}
+
+	probe := NewS3TablesCreator(c.client)
+	probe.SetExistenceCheck(c.existenceCheck)
+	_ = runLimited(len(results), parallel, func(i int) error {
+		result := &results[i]
+		if result.Err != nil {
+			return nil
+		}
+		path := result.Path
+		bucketARN := path.BucketARN
+		if bucketARN == "" {
+			if result.Err = bucketErrs[path.Bucket]; result.Err != nil {
+				return nil
+			}
+			bucketARN = bucketARNs[path.Bucket]
+		}
+
+		switch {
+		case bucketARN == "":
+			result.Exists = false
+		case path.Table != "":
+			result.Exists, _, _, result.Err = probe.checkTableExists(ctx, bucketARN, path.Namespace, path.Table)
+		case path.Namespace != "":
+			result.Exists, result.Err = probe.checkNamespaceExists(ctx, bucketARN, path.Namespace)
+		default:
+			result.Exists = true
+		}
+		return nil
+	})
+	return results
+}
@@ -0,0 +1,126 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+func TestProbePermissions(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantErr        bool
+		wantType       ErrorType
+		wantSuggestion string
+	}{
+		{
+			name:    "success",
+			err:     nil,
+			wantErr: false,
+		},
+		{
+			name:           "forbidden",
+			err:            &types.ForbiddenException{Message: aws.String("access denied")},
+			wantErr:        true,
+			wantType:       ErrorTypeForbidden,
+			wantSuggestion: "s3tables:ListTableBuckets",
+		},
+		{
+			name:           "credentials",
+			err:            errors.New("failed to retrieve credentials: no credentials"),
+			wantErr:        true,
+			wantType:       ErrorTypeCredentials,
+			wantSuggestion: "aws configure",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotInput *s3tables.ListTableBucketsInput
+			mock := &FuncMockS3TablesAPI{
+				ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+					gotInput = params
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &s3tables.ListTableBucketsOutput{}, nil
+				},
+			}
+			checker := NewS3TablesChecker(mock)
+
+			err := checker.ProbePermissions(context.Background())
+			if gotInput == nil || aws.ToInt32(gotInput.MaxBuckets) != 1 {
+				t.Errorf("ProbePermissions() should request a single bucket, got input %+v", gotInput)
+			}
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("ProbePermissions() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var s3tErr *S3TablesError
+			if !errors.As(err, &s3tErr) {
+				t.Fatalf("ProbePermissions() error = %T, want *S3TablesError", err)
+			}
+			if s3tErr.Type != tt.wantType {
+				t.Errorf("ProbePermissions() Type = %v, want %v", s3tErr.Type, tt.wantType)
+			}
+			if s3tErr.Operation != "ProbePermissions" {
+				t.Errorf("ProbePermissions() Operation = %q, want ProbePermissions", s3tErr.Operation)
+			}
+			if !strings.Contains(s3tErr.Suggestion(), tt.wantSuggestion) {
+				t.Errorf("ProbePermissions() Suggestion = %q, want to contain %q", s3tErr.Suggestion(), tt.wantSuggestion)
+			}
+		})
+	}
+}
+
+// TestCheckPaths tests existence at every level, input order, and that each bucket
+// name is looked up once
+func TestCheckPaths(t *testing.T) {
+	mock := newSingleAccessMock(true)
+	var bucketLookups atomic.Int32
+	mock.ListTableBucketsFunc = func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+		bucketLookups.Add(1)
+		var buckets []types.TableBucketSummary
+		if strings.HasPrefix("test", aws.ToString(params.Prefix)) {
+			buckets = append(buckets, types.TableBucketSummary{Name: aws.String("test"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test")})
+		}
+		return &s3tables.ListTableBucketsOutput{TableBuckets: buckets}, nil
+	}
+	const bucket = "test"
+
+	paths := []ResourcePath{
+		{Bucket: bucket, Namespace: "sales", Table: "orders"},
+		{Bucket: bucket, Namespace: "sales", Table: "refunds"},
+		{Bucket: bucket, Namespace: "sales"},
+		{Bucket: bucket},
+		{Bucket: "no-such-bucket", Namespace: "sales"},
+		{Bucket: bucket, Table: "orders"},
+	}
+	results := NewS3TablesChecker(mock).CheckPaths(context.Background(), paths, 3)
+
+	want := []bool{true, false, true, true, false, false}
+	for i, result := range results {
+		if result.Path != paths[i] {
+			t.Errorf("results[%d].Path = %+v, want %+v", i, result.Path, paths[i])
+		}
+		if result.Exists != want[i] {
+			t.Errorf("results[%d].Exists = %v, want %v", i, result.Exists, want[i])
+		}
+		if wantErr := i == 5; (result.Err != nil) != wantErr {
+			t.Errorf("results[%d].Err = %v, want error %v", i, result.Err, wantErr)
+		}
+	}
+	if got := bucketLookups.Load(); got != 2 {
+		t.Errorf("ListTableBuckets called %d times, want once per bucket name (2)", got)
+	}
+}
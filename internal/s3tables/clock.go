@@ -0,0 +1,59 @@
+package s3tables
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock supplies the current time and waits, so that time-dependent logic
+// (backoff, polling deadlines, timestamps) can be tested without sleeping
+type Clock interface {
+	Now() time.Time
+	// Sleep waits for d, returning ctx.Err() early when ctx is done
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// realClock is the Clock backed by the time package
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var (
+	// clockMu guards defaultClock
+	clockMu sync.RWMutex
+	// defaultClock is used by every type that has no Clock of its own
+	defaultClock Clock = realClock{}
+)
+
+// SetClock replaces the package clock used by types without a Clock of their own.
+// Pass nil to restore the real clock.
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	defaultClock = c
+}
+
+// clockOr returns c, or the package clock when c is nil
+func clockOr(c Clock) Clock {
+	if c != nil {
+		return c
+	}
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return defaultClock
+}
@@ -0,0 +1,110 @@
+package s3tables
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves when Sleep is called
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+// TestRetryWithFakeClock tests the backoff schedule of Retry without sleeping
+func TestRetryWithFakeClock(t *testing.T) {
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cfg := BackoffConfig{MaxAttempts: 4, BaseDelay: time.Hour, MaxDelay: 3 * time.Hour, Strategy: BackoffExponential, Clock: clock}
+
+	calls := 0
+	err := Retry(context.Background(), cfg, func() error {
+		calls++
+		return &S3TablesError{Type: ErrorTypeThrottling}
+	})
+	if GetErrorType(err) != ErrorTypeThrottling || calls != 4 {
+		t.Fatalf("Retry() = %v after %d calls, want throttling error after 4 calls", err, calls)
+	}
+
+	want := []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour}
+	if !reflect.DeepEqual(clock.sleeps, want) {
+		t.Errorf("sleeps = %v, want %v", clock.sleeps, want)
+	}
+}
+
+// TestWaitForVisibleFakeClockTimeout tests that polling stops at the deadline measured on the clock
+func TestWaitForVisibleFakeClockTimeout(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := newFakeClock(start)
+	cfg := BackoffConfig{BaseDelay: 2 * time.Minute, Strategy: BackoffConstant, Clock: clock}
+
+	calls := 0
+	err := waitForVisible(context.Background(), "CreateTable", cfg, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+	if GetErrorType(err) != ErrorTypeTimeout {
+		t.Fatalf("waitForVisible() error = %v, want timeout", err)
+	}
+
+	// The last wait is cut short so that the final poll happens exactly at the deadline
+	want := []time.Duration{2 * time.Minute, 2 * time.Minute, time.Minute}
+	if !reflect.DeepEqual(clock.sleeps, want) {
+		t.Errorf("sleeps = %v, want %v", clock.sleeps, want)
+	}
+	if calls != 4 {
+		t.Errorf("check calls = %d, want 4", calls)
+	}
+	if got := clock.Now().Sub(start); got != 5*time.Minute {
+		t.Errorf("elapsed = %v, want 5m", got)
+	}
+}
+
+// TestSetClock tests that types without their own Clock use the package clock
+func TestSetClock(t *testing.T) {
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetClock(clock)
+	t.Cleanup(func() { SetClock(nil) })
+
+	calls := 0
+	cfg := BackoffConfig{MaxAttempts: 2, BaseDelay: time.Hour, Strategy: BackoffConstant}
+	_ = Retry(context.Background(), cfg, func() error {
+		calls++
+		return &S3TablesError{Type: ErrorTypeInternalServer}
+	})
+	if calls != 2 || !reflect.DeepEqual(clock.sleeps, []time.Duration{time.Hour}) {
+		t.Errorf("Retry() made %d calls with sleeps %v, want 2 calls and one 1h sleep", calls, clock.sleeps)
+	}
+
+	if _, ok := clockOr(nil).(*fakeClock); !ok {
+		t.Error("clockOr(nil) did not return the clock set by SetClock")
+	}
+	SetClock(nil)
+	if _, ok := clockOr(nil).(realClock); !ok {
+		t.Error("SetClock(nil) did not restore the real clock")
+	}
+}
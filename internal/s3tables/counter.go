@@ -0,0 +1,145 @@
+package s3tables
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// countConcurrency bounds the number of list calls in flight during a recursive count
+const countConcurrency = 8
+
+// CountScope selects what CountRecursive counts.
+// An empty TableBucketARN counts the tables of every table bucket.
+// NamespacePrefix and TablePrefix narrow the count to matching names.
+// With ContinueOnError, table buckets and namespaces that cannot be listed are
+// skipped and reported in the result instead of failing the count.
+type CountScope struct {
+	TableBucketName string
+	TableBucketARN  string
+	NamespacePrefix string
+	TablePrefix     string
+	ContinueOnError bool
+}
+
+// NamespaceCount is the number of tables in one namespace of a table bucket
+type NamespaceCount struct {
+	TableBucket string
+	Namespace   string
+	Tables      int
+}
+
+// CountResult is the total table count together with a per-namespace breakdown
+// and, with ContinueOnError, the table buckets and namespaces left out of it
+type CountResult struct {
+	Total             int
+	Breakdown         []NamespaceCount
+	SkippedBuckets    []ItemError // 一覧に失敗して数えなかった Table Bucket
+	SkippedNamespaces []ItemError // 一覧に失敗して数えなかった Namespace（"bucket/namespace"）
+}
+
+// CountRecursive counts the tables across all namespaces of the scoped table buckets.
+// Namespaces and tables are listed concurrently with at most countConcurrency calls in flight.
+func (l *S3TablesLister) CountRecursive(ctx context.Context, scope CountScope) (*CountResult, error) {
+	buckets := []TableBucketInfo{{Name: scope.TableBucketName, ARN: scope.TableBucketARN}}
+	if scope.TableBucketARN == "" {
+		var err error
+		buckets, err = l.ListTableBucketsAll(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &CountResult{}
+	namespaces := make([][]NamespaceInfo, len(buckets))
+	bucketErrs := make([]error, len(buckets))
+	err := runBounded(len(buckets), func(i int) error {
+		var err error
+		namespaces[i], err = l.ListNamespacesAll(ctx, buckets[i].ARN, scope.NamespacePrefix)
+		return scope.skip(err, &bucketErrs[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var breakdown []NamespaceCount
+	var bucketARNs []string
+	for i, bucket := range buckets {
+		if bucketErrs[i] != nil {
+			result.SkippedBuckets = append(result.SkippedBuckets, ItemError{Item: bucket.Name, Err: bucketErrs[i]})
+			continue
+		}
+		for _, ns := range namespaces[i] {
+			breakdown = append(breakdown, NamespaceCount{TableBucket: bucket.Name, Namespace: ns.Name})
+			bucketARNs = append(bucketARNs, bucket.ARN)
+		}
+	}
+
+	namespaceErrs := make([]error, len(breakdown))
+	err = runBounded(len(breakdown), func(i int) error {
+		tables, err := l.ListTablesAll(ctx, bucketARNs[i], breakdown[i].Namespace, scope.TablePrefix)
+		breakdown[i].Tables = len(tables)
+		return scope.skip(err, &namespaceErrs[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range breakdown {
+		if namespaceErrs[i] != nil {
+			result.SkippedNamespaces = append(result.SkippedNamespaces, ItemError{Item: c.TableBucket + "/" + c.Namespace, Err: namespaceErrs[i]})
+			continue
+		}
+		result.Breakdown = append(result.Breakdown, c)
+		result.Total += c.Tables
+	}
+	return result, nil
+}
+
+// skip returns err, or records it in skipped and returns nil when the scope
+// continues on error. Credential errors always fail, as in ListAllTables.
+func (s CountScope) skip(err error, skipped *error) error {
+	if err == nil || !s.ContinueOnError || IsCredentialError(err) {
+		return err
+	}
+	*skipped = err
+	return nil
+}
+
+// runBounded calls fn for every index in [0, n) with at most countConcurrency
+// calls running at once, and returns the first error by index
+func runBounded(n int, fn func(i int) error) error {
+	return runLimited(n, countConcurrency, fn)
+}
+
+// runLimited calls fn for every index in [0, n) with at most limit calls
+// running at once (at least one), and returns the first error by index.
+// Once a call fails no further calls are started; those already running finish.
+func runLimited(n, limit int, fn func(i int) error) error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, max(limit, 1))
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		if failed.Load() {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if errs[i] = fn(i); errs[i] != nil {
+				failed.Store(true)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
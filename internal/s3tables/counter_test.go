@@ -0,0 +1,244 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+const counterTestARNPrefix = "arn:aws:s3tables:us-east-1:123456789012:bucket/"
+
+// newMultiBucketMock returns a mock serving bucket → namespace → table count data
+func newMultiBucketMock(data map[string]map[string]int, inFlight, maxInFlight *int32) *FuncMockS3TablesAPI {
+	track := func() func() {
+		n := atomic.AddInt32(inFlight, 1)
+		for {
+			peak := atomic.LoadInt32(maxInFlight)
+			if n <= peak || atomic.CompareAndSwapInt32(maxInFlight, peak, n) {
+				break
+			}
+		}
+		return func() { atomic.AddInt32(inFlight, -1) }
+	}
+
+	return &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			var summaries []types.TableBucketSummary
+			for name := range data {
+				summaries = append(summaries, types.TableBucketSummary{Name: aws.String(name), Arn: aws.String(counterTestARNPrefix + name)})
+			}
+			return &s3tables.ListTableBucketsOutput{TableBuckets: summaries}, nil
+		},
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			defer track()()
+			bucket := strings.TrimPrefix(aws.ToString(params.TableBucketARN), counterTestARNPrefix)
+			var summaries []types.NamespaceSummary
+			for ns := range data[bucket] {
+				summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			defer track()()
+			bucket := strings.TrimPrefix(aws.ToString(params.TableBucketARN), counterTestARNPrefix)
+			ns := aws.ToString(params.Namespace)
+			summaries := make([]types.TableSummary, data[bucket][ns])
+			for i := range summaries {
+				summaries[i] = types.TableSummary{Name: aws.String("t"), Namespace: []string{ns}}
+			}
+			return &s3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+	}
+}
+
+var counterTestData = map[string]map[string]int{
+	"bucket-a": {"ns1": 3, "ns2": 0, "ns3": 2},
+	"bucket-b": {"ns1": 4},
+	"bucket-c": {},
+}
+
+func TestCountRecursive_SingleBucket(t *testing.T) {
+	var inFlight, maxInFlight int32
+	lister := NewS3TablesLister(newMultiBucketMock(counterTestData, &inFlight, &maxInFlight))
+
+	result, err := lister.CountRecursive(context.Background(), CountScope{TableBucketName: "bucket-a", TableBucketARN: counterTestARNPrefix + "bucket-a"})
+	if err != nil {
+		t.Fatalf("CountRecursive() error = %v", err)
+	}
+
+	if result.Total != 5 {
+		t.Errorf("Total = %d, want 5", result.Total)
+	}
+	if len(result.Breakdown) != 3 {
+		t.Errorf("Breakdown = %+v, want 3 namespaces", result.Breakdown)
+	}
+	for _, c := range result.Breakdown {
+		if c.TableBucket != "bucket-a" {
+			t.Errorf("Breakdown entry %+v, want bucket-a only", c)
+		}
+	}
+}
+
+func TestCountRecursive_AllBuckets(t *testing.T) {
+	var inFlight, maxInFlight int32
+	lister := NewS3TablesLister(newMultiBucketMock(counterTestData, &inFlight, &maxInFlight))
+
+	result, err := lister.CountRecursive(context.Background(), CountScope{})
+	if err != nil {
+		t.Fatalf("CountRecursive() error = %v", err)
+	}
+
+	if result.Total != 9 {
+		t.Errorf("Total = %d, want 9", result.Total)
+	}
+
+	perBucket := map[string]int{}
+	for _, c := range result.Breakdown {
+		perBucket[c.TableBucket] += c.Tables
+	}
+	if want := map[string]int{"bucket-a": 5, "bucket-b": 4}; !reflect.DeepEqual(perBucket, want) {
+		t.Errorf("per-bucket totals = %v, want %v", perBucket, want)
+	}
+	if maxInFlight > countConcurrency {
+		t.Errorf("max in-flight calls = %d, exceeds %d", maxInFlight, countConcurrency)
+	}
+}
+
+// TestCountRecursive_Prefixes tests that the scope prefixes reach the API and narrow the counts
+func TestCountRecursive_Prefixes(t *testing.T) {
+	tables := map[string][]string{
+		"sales":     {"orders", "order_items", "refunds"},
+		"sales_eu":  {"orders"},
+		"marketing": {"orders", "campaigns"},
+	}
+	var mu sync.Mutex
+	var nsPrefixes, tablePrefixes []string
+
+	mock := &FuncMockS3TablesAPI{
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			prefix := aws.ToString(params.Prefix)
+			mu.Lock()
+			nsPrefixes = append(nsPrefixes, prefix)
+			mu.Unlock()
+			var summaries []types.NamespaceSummary
+			for ns := range tables {
+				if strings.HasPrefix(ns, prefix) {
+					summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+				}
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			prefix := aws.ToString(params.Prefix)
+			mu.Lock()
+			tablePrefixes = append(tablePrefixes, prefix)
+			mu.Unlock()
+			var summaries []types.TableSummary
+			for _, name := range tables[aws.ToString(params.Namespace)] {
+				if strings.HasPrefix(name, prefix) {
+					summaries = append(summaries, types.TableSummary{Name: aws.String(name), Namespace: []string{aws.ToString(params.Namespace)}})
+				}
+			}
+			return &s3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+	}
+	lister := NewS3TablesLister(mock)
+
+	result, err := lister.CountRecursive(context.Background(), CountScope{
+		TableBucketName: "bucket-a",
+		TableBucketARN:  counterTestARNPrefix + "bucket-a",
+		NamespacePrefix: "sales",
+		TablePrefix:     "order",
+	})
+	if err != nil {
+		t.Fatalf("CountRecursive() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(nsPrefixes, []string{"sales"}) {
+		t.Errorf("ListNamespaces prefixes = %v, want [sales]", nsPrefixes)
+	}
+	if !reflect.DeepEqual(tablePrefixes, []string{"order", "order"}) {
+		t.Errorf("ListTables prefixes = %v, want [order order]", tablePrefixes)
+	}
+
+	perNamespace := map[string]int{}
+	for _, c := range result.Breakdown {
+		perNamespace[c.Namespace] = c.Tables
+	}
+	if want := map[string]int{"sales": 2, "sales_eu": 1}; !reflect.DeepEqual(perNamespace, want) {
+		t.Errorf("per-namespace counts = %v, want %v", perNamespace, want)
+	}
+	if result.Total != 3 {
+		t.Errorf("Total = %d, want 3", result.Total)
+	}
+}
+
+// TestCountRecursive_ContinueOnError tests that failing buckets and namespaces are
+// skipped and reported with ContinueOnError, and fail the count without it
+func TestCountRecursive_ContinueOnError(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mock := newMultiBucketMock(counterTestData, &inFlight, &maxInFlight)
+	listNamespaces, listTables := mock.ListNamespacesFunc, mock.ListTablesFunc
+	mock.ListNamespacesFunc = func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+		if aws.ToString(params.TableBucketARN) == counterTestARNPrefix+"bucket-b" {
+			return nil, &S3TablesError{Operation: "ListNamespaces", Message: "throttled", Type: ErrorTypeThrottling}
+		}
+		return listNamespaces(ctx, params)
+	}
+	mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+		if aws.ToString(params.Namespace) == "ns3" {
+			return nil, &S3TablesError{Operation: "ListTables", Message: "denied", Type: ErrorTypeForbidden}
+		}
+		return listTables(ctx, params)
+	}
+	lister := NewS3TablesLister(mock)
+
+	if _, err := lister.CountRecursive(context.Background(), CountScope{}); err == nil {
+		t.Fatal("CountRecursive() without ContinueOnError: want an error")
+	}
+
+	result, err := lister.CountRecursive(context.Background(), CountScope{ContinueOnError: true})
+	if err != nil {
+		t.Fatalf("CountRecursive() error = %v", err)
+	}
+	if result.Total != 3 {
+		t.Errorf("Total = %d, want 3 (bucket-a ns1 only)", result.Total)
+	}
+	if len(result.SkippedBuckets) != 1 || result.SkippedBuckets[0].Item != "bucket-b" {
+		t.Errorf("SkippedBuckets = %v, want bucket-b", result.SkippedBuckets)
+	}
+	if len(result.SkippedNamespaces) != 1 || result.SkippedNamespaces[0].Item != "bucket-a/ns3" {
+		t.Errorf("SkippedNamespaces = %v, want bucket-a/ns3", result.SkippedNamespaces)
+	}
+	for _, c := range result.Breakdown {
+		if c.Namespace == "ns3" || c.TableBucket == "bucket-b" {
+			t.Errorf("Breakdown contains skipped entry %+v", c)
+		}
+	}
+}
+
+// TestRunLimitedStopsAfterError tests that runLimited starts no call after one fails
+func TestRunLimitedStopsAfterError(t *testing.T) {
+	var calls []int
+	err := runLimited(4, 1, func(i int) error {
+		calls = append(calls, i)
+		if i == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "failed" {
+		t.Errorf("runLimited() error = %v, want failed", err)
+	}
+	if !reflect.DeepEqual(calls, []int{0, 1}) {
+		t.Errorf("calls = %v, want [0 1]", calls)
+	}
+}
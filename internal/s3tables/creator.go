@@ -4,8 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/aws/smithy-go"
@@ -22,26 +27,285 @@ type S3TablesAPI interface {
 	CreateTable(ctx context.Context, params *s3tables.CreateTableInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateTableOutput, error)
 	ListNamespaces(ctx context.Context, params *s3tables.ListNamespacesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListNamespacesOutput, error)
 	ListTables(ctx context.Context, params *s3tables.ListTablesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTablesOutput, error)
+	DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error)
+	DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error)
 }
 
 // CreateResult represents the result of resource creation
+// TableARN and TableCreated describe the last table processed; Tables holds every table
 type CreateResult struct {
 	TableBucketARN     string
 	TableARN           string
+	Namespace          string // テーブルを作成する Namespace 名
 	Messages           []string
+	Steps              []CreateStep // Messages と同じ順で、各メッセージの対象と結果を表す
 	TableBucketCreated bool
 	NamespaceCreated   bool
 	TableCreated       bool
+	Tables             []TableResult
+}
+
+// StepOutcome is what a create did with one resource
+type StepOutcome int
+
+const (
+	// StepCreated means the resource did not exist and was created
+	StepCreated StepOutcome = iota
+	// StepRecreated means an existing table was deleted and created again
+	StepRecreated
+	// StepExisted means the resource already existed and was kept
+	StepExisted
+	// StepSkipped means an existing table was kept because its recreate was declined
+	StepSkipped
+)
+
+// CreateStep is the outcome of one resource of a create, with its message
+type CreateStep struct {
+	Resource string // "table-bucket"、"namespace" または "table"
+	Name     string
+	Outcome  StepOutcome
+	Message  string
+}
+
+// New reports whether the step created the resource, including a recreate
+func (s CreateStep) New() bool {
+	return s.Outcome == StepCreated || s.Outcome == StepRecreated
+}
+
+// addStep records the outcome of a resource together with its message
+func (r *CreateResult) addStep(resource, name string, outcome StepOutcome, message string) {
+	r.Messages = append(r.Messages, message)
+	r.Steps = append(r.Steps, CreateStep{Resource: resource, Name: name, Outcome: outcome, Message: message})
+}
+
+// Counts returns the number of resources that were created and that already existed
+func (r *CreateResult) Counts() (created, existed int) {
+	if r.TableBucketCreated {
+		created++
+	} else if r.TableBucketARN != "" {
+		existed++
+	}
+
+	if r.NamespaceCreated {
+		created++
+	} else {
+		existed++
+	}
+
+	for _, tbl := range r.Tables {
+		if tbl.Created {
+			created++
+		} else if tbl.ARN != "" {
+			existed++
+		}
+	}
+	return created, existed
+}
+
+// SummaryLine returns a stable single-line summary for scripts, e.g.
+// SUMMARY created=2 existed=1 bucket_arn=arn:... table_arn=arn:...
+func (r *CreateResult) SummaryLine() string {
+	created, existed := r.Counts()
+	return fmt.Sprintf("SUMMARY created=%d existed=%d bucket_arn=%s table_arn=%s",
+		created, existed, r.TableBucketARN, r.TableARN)
+}
+
+// TableResult represents the creation result of a single table
+type TableResult struct {
+	Name    string
+	ARN     string
+	Created bool
+	Skipped bool // 再作成の確認が拒否され、既存のテーブルをそのまま残した
 }
 
 // S3TablesCreator manages S3 Tables resource creation
 type S3TablesCreator struct {
 	client S3TablesAPI
+	audit  *AuditLogger
+	// waitTimeout > 0 waits after each create until the resource is visible
+	waitTimeout time.Duration
+	waitBackoff BackoffConfig
+	// bucketReadyTimeout > 0 polls GetTableBucket after a table bucket is created until it succeeds
+	bucketReadyTimeout time.Duration
+	// expectedRegion is the configured region that created ARNs are checked against ("" skips the check)
+	expectedRegion string
+	warnOut        io.Writer
+	// createBucketIfMissing is false when table buckets are managed elsewhere and must already exist
+	createBucketIfMissing bool
+	// progress receives batch progress events (nil disables reporting)
+	progress func(ProgressEvent)
+	// recreator deletes existing tables before they are created again (nil keeps existing tables)
+	recreator *S3TablesDeleter
+	// existenceCheck selects Get or List calls for namespace and table existence checks
+	existenceCheck ExistenceCheck
+	// expectType is the type an existing table must have (empty skips the check)
+	expectType types.TableType
+	// tableMetadata is the schema and partition spec passed to CreateTable (nil creates tables without metadata)
+	tableMetadata types.TableMetadata
 }
 
 // NewS3TablesCreator creates a new S3TablesCreator instance
 func NewS3TablesCreator(client S3TablesAPI) *S3TablesCreator {
-	return &S3TablesCreator{client: client}
+	return &S3TablesCreator{client: client, warnOut: os.Stderr, createBucketIfMissing: true}
+}
+
+// SetTableMetadata sets the Iceberg metadata (schema and partition spec) that new
+// tables are created with, as built by BuildIcebergMetadata. nil creates tables
+// without metadata.
+func (c *S3TablesCreator) SetTableMetadata(metadata types.TableMetadata) {
+	c.tableMetadata = metadata
+}
+
+// SetExpectedRegion sets the configured region. A created table bucket whose ARN
+// names a different region is reported as a warning, since it usually means the
+// client talks to a misconfigured endpoint.
+func (c *S3TablesCreator) SetExpectedRegion(region string) {
+	c.expectedRegion = region
+}
+
+// SetWarningOutput sets the writer that warnings are printed to
+func (c *S3TablesCreator) SetWarningOutput(w io.Writer) {
+	c.warnOut = w
+}
+
+// checkCreatedRegion warns when the ARN of a created table bucket is malformed
+// or belongs to a region other than the expected one
+func (c *S3TablesCreator) checkCreatedRegion(tableBucket, bucketARN string) {
+	if c.expectedRegion == "" {
+		return
+	}
+	parsed, err := arn.Parse(bucketARN)
+	if err != nil {
+		fmt.Fprintf(c.warnOut, "Warning: created table bucket '%s' has a malformed ARN '%s': %v\n", tableBucket, bucketARN, err)
+		return
+	}
+	if parsed.Region != c.expectedRegion {
+		fmt.Fprintf(c.warnOut, "Warning: table bucket '%s' was created in region '%s' but the configured region is '%s'; check the endpoint configuration\n",
+			tableBucket, parsed.Region, c.expectedRegion)
+	}
+}
+
+// SetCreateBucketIfMissing controls whether a missing table bucket is created (the default).
+// When disabled, a missing bucket is reported as a NotFound error before any
+// namespace or table is touched, for buckets that are managed elsewhere (e.g. IaC).
+func (c *S3TablesCreator) SetCreateBucketIfMissing(create bool) {
+	c.createBucketIfMissing = create
+}
+
+// SetProgressFunc sets the function that receives progress events from CreateBatch
+func (c *S3TablesCreator) SetProgressFunc(fn func(ProgressEvent)) {
+	c.progress = fn
+}
+
+// SetForceRecreate makes an existing table be deleted through deleter and created
+// fresh. The deleter asks for confirmation before each delete; a declined
+// confirmation keeps that table, records it as skipped and moves on to the next
+// one. A nil deleter keeps existing tables.
+func (c *S3TablesCreator) SetForceRecreate(deleter *S3TablesDeleter) {
+	c.recreator = deleter
+}
+
+// SetExistenceCheck selects how namespace and table existence is determined, for
+// IAM policies that allow only Get or only List calls. Table buckets are always
+// found with ListTableBuckets, since GetTableBucket needs the ARN that listing provides.
+func (c *S3TablesCreator) SetExistenceCheck(check ExistenceCheck) {
+	c.existenceCheck = check
+}
+
+// SetExpectTableType makes an existing table whose type differs from expected an
+// error instead of being skipped, so configuration drift is not hidden. An empty
+// type disables the check.
+func (c *S3TablesCreator) SetExpectTableType(expected types.TableType) {
+	c.expectType = expected
+}
+
+// ParseTableType parses a table type name: customer or aws. An empty name
+// yields the empty type, which disables SetExpectTableType.
+func ParseTableType(s string) (types.TableType, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, t := range types.TableTypeCustomer.Values() {
+		if strings.EqualFold(s, string(t)) {
+			return t, nil
+		}
+	}
+	names := make([]string, 0, 2)
+	for _, t := range types.TableTypeCustomer.Values() {
+		names = append(names, string(t))
+	}
+	return "", fmt.Errorf("invalid table type '%s' (available: %s)", s, strings.Join(names, ", "))
+}
+
+// verifyTableType checks that an existing table of tableType has the expected type
+func (c *S3TablesCreator) verifyTableType(table string, tableType types.TableType) error {
+	if c.expectType == "" || tableType == c.expectType {
+		return nil
+	}
+	return &S3TablesError{
+		Operation:   "CreateTable",
+		Message:     fmt.Sprintf("table '%s' exists with type '%s', expected '%s'", table, tableType, c.expectType),
+		Suggestions: []string{msg(MsgSuggestCheckTableType)},
+		Type:        ErrorTypeConflict,
+	}
+}
+
+// SetAuditLogger sets the logger that records every mutating operation
+func (c *S3TablesCreator) SetAuditLogger(audit *AuditLogger) {
+	c.audit = audit
+}
+
+// SetWaitConsistent makes every create wait up to timeout until the new resource
+// is visible through Get/List, so that follow-up calls do not hit eventual
+// consistency. A zero timeout disables waiting.
+func (c *S3TablesCreator) SetWaitConsistent(timeout time.Duration) {
+	c.waitTimeout = timeout
+	c.waitBackoff = visibilityBackoff()
+}
+
+// SetWaitBucketReady makes a table bucket create wait up to timeout until
+// GetTableBucket succeeds, since namespace calls made right after the create
+// occasionally fail while the bucket is still being set up. A zero timeout disables waiting.
+func (c *S3TablesCreator) SetWaitBucketReady(timeout time.Duration) {
+	c.bucketReadyTimeout = timeout
+	c.waitBackoff = visibilityBackoff()
+}
+
+// waitBucketReady polls GetTableBucket when SetWaitBucketReady is enabled.
+// Not-found and retryable errors count as not ready yet; other errors abort.
+func (c *S3TablesCreator) waitBucketReady(ctx context.Context, tableBucketARN string) error {
+	if c.bucketReadyTimeout <= 0 {
+		return nil
+	}
+	return waitForVisible(ctx, "CreateTableBucket", c.waitBackoff, c.bucketReadyTimeout, func(ctx context.Context) (bool, error) {
+		_, err := c.client.GetTableBucket(ctx, &s3tables.GetTableBucketInput{
+			TableBucketARN: aws.String(tableBucketARN),
+		})
+		if err == nil {
+			return true, nil
+		}
+		wrapped := WrapError("GetTableBucket", err)
+		var s3tErr *S3TablesError
+		if IsNotFoundError(wrapped) || (errors.As(wrapped, &s3tErr) && s3tErr.Retryable()) {
+			return false, nil
+		}
+		return false, wrapped
+	})
+}
+
+// waitVisible waits for a created resource when SetWaitConsistent is enabled
+func (c *S3TablesCreator) waitVisible(ctx context.Context, operation string, check func(ctx context.Context) (bool, error)) error {
+	if c.waitTimeout <= 0 {
+		return nil
+	}
+	return waitForVisible(ctx, operation, c.waitBackoff, c.waitTimeout, check)
+}
+
+// waitNamespaceVisible waits until a created namespace is visible through GetNamespace
+func (c *S3TablesCreator) waitNamespaceVisible(ctx context.Context, tableBucketARN, namespace string) error {
+	return c.waitVisible(ctx, "CreateNamespace", func(ctx context.Context) (bool, error) {
+		return c.checkNamespaceExists(ctx, tableBucketARN, namespace)
+	})
 }
 
 // isNotFoundError checks if the error is a NotFoundException from AWS API
@@ -58,28 +322,28 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
-// checkTableBucketExists checks if a Table Bucket exists and returns its ARN if it does
-// Uses ListTableBuckets with prefix filter to find the bucket by name
+// checkTableBucketExists checks if a Table Bucket exists and returns its ARN if it does.
+// Every page of the prefix listing is read, since the exact name may follow longer
+// names that share its prefix; throttled list calls are retried like other listings.
 func (c *S3TablesCreator) checkTableBucketExists(ctx context.Context, tableBucket string) (exists bool, arn string, err error) {
-	output, err := c.client.ListTableBuckets(ctx, &s3tables.ListTableBucketsInput{
-		Prefix: aws.String(tableBucket),
-	})
-	if err != nil {
-		return false, "", WrapError("ListTableBuckets", err)
+	lister := NewS3TablesLister(c.client)
+	lister.SetWarningOutput(c.warnOut)
+	bucket, _, err := lister.FindBucketByExactName(ctx, tableBucket)
+	if err != nil || bucket == nil {
+		return false, "", err
 	}
-
-	// Find exact match in the results
-	for _, bucket := range output.TableBuckets {
-		if aws.ToString(bucket.Name) == tableBucket {
-			return true, aws.ToString(bucket.Arn), nil
-		}
-	}
-
-	return false, "", nil
+	return true, bucket.ARN, nil
 }
 
-// checkNamespaceExists checks if a Namespace exists under the given Table Bucket
+// checkNamespaceExists checks if a Namespace exists under the given Table Bucket,
+// using the API selected by SetExistenceCheck. In auto mode a forbidden
+// GetNamespace falls back to ListNamespaces with the namespace as prefix,
+// since some policies allow listing but not GetNamespace.
 func (c *S3TablesCreator) checkNamespaceExists(ctx context.Context, tableBucketARN, namespace string) (exists bool, err error) {
+	if c.existenceCheck == ExistenceCheckList {
+		return c.listNamespaceExists(ctx, tableBucketARN, namespace)
+	}
+
 	_, err = c.client.GetNamespace(ctx, &s3tables.GetNamespaceInput{
 		TableBucketARN: aws.String(tableBucketARN),
 		Namespace:      aws.String(namespace),
@@ -88,13 +352,39 @@ func (c *S3TablesCreator) checkNamespaceExists(ctx context.Context, tableBucketA
 		if isNotFoundError(err) {
 			return false, nil
 		}
-		return false, WrapError("GetNamespace", err)
+		wrapped := WrapError("GetNamespace", err)
+		if c.existenceCheck == ExistenceCheckAuto && GetErrorType(wrapped) == ErrorTypeForbidden {
+			if exists, listErr := c.listNamespaceExists(ctx, tableBucketARN, namespace); listErr == nil {
+				return exists, nil
+			}
+		}
+		return false, wrapped
 	}
 	return true, nil
 }
 
-// checkTableExists checks if a Table exists under the given Namespace
-func (c *S3TablesCreator) checkTableExists(ctx context.Context, tableBucketARN, namespace, table string) (exists bool, tableARN string, err error) {
+// listNamespaceExists determines namespace existence by listing with the namespace as prefix
+func (c *S3TablesCreator) listNamespaceExists(ctx context.Context, tableBucketARN, namespace string) (bool, error) {
+	namespaces, err := NewS3TablesLister(c.client).ListNamespacesAll(ctx, tableBucketARN, namespace)
+	if err != nil {
+		return false, err
+	}
+	for _, ns := range namespaces {
+		if ns.Name == namespace {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkTableExists checks if a Table exists under the given Namespace, using the
+// API selected by SetExistenceCheck. In auto mode a forbidden GetTable falls
+// back to ListTables with the table as prefix.
+func (c *S3TablesCreator) checkTableExists(ctx context.Context, tableBucketARN, namespace, table string) (exists bool, tableARN string, tableType types.TableType, err error) {
+	if c.existenceCheck == ExistenceCheckList {
+		return c.listTableExists(ctx, tableBucketARN, namespace, table)
+	}
+
 	output, err := c.client.GetTable(ctx, &s3tables.GetTableInput{
 		TableBucketARN: aws.String(tableBucketARN),
 		Namespace:      aws.String(namespace),
@@ -102,36 +392,70 @@ func (c *S3TablesCreator) checkTableExists(ctx context.Context, tableBucketARN,
 	})
 	if err != nil {
 		if isNotFoundError(err) {
-			return false, "", nil
+			return false, "", "", nil
+		}
+		wrapped := WrapError("GetTable", err)
+		if c.existenceCheck == ExistenceCheckAuto && GetErrorType(wrapped) == ErrorTypeForbidden {
+			if exists, arn, tableType, listErr := c.listTableExists(ctx, tableBucketARN, namespace, table); listErr == nil {
+				return exists, arn, tableType, nil
+			}
 		}
-		return false, "", WrapError("GetTable", err)
+		return false, "", "", wrapped
 	}
-	return true, aws.ToString(output.TableARN), nil
+	return true, aws.ToString(output.TableARN), output.Type, nil
+}
+
+// listTableExists determines table existence by listing with the table as prefix.
+// A namespace that does not exist yet holds no tables.
+func (c *S3TablesCreator) listTableExists(ctx context.Context, tableBucketARN, namespace, table string) (bool, string, types.TableType, error) {
+	tables, err := NewS3TablesLister(c.client).ListTablesAll(ctx, tableBucketARN, namespace, table)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return false, "", "", nil
+		}
+		return false, "", "", err
+	}
+	for _, tbl := range tables {
+		if tbl.Name == table {
+			return true, tbl.ARN, types.TableType(tbl.Type), nil
+		}
+	}
+	return false, "", "", nil
 }
 
 // Create creates S3 Tables resources hierarchically: Table Bucket → Namespace → Table
 // It checks for existing resources and only creates what's needed
 func (c *S3TablesCreator) Create(ctx context.Context, tableBucket, namespace, table string) (*CreateResult, error) {
+	return c.CreateTables(ctx, tableBucket, namespace, []string{table})
+}
+
+// CreateTables creates the Table Bucket and Namespace once, then each of the given tables
+// in order. Existing resources are detected and skipped. On failure the returned
+// result still records the resources created before the error.
+func (c *S3TablesCreator) CreateTables(ctx context.Context, tableBucket, namespace string, tables []string) (*CreateResult, error) {
 	result := &CreateResult{
-		Messages: make([]string, 0),
+		Namespace: namespace,
+		Messages:  make([]string, 0),
 	}
 
 	// Step 1: Check/Create Table Bucket
 	tableBucketARN, err := c.ensureTableBucket(ctx, tableBucket, result)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
 
 	// Step 2: Check/Create Namespace
 	err = c.ensureNamespace(ctx, tableBucketARN, namespace, result)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
 
-	// Step 3: Check/Create Table
-	err = c.ensureTable(ctx, tableBucketARN, namespace, table, result)
-	if err != nil {
-		return nil, err
+	// Step 3: Check/Create each Table
+	for _, table := range tables {
+		err = c.ensureTable(ctx, tableBucketARN, namespace, table, result)
+		if err != nil {
+			return result, err
+		}
 	}
 
 	return result, nil
@@ -146,24 +470,98 @@ func (c *S3TablesCreator) ensureTableBucket(ctx context.Context, tableBucket str
 
 	if exists {
 		result.TableBucketARN = arn
-		result.Messages = append(result.Messages, fmt.Sprintf("Table Bucket '%s' already exists", tableBucket))
+		result.addStep("table-bucket", tableBucket, StepExisted, fmt.Sprintf("Table Bucket '%s' already exists", tableBucket))
 		return arn, nil
 	}
+	if !c.createBucketIfMissing {
+		return "", &S3TablesError{
+			Operation:   "CreateTableBucket",
+			Message:     fmt.Sprintf("table bucket '%s' does not exist", tableBucket),
+			Suggestions: []string{msg(MsgSuggestCreateBucketFirst)},
+			Type:        ErrorTypeNotFound,
+		}
+	}
 
 	// Create Table Bucket
 	output, err := c.client.CreateTableBucket(ctx, &s3tables.CreateTableBucketInput{
 		Name: aws.String(tableBucket),
 	})
 	if err != nil {
-		return "", WrapError("CreateTableBucket", err)
+		c.audit.logResult("CreateTableBucket", "table-bucket", tableBucket, "", err)
+		wrapped := WrapError("CreateTableBucket", err)
+		if IsConflictError(err) {
+			c.suggestTableBucketName(ctx, tableBucket, wrapped)
+		}
+		return "", wrapped
 	}
+	c.audit.logResult("CreateTableBucket", "table-bucket", tableBucket, aws.ToString(output.Arn), nil)
+	c.checkCreatedRegion(tableBucket, aws.ToString(output.Arn))
 
+	// The bucket exists from here on, even if waiting for it fails
 	result.TableBucketCreated = true
 	result.TableBucketARN = aws.ToString(output.Arn)
-	result.Messages = append(result.Messages, fmt.Sprintf("Table Bucket '%s' created", tableBucket))
+	result.addStep("table-bucket", tableBucket, StepCreated, fmt.Sprintf("Table Bucket '%s' created", tableBucket))
+
+	if err := c.waitVisible(ctx, "CreateTableBucket", func(ctx context.Context) (bool, error) {
+		exists, _, err := c.checkTableBucketExists(ctx, tableBucket)
+		return exists, err
+	}); err != nil {
+		return "", err
+	}
+	if err := c.waitBucketReady(ctx, result.TableBucketARN); err != nil {
+		return "", err
+	}
 	return result.TableBucketARN, nil
 }
 
+// maxNameSuggestionProbes bounds how many suffixed names suggestAvailableName tries
+const maxNameSuggestionProbes = 20
+
+// suggestAvailableName probes base-2, base-3, ... and returns the first name
+// for which existsFn reports false. base is shortened so that each candidate
+// fits in maxLen, and every candidate must pass validate before it is probed.
+func suggestAvailableName(ctx context.Context, base string, maxLen int, validate func(string) error, existsFn func(string) (bool, error)) (string, error) {
+	for i := 2; i < 2+maxNameSuggestionProbes; i++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		suffix := fmt.Sprintf("-%d", i)
+		candidate := base
+		if len(candidate)+len(suffix) > maxLen {
+			candidate = candidate[:max(maxLen-len(suffix), 0)]
+		}
+		candidate += suffix
+		if err := validate(candidate); err != nil {
+			return "", err
+		}
+		exists, err := existsFn(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no available name found for '%s' after %d attempts", base, maxNameSuggestionProbes)
+}
+
+// suggestTableBucketName replaces the generic conflict suggestion on err with
+// a concrete available Table Bucket name. err is left unchanged when probing fails.
+func (c *S3TablesCreator) suggestTableBucketName(ctx context.Context, tableBucket string, err error) {
+	var s3tErr *S3TablesError
+	if !errors.As(err, &s3tErr) {
+		return
+	}
+	name, probeErr := suggestAvailableName(ctx, tableBucket, maxTableBucketLength, ValidateTableBucket, func(candidate string) (bool, error) {
+		exists, _, err := c.checkTableBucketExists(ctx, candidate)
+		return exists, err
+	})
+	if probeErr != nil {
+		return
+	}
+	s3tErr.Suggestions = []string{msg(MsgSuggestAlternativeName, name)}
+}
+
 // ensureNamespace ensures the Namespace exists, creating it if necessary
 func (c *S3TablesCreator) ensureNamespace(ctx context.Context, tableBucketARN, namespace string, result *CreateResult) error {
 	exists, err := c.checkNamespaceExists(ctx, tableBucketARN, namespace)
@@ -172,7 +570,7 @@ func (c *S3TablesCreator) ensureNamespace(ctx context.Context, tableBucketARN, n
 	}
 
 	if exists {
-		result.Messages = append(result.Messages, fmt.Sprintf("Namespace '%s' already exists", namespace))
+		result.addStep("namespace", namespace, StepExisted, fmt.Sprintf("Namespace '%s' already exists", namespace))
 		return nil
 	}
 
@@ -181,27 +579,46 @@ func (c *S3TablesCreator) ensureNamespace(ctx context.Context, tableBucketARN, n
 		TableBucketARN: aws.String(tableBucketARN),
 		Namespace:      []string{namespace},
 	})
+	c.audit.logResult("CreateNamespace", "namespace", namespace, tableBucketARN, err)
 	if err != nil {
 		return WrapError("CreateNamespace", err)
 	}
 
 	result.NamespaceCreated = true
-	result.Messages = append(result.Messages, fmt.Sprintf("Namespace '%s' created", namespace))
-	return nil
+	result.addStep("namespace", namespace, StepCreated, fmt.Sprintf("Namespace '%s' created", namespace))
+	return c.waitNamespaceVisible(ctx, tableBucketARN, namespace)
 }
 
 // ensureTable ensures the Table exists, creating it if necessary
 func (c *S3TablesCreator) ensureTable(ctx context.Context, tableBucketARN, namespace, table string, result *CreateResult) error {
-	exists, tableARN, err := c.checkTableExists(ctx, tableBucketARN, namespace, table)
+	exists, tableARN, tableType, err := c.checkTableExists(ctx, tableBucketARN, namespace, table)
 	if err != nil {
 		return err
 	}
 
-	if exists {
+	if exists && c.recreator == nil {
+		if err := c.verifyTableType(table, tableType); err != nil {
+			return err
+		}
 		result.TableARN = tableARN
-		result.Messages = append(result.Messages, fmt.Sprintf("Table '%s' already exists", table))
+		result.TableCreated = false
+		result.Tables = append(result.Tables, TableResult{Name: table, ARN: tableARN})
+		result.addStep("table", table, StepExisted, fmt.Sprintf("Table '%s' already exists", table))
 		return nil
 	}
+	if exists {
+		err := c.recreator.DeleteTable(ctx, tableBucketARN, namespace, table)
+		if errors.Is(err, ErrDeleteCancelled) {
+			result.TableARN = tableARN
+			result.TableCreated = false
+			result.Tables = append(result.Tables, TableResult{Name: table, ARN: tableARN, Skipped: true})
+			result.addStep("table", table, StepSkipped, fmt.Sprintf("Table '%s' skipped (recreate declined)", table))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
 
 	// Create Table
 	output, err := c.client.CreateTable(ctx, &s3tables.CreateTableInput{
@@ -209,13 +626,78 @@ func (c *S3TablesCreator) ensureTable(ctx context.Context, tableBucketARN, names
 		Namespace:      aws.String(namespace),
 		Name:           aws.String(table),
 		Format:         types.OpenTableFormatIceberg,
+		Metadata:       c.tableMetadata,
 	})
 	if err != nil {
+		c.audit.logResult("CreateTable", "table", namespace+"/"+table, "", err)
 		return WrapError("CreateTable", err)
 	}
+	c.audit.logResult("CreateTable", "table", namespace+"/"+table, aws.ToString(output.TableARN), nil)
 
 	result.TableCreated = true
 	result.TableARN = aws.ToString(output.TableARN)
-	result.Messages = append(result.Messages, fmt.Sprintf("Table '%s' created", table))
-	return nil
+	result.Tables = append(result.Tables, TableResult{Name: table, ARN: result.TableARN, Created: true})
+	if exists {
+		result.addStep("table", table, StepRecreated, fmt.Sprintf("Table '%s' recreated", table))
+	} else {
+		result.addStep("table", table, StepCreated, fmt.Sprintf("Table '%s' created", table))
+	}
+	return c.waitVisible(ctx, "CreateTable", func(ctx context.Context) (bool, error) {
+		exists, _, _, err := c.checkTableExists(ctx, tableBucketARN, namespace, table)
+		return exists, err
+	})
+}
+
+// namespaceCheckConcurrency bounds the number of namespace existence checks in flight
+const namespaceCheckConcurrency = 8
+
+// EnsureNamespaces ensures that all given Namespaces exist under the Table Bucket.
+// Existence checks run in parallel with at most namespaceCheckConcurrency in
+// flight; missing namespaces are then created sequentially in input order.
+// Duplicate names are checked only once.
+// Returns the names of the namespaces that were created.
+func (c *S3TablesCreator) EnsureNamespaces(ctx context.Context, tableBucketARN string, namespaces []string) ([]string, error) {
+	// Remove duplicates while preserving order
+	unique := make([]string, 0, len(namespaces))
+	seen := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		if seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		unique = append(unique, ns)
+	}
+
+	// Check existence in parallel
+	exists := make([]bool, len(unique))
+	err := runLimited(len(unique), namespaceCheckConcurrency, func(i int) error {
+		var err error
+		exists[i], err = c.checkNamespaceExists(ctx, tableBucketARN, unique[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Create missing namespaces in input order
+	var created []string
+	for i, ns := range unique {
+		if exists[i] {
+			continue
+		}
+		_, err := c.client.CreateNamespace(ctx, &s3tables.CreateNamespaceInput{
+			TableBucketARN: aws.String(tableBucketARN),
+			Namespace:      []string{ns},
+		})
+		c.audit.logResult("CreateNamespace", "namespace", ns, tableBucketARN, err)
+		if err != nil {
+			return created, WrapError("CreateNamespace", err)
+		}
+		if err := c.waitNamespaceVisible(ctx, tableBucketARN, ns); err != nil {
+			return created, err
+		}
+		created = append(created, ns)
+	}
+
+	return created, nil
 }
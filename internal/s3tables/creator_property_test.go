@@ -67,6 +67,14 @@ func (m *ErrorReturningMockS3TablesAPI) ListTables(ctx context.Context, params *
 	return &s3tables.ListTablesOutput{Tables: []types.TableSummary{}}, nil
 }
 
+func (m *ErrorReturningMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *ErrorReturningMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // TestCheckTableBucketExistsError tests error handling in checkTableBucketExists
 func TestCheckTableBucketExistsError(t *testing.T) {
 	mock := &ErrorReturningMockS3TablesAPI{
@@ -100,7 +108,7 @@ func TestCheckTableExistsError(t *testing.T) {
 	}
 	creator := NewS3TablesCreator(mock)
 
-	_, _, err := creator.checkTableExists(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "ns", "tbl")
+	_, _, _, err := creator.checkTableExists(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "ns", "tbl")
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -188,6 +196,14 @@ func (m *CreateErrorMockS3TablesAPI) ListTables(ctx context.Context, params *s3t
 	return &s3tables.ListTablesOutput{Tables: []types.TableSummary{}}, nil
 }
 
+func (m *CreateErrorMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *CreateErrorMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // TestEnsureTableBucketCreateError tests error handling when CreateTableBucket fails
 func TestEnsureTableBucketCreateError(t *testing.T) {
 	mock := &CreateErrorMockS3TablesAPI{
@@ -279,6 +295,14 @@ func (m *CheckErrorMockS3TablesAPI) ListTables(ctx context.Context, params *s3ta
 	return &s3tables.ListTablesOutput{Tables: []types.TableSummary{}}, nil
 }
 
+func (m *CheckErrorMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *CheckErrorMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // TestEnsureTableBucketCheckError tests error handling when checkTableBucketExists fails in ensureTableBucket
 func TestEnsureTableBucketCheckError(t *testing.T) {
 	mock := &CheckErrorMockS3TablesAPI{
@@ -416,6 +440,14 @@ func (m *MockS3TablesAPI) ListTables(ctx context.Context, params *s3tables.ListT
 	return &s3tables.ListTablesOutput{Tables: []types.TableSummary{}}, nil
 }
 
+func (m *MockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *MockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // ResourceState represents the existence state of all three resources
 type ResourceState struct {
 	TableBucketExists bool
@@ -580,6 +612,14 @@ func (m *OrderTrackingMockS3TablesAPI) ListTables(ctx context.Context, params *s
 	return &s3tables.ListTablesOutput{Tables: []types.TableSummary{}}, nil
 }
 
+func (m *OrderTrackingMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *OrderTrackingMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // FailureScenario represents which resource creation should fail
 type FailureScenario struct {
 	FailTableBucket bool
@@ -0,0 +1,1079 @@
+package s3tables
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// FuncMockS3TablesAPI is a mock whose behavior is configured per operation via function fields.
+// Unset functions fall back to empty list results, NotFound for Get* calls, and success for Create* and Delete* calls.
+type FuncMockS3TablesAPI struct {
+	ListTableBucketsFunc  func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error)
+	GetTableBucketFunc    func(ctx context.Context, params *s3tables.GetTableBucketInput) (*s3tables.GetTableBucketOutput, error)
+	CreateTableBucketFunc func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error)
+	GetNamespaceFunc      func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error)
+	CreateNamespaceFunc   func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error)
+	GetTableFunc          func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error)
+	CreateTableFunc       func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error)
+	ListNamespacesFunc    func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error)
+	ListTablesFunc        func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error)
+	DeleteNamespaceFunc   func(ctx context.Context, params *s3tables.DeleteNamespaceInput) (*s3tables.DeleteNamespaceOutput, error)
+	DeleteTableFunc       func(ctx context.Context, params *s3tables.DeleteTableInput) (*s3tables.DeleteTableOutput, error)
+}
+
+func (m *FuncMockS3TablesAPI) ListTableBuckets(ctx context.Context, params *s3tables.ListTableBucketsInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTableBucketsOutput, error) {
+	if m.ListTableBucketsFunc != nil {
+		return m.ListTableBucketsFunc(ctx, params)
+	}
+	return &s3tables.ListTableBucketsOutput{}, nil
+}
+
+func (m *FuncMockS3TablesAPI) GetTableBucket(ctx context.Context, params *s3tables.GetTableBucketInput, optFns ...func(*s3tables.Options)) (*s3tables.GetTableBucketOutput, error) {
+	if m.GetTableBucketFunc != nil {
+		return m.GetTableBucketFunc(ctx, params)
+	}
+	return nil, &types.NotFoundException{Message: aws.String("not found")}
+}
+
+func (m *FuncMockS3TablesAPI) CreateTableBucket(ctx context.Context, params *s3tables.CreateTableBucketInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateTableBucketOutput, error) {
+	if m.CreateTableBucketFunc != nil {
+		return m.CreateTableBucketFunc(ctx, params)
+	}
+	return &s3tables.CreateTableBucketOutput{Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/" + aws.ToString(params.Name))}, nil
+}
+
+func (m *FuncMockS3TablesAPI) GetNamespace(ctx context.Context, params *s3tables.GetNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.GetNamespaceOutput, error) {
+	if m.GetNamespaceFunc != nil {
+		return m.GetNamespaceFunc(ctx, params)
+	}
+	return nil, &types.NotFoundException{Message: aws.String("not found")}
+}
+
+func (m *FuncMockS3TablesAPI) CreateNamespace(ctx context.Context, params *s3tables.CreateNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateNamespaceOutput, error) {
+	if m.CreateNamespaceFunc != nil {
+		return m.CreateNamespaceFunc(ctx, params)
+	}
+	return &s3tables.CreateNamespaceOutput{Namespace: params.Namespace}, nil
+}
+
+func (m *FuncMockS3TablesAPI) GetTable(ctx context.Context, params *s3tables.GetTableInput, optFns ...func(*s3tables.Options)) (*s3tables.GetTableOutput, error) {
+	if m.GetTableFunc != nil {
+		return m.GetTableFunc(ctx, params)
+	}
+	return nil, &types.NotFoundException{Message: aws.String("not found")}
+}
+
+func (m *FuncMockS3TablesAPI) CreateTable(ctx context.Context, params *s3tables.CreateTableInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateTableOutput, error) {
+	if m.CreateTableFunc != nil {
+		return m.CreateTableFunc(ctx, params)
+	}
+	return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + aws.ToString(params.Name))}, nil
+}
+
+func (m *FuncMockS3TablesAPI) ListNamespaces(ctx context.Context, params *s3tables.ListNamespacesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListNamespacesOutput, error) {
+	if m.ListNamespacesFunc != nil {
+		return m.ListNamespacesFunc(ctx, params)
+	}
+	return &s3tables.ListNamespacesOutput{}, nil
+}
+
+func (m *FuncMockS3TablesAPI) ListTables(ctx context.Context, params *s3tables.ListTablesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTablesOutput, error) {
+	if m.ListTablesFunc != nil {
+		return m.ListTablesFunc(ctx, params)
+	}
+	return &s3tables.ListTablesOutput{}, nil
+}
+
+func (m *FuncMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	if m.DeleteNamespaceFunc != nil {
+		return m.DeleteNamespaceFunc(ctx, params)
+	}
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *FuncMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	if m.DeleteTableFunc != nil {
+		return m.DeleteTableFunc(ctx, params)
+	}
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
+// TestEnsureNamespacesCreatesOnlyMissing tests that only missing namespaces are created, in input order
+func TestEnsureNamespacesCreatesOnlyMissing(t *testing.T) {
+	existing := map[string]bool{"ns_b": true, "ns_d": true}
+
+	var mu sync.Mutex
+	var checked []string
+	var createdCalls []string
+
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			ns := aws.ToString(params.Namespace)
+			mu.Lock()
+			checked = append(checked, ns)
+			mu.Unlock()
+			if existing[ns] {
+				return &s3tables.GetNamespaceOutput{Namespace: []string{ns}}, nil
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateNamespaceFunc: func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error) {
+			mu.Lock()
+			createdCalls = append(createdCalls, params.Namespace[0])
+			mu.Unlock()
+			return &s3tables.CreateNamespaceOutput{Namespace: params.Namespace}, nil
+		},
+	}
+	creator := NewS3TablesCreator(mock)
+
+	created, err := creator.EnsureNamespaces(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", []string{"ns_a", "ns_b", "ns_c", "ns_a", "ns_d"})
+	if err != nil {
+		t.Fatalf("EnsureNamespaces() error = %v", err)
+	}
+
+	want := []string{"ns_a", "ns_c"}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("EnsureNamespaces() created = %v, want %v", created, want)
+	}
+	if !reflect.DeepEqual(createdCalls, want) {
+		t.Errorf("CreateNamespace calls = %v, want %v", createdCalls, want)
+	}
+	if len(checked) != 4 {
+		t.Errorf("GetNamespace called %d times, want 4 (duplicates checked once)", len(checked))
+	}
+}
+
+// TestEnsureNamespacesChecksInParallel tests that existence checks run concurrently
+func TestEnsureNamespacesChecksInParallel(t *testing.T) {
+	namespaces := []string{"ns_a", "ns_b", "ns_c"}
+
+	// Each check blocks until all checks have started; this only completes if they run in parallel
+	var arrived sync.WaitGroup
+	arrived.Add(len(namespaces))
+	allArrived := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(allArrived)
+	}()
+
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			arrived.Done()
+			select {
+			case <-allArrived:
+			case <-time.After(2 * time.Second):
+				t.Error("existence checks did not run in parallel")
+			}
+			return &s3tables.GetNamespaceOutput{Namespace: []string{aws.ToString(params.Namespace)}}, nil
+		},
+	}
+	creator := NewS3TablesCreator(mock)
+
+	created, err := creator.EnsureNamespaces(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", namespaces)
+	if err != nil {
+		t.Fatalf("EnsureNamespaces() error = %v", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("EnsureNamespaces() created = %v, want none", created)
+	}
+}
+
+// TestEnsureNamespacesBoundsChecks tests that no more than namespaceCheckConcurrency checks run at once
+func TestEnsureNamespacesBoundsChecks(t *testing.T) {
+	namespaces := make([]string, 3*namespaceCheckConcurrency)
+	for i := range namespaces {
+		namespaces[i] = fmt.Sprintf("ns_%02d", i)
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return &s3tables.GetNamespaceOutput{Namespace: []string{aws.ToString(params.Namespace)}}, nil
+		},
+	}
+
+	if _, err := NewS3TablesCreator(mock).EnsureNamespaces(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", namespaces); err != nil {
+		t.Fatalf("EnsureNamespaces() error = %v", err)
+	}
+	if got := maxInFlight.Load(); got > namespaceCheckConcurrency {
+		t.Errorf("max in-flight checks = %d, exceeds %d", got, namespaceCheckConcurrency)
+	}
+}
+
+// TestEnsureNamespacesCheckError tests that a failed existence check aborts before any creation
+func TestEnsureNamespacesCheckError(t *testing.T) {
+	createCalled := false
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			if aws.ToString(params.Namespace) == "ns_b" {
+				return nil, &types.InternalServerErrorException{Message: aws.String("internal error")}
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateNamespaceFunc: func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error) {
+			createCalled = true
+			return &s3tables.CreateNamespaceOutput{Namespace: params.Namespace}, nil
+		},
+	}
+	creator := NewS3TablesCreator(mock)
+
+	_, err := creator.EnsureNamespaces(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", []string{"ns_a", "ns_b"})
+	if err == nil {
+		t.Error("EnsureNamespaces() should return error")
+	}
+	if createCalled {
+		t.Error("CreateNamespace should not be called when a check fails")
+	}
+}
+
+// TestCreateTablesMixedExistingAndNew tests creating several tables under one namespace
+func TestCreateTablesMixedExistingAndNew(t *testing.T) {
+	existing := map[string]bool{"customers": true}
+	var createdTables []string
+
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			return &s3tables.GetNamespaceOutput{Namespace: []string{aws.ToString(params.Namespace)}}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			name := aws.ToString(params.Name)
+			if existing[name] {
+				return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + name)}, nil
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			createdTables = append(createdTables, aws.ToString(params.Name))
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + aws.ToString(params.Name))}, nil
+		},
+	}
+	creator := NewS3TablesCreator(mock)
+
+	result, err := creator.CreateTables(context.Background(), "test-bucket", "sales", []string{"orders", "customers", "products"})
+	if err != nil {
+		t.Fatalf("CreateTables() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(createdTables, []string{"orders", "products"}) {
+		t.Errorf("CreateTable calls = %v, want [orders products]", createdTables)
+	}
+
+	want := []TableResult{
+		{Name: "orders", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/orders", Created: true},
+		{Name: "customers", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/customers", Created: false},
+		{Name: "products", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/products", Created: true},
+	}
+	if !reflect.DeepEqual(result.Tables, want) {
+		t.Errorf("CreateTables() Tables = %+v, want %+v", result.Tables, want)
+	}
+	if !result.TableBucketCreated || result.NamespaceCreated {
+		t.Errorf("CreateTables() bucket created = %v, namespace created = %v, want true, false", result.TableBucketCreated, result.NamespaceCreated)
+	}
+	// Bucket, namespace and three tables
+	if len(result.Messages) != 5 {
+		t.Errorf("CreateTables() Messages = %v, want 5 entries", result.Messages)
+	}
+}
+
+// newForbiddenGetNamespaceMock returns a mock that forbids GetNamespace but lists the given namespaces
+func newForbiddenGetNamespaceMock(namespaces []string, listErr error) *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			return nil, &types.AccessDeniedException{Message: aws.String("not authorized to perform s3tables:GetNamespace")}
+		},
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			if listErr != nil {
+				return nil, listErr
+			}
+			var summaries []types.NamespaceSummary
+			for _, ns := range namespaces {
+				if strings.HasPrefix(ns, aws.ToString(params.Prefix)) {
+					summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+				}
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+	}
+}
+
+// TestCheckNamespaceExists_ForbiddenFallsBackToList tests the ListNamespaces fallback when GetNamespace is forbidden
+func TestCheckNamespaceExists_ForbiddenFallsBackToList(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		want      bool
+	}{
+		{name: "exact match exists", namespace: "sales", want: true},
+		{name: "only prefix matches", namespace: "sal", want: false},
+		{name: "absent", namespace: "marketing", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := NewS3TablesCreator(newForbiddenGetNamespaceMock([]string{"sales", "sales_archive"}, nil))
+
+			exists, err := creator.checkNamespaceExists(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", tt.namespace)
+			if err != nil {
+				t.Fatalf("checkNamespaceExists() error = %v", err)
+			}
+			if exists != tt.want {
+				t.Errorf("checkNamespaceExists() = %v, want %v", exists, tt.want)
+			}
+		})
+	}
+}
+
+// TestCheckNamespaceExists_ForbiddenListFails tests that the original forbidden error is returned when listing also fails
+func TestCheckNamespaceExists_ForbiddenListFails(t *testing.T) {
+	listErr := &types.AccessDeniedException{Message: aws.String("not authorized to perform s3tables:ListNamespaces")}
+	creator := NewS3TablesCreator(newForbiddenGetNamespaceMock(nil, listErr))
+
+	_, err := creator.checkNamespaceExists(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "sales")
+
+	var s3tErr *S3TablesError
+	if !errors.As(err, &s3tErr) || s3tErr.Type != ErrorTypeForbidden || s3tErr.Operation != "GetNamespace" {
+		t.Errorf("checkNamespaceExists() error = %v, want forbidden GetNamespace error", err)
+	}
+}
+
+// newSingleAccessMock returns a mock holding namespace "sales" with table "orders" that
+// permits either only the Get calls (allowGet) or only the List calls
+func newSingleAccessMock(allowGet bool) *FuncMockS3TablesAPI {
+	const tableARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/orders"
+	denied := func(operation string) error {
+		return &types.AccessDeniedException{Message: aws.String("not authorized to perform s3tables:" + operation)}
+	}
+	return &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			if !allowGet {
+				return nil, denied("GetNamespace")
+			}
+			if aws.ToString(params.Namespace) != "sales" {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetNamespaceOutput{Namespace: []string{"sales"}}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			if !allowGet {
+				return nil, denied("GetTable")
+			}
+			if aws.ToString(params.Name) != "orders" {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetTableOutput{Name: params.Name, TableARN: aws.String(tableARN)}, nil
+		},
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			if allowGet {
+				return nil, denied("ListNamespaces")
+			}
+			var summaries []types.NamespaceSummary
+			if strings.HasPrefix("sales", aws.ToString(params.Prefix)) {
+				summaries = append(summaries, types.NamespaceSummary{Namespace: []string{"sales"}})
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			if allowGet {
+				return nil, denied("ListTables")
+			}
+			var summaries []types.TableSummary
+			if strings.HasPrefix("orders", aws.ToString(params.Prefix)) {
+				summaries = append(summaries, types.TableSummary{Name: aws.String("orders"), Namespace: []string{"sales"}, TableARN: aws.String(tableARN)})
+			}
+			return &s3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+	}
+}
+
+// TestExistenceCheckModes tests every existence check mode against mocks permitting only Get or only List
+func TestExistenceCheckModes(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/test"
+
+	tests := []struct {
+		name          string
+		allowGet      bool
+		check         ExistenceCheck
+		wantForbidden bool
+	}{
+		{name: "get mode with get access", allowGet: true, check: ExistenceCheckGet},
+		{name: "get mode with list access", allowGet: false, check: ExistenceCheckGet, wantForbidden: true},
+		{name: "list mode with list access", allowGet: false, check: ExistenceCheckList},
+		{name: "list mode with get access", allowGet: true, check: ExistenceCheckList, wantForbidden: true},
+		{name: "auto mode with get access", allowGet: true, check: ExistenceCheckAuto},
+		{name: "auto mode with list access", allowGet: false, check: ExistenceCheckAuto},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := NewS3TablesCreator(newSingleAccessMock(tt.allowGet))
+			creator.SetExistenceCheck(tt.check)
+			ctx := context.Background()
+
+			nsExists, nsErr := creator.checkNamespaceExists(ctx, bucketARN, "sales")
+			nsMissing, nsMissingErr := creator.checkNamespaceExists(ctx, bucketARN, "sal")
+			tblExists, tblARN, _, tblErr := creator.checkTableExists(ctx, bucketARN, "sales", "orders")
+			tblMissing, _, _, tblMissingErr := creator.checkTableExists(ctx, bucketARN, "sales", "order")
+
+			if tt.wantForbidden {
+				for _, err := range []error{nsErr, nsMissingErr, tblErr, tblMissingErr} {
+					if GetErrorType(err) != ErrorTypeForbidden {
+						t.Errorf("error = %v, want forbidden", err)
+					}
+				}
+				return
+			}
+			for _, err := range []error{nsErr, nsMissingErr, tblErr, tblMissingErr} {
+				if err != nil {
+					t.Fatalf("unexpected error = %v", err)
+				}
+			}
+			if !nsExists || nsMissing {
+				t.Errorf("namespace exists = %v, prefix-only exists = %v, want true, false", nsExists, nsMissing)
+			}
+			if !tblExists || tblMissing {
+				t.Errorf("table exists = %v, prefix-only exists = %v, want true, false", tblExists, tblMissing)
+			}
+			if tblARN != "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/orders" {
+				t.Errorf("table ARN = %q", tblARN)
+			}
+		})
+	}
+}
+
+func TestParseExistenceCheck(t *testing.T) {
+	for _, check := range []ExistenceCheck{ExistenceCheckAuto, ExistenceCheckGet, ExistenceCheckList} {
+		got, err := ParseExistenceCheck(check.String())
+		if err != nil || got != check {
+			t.Errorf("ParseExistenceCheck(%q) = %v, %v, want %v", check.String(), got, err, check)
+		}
+	}
+	if _, err := ParseExistenceCheck("head"); err == nil {
+		t.Error("ParseExistenceCheck(\"head\") error = nil, want error")
+	}
+}
+
+// TestCreateResultSummaryLine tests the exact format of the machine-friendly summary
+func TestCreateResultSummaryLine(t *testing.T) {
+	result := &CreateResult{
+		TableBucketARN:     "arn:aws:s3tables:us-east-1:123456789012:bucket/b",
+		TableARN:           "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/t2",
+		TableBucketCreated: false,
+		NamespaceCreated:   true,
+		Tables: []TableResult{
+			{Name: "t1", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/t1", Created: false},
+			{Name: "t2", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/t2", Created: true},
+		},
+	}
+
+	want := "SUMMARY created=2 existed=2 bucket_arn=arn:aws:s3tables:us-east-1:123456789012:bucket/b table_arn=arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/t2"
+	if got := result.SummaryLine(); got != want {
+		t.Errorf("SummaryLine() = %q, want %q", got, want)
+	}
+}
+
+// TestEnsureTableBucketExactMatchOnLaterPage tests that an existing bucket listed
+// after other buckets sharing its prefix is found instead of being created again
+func TestEnsureTableBucketExactMatchOnLaterPage(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/sales"
+	mock := &PaginatedMockS3TablesAPI{
+		TableBuckets: []types.TableBucketSummary{
+			{Name: aws.String("sales-archive"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales-archive")},
+			{Name: aws.String("sales-dev"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales-dev")},
+			{Name: aws.String("sales"), Arn: aws.String(bucketARN)},
+		},
+		PageSize: 2,
+	}
+	creator := NewS3TablesCreator(mock)
+
+	result := &CreateResult{}
+	arn, err := creator.ensureTableBucket(context.Background(), "sales", result)
+	if err != nil {
+		t.Fatalf("ensureTableBucket() error = %v", err)
+	}
+	if arn != bucketARN || result.TableBucketCreated {
+		t.Errorf("ensureTableBucket() = %q (created %v), want the existing %q", arn, result.TableBucketCreated, bucketARN)
+	}
+	if len(result.Steps) != 1 || result.Steps[0].Outcome != StepExisted {
+		t.Errorf("Steps = %+v, want one existing table bucket", result.Steps)
+	}
+}
+
+// TestSuggestAvailableName tests that taken suffixed names are skipped
+func TestSuggestAvailableName(t *testing.T) {
+	taken := map[string]bool{"base": true, "base-2": true}
+	var probed []string
+	existsFn := func(name string) (bool, error) {
+		probed = append(probed, name)
+		return taken[name], nil
+	}
+
+	got, err := suggestAvailableName(context.Background(), "base", maxTableBucketLength, ValidateTableBucket, existsFn)
+	if err != nil {
+		t.Fatalf("suggestAvailableName() error = %v", err)
+	}
+	if got != "base-3" {
+		t.Errorf("suggestAvailableName() = %q, want base-3", got)
+	}
+	if want := []string{"base-2", "base-3"}; !reflect.DeepEqual(probed, want) {
+		t.Errorf("probed = %v, want %v", probed, want)
+	}
+}
+
+// TestSuggestAvailableName_MaxLength tests that a long base is shortened so the suffixed name stays valid
+func TestSuggestAvailableName_MaxLength(t *testing.T) {
+	base := strings.Repeat("a", maxTableBucketLength)
+	got, err := suggestAvailableName(context.Background(), base, maxTableBucketLength, ValidateTableBucket, func(string) (bool, error) {
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("suggestAvailableName() error = %v", err)
+	}
+	if want := strings.Repeat("a", maxTableBucketLength-2) + "-2"; got != want {
+		t.Errorf("suggestAvailableName() = %q, want %q", got, want)
+	}
+	if err := ValidateTableBucket(got); err != nil {
+		t.Errorf("ValidateTableBucket(%q) = %v", got, err)
+	}
+}
+
+// TestSuggestAvailableName_ProbeError tests that probe errors are returned
+func TestSuggestAvailableName_ProbeError(t *testing.T) {
+	probeErr := errors.New("throttled")
+	_, err := suggestAvailableName(context.Background(), "base", maxTableBucketLength, ValidateTableBucket, func(string) (bool, error) {
+		return false, probeErr
+	})
+	if !errors.Is(err, probeErr) {
+		t.Errorf("suggestAvailableName() error = %v, want %v", err, probeErr)
+	}
+}
+
+// TestCreateTableBucketConflictSuggestsName tests the suggestion attached to a CreateTableBucket conflict
+func TestCreateTableBucketConflictSuggestsName(t *testing.T) {
+	existing := map[string]bool{"base-2": true}
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			name := aws.ToString(params.Prefix)
+			if !existing[name] {
+				return &s3tables.ListTableBucketsOutput{}, nil
+			}
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String(name), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/" + name)},
+			}}, nil
+		},
+		CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+			return nil, &types.ConflictException{Message: aws.String("bucket already exists")}
+		},
+	}
+
+	_, err := NewS3TablesCreator(mock).Create(context.Background(), "base", "ns", "tbl")
+
+	var s3tErr *S3TablesError
+	if !errors.As(err, &s3tErr) || s3tErr.Type != ErrorTypeConflict {
+		t.Fatalf("Create() error = %v, want conflict error", err)
+	}
+	if !strings.Contains(s3tErr.Suggestion(), "'base-3'") {
+		t.Errorf("Suggestion = %q, want it to mention 'base-3'", s3tErr.Suggestion())
+	}
+}
+
+// TestCreateWaitConsistent tests that creates poll until the new resources become visible
+func TestCreateWaitConsistent(t *testing.T) {
+	const hiddenPolls = 2
+	var nsCreated, tableCreated bool
+	var nsPolls, tablePolls int
+
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String("test-bucket"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket")},
+			}}, nil
+		},
+		CreateNamespaceFunc: func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error) {
+			nsCreated = true
+			return &s3tables.CreateNamespaceOutput{Namespace: params.Namespace}, nil
+		},
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			if nsCreated {
+				nsPolls++
+				if nsPolls > hiddenPolls {
+					return &s3tables.GetNamespaceOutput{Namespace: []string{aws.ToString(params.Namespace)}}, nil
+				}
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			tableCreated = true
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/orders")}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			if tableCreated {
+				tablePolls++
+				if tablePolls > hiddenPolls {
+					return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/orders")}, nil
+				}
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+	}
+
+	creator := NewS3TablesCreator(mock)
+	creator.SetWaitConsistent(time.Second)
+	creator.waitBackoff = BackoffConfig{BaseDelay: time.Millisecond, Strategy: BackoffConstant}
+
+	if _, err := creator.Create(context.Background(), "test-bucket", "sales", "orders"); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if nsPolls != hiddenPolls+1 {
+		t.Errorf("GetNamespace polls after create = %d, want %d", nsPolls, hiddenPolls+1)
+	}
+	if tablePolls != hiddenPolls+1 {
+		t.Errorf("GetTable polls after create = %d, want %d", tablePolls, hiddenPolls+1)
+	}
+}
+
+// TestCreateWaitConsistentTimeout tests that a resource that never appears yields a timeout error
+func TestCreateWaitConsistentTimeout(t *testing.T) {
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String("test-bucket"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket")},
+			}}, nil
+		},
+	}
+
+	creator := NewS3TablesCreator(mock)
+	creator.SetWaitConsistent(20 * time.Millisecond)
+	creator.waitBackoff = BackoffConfig{BaseDelay: time.Millisecond, Strategy: BackoffConstant}
+
+	_, err := creator.Create(context.Background(), "test-bucket", "sales", "orders")
+	if GetErrorType(err) != ErrorTypeTimeout {
+		t.Fatalf("Create() error = %v, want timeout error", err)
+	}
+}
+
+// TestCreateWaitBucketReady tests that a new table bucket is polled until GetTableBucket
+// succeeds before the namespace is touched
+func TestCreateWaitBucketReady(t *testing.T) {
+	const failingPolls = 3
+	bucketARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/new-bucket"
+
+	tests := []struct {
+		name      string
+		pollErr   error
+		wantPolls int
+		wantErr   bool
+	}{
+		{name: "not found until ready", pollErr: &types.NotFoundException{Message: aws.String("not found")}, wantPolls: failingPolls + 1},
+		{name: "retryable until ready", pollErr: &types.InternalServerErrorException{Message: aws.String("busy")}, wantPolls: failingPolls + 1},
+		{name: "access denied aborts", pollErr: &types.ForbiddenException{Message: aws.String("denied")}, wantPolls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int
+			pollsAtNamespace := -1
+			mock := &FuncMockS3TablesAPI{
+				CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+					return &s3tables.CreateTableBucketOutput{Arn: aws.String(bucketARN)}, nil
+				},
+				GetTableBucketFunc: func(ctx context.Context, params *s3tables.GetTableBucketInput) (*s3tables.GetTableBucketOutput, error) {
+					polls++
+					if aws.ToString(params.TableBucketARN) != bucketARN {
+						t.Errorf("GetTableBucket ARN = %q, want %q", aws.ToString(params.TableBucketARN), bucketARN)
+					}
+					if polls <= failingPolls {
+						return nil, tt.pollErr
+					}
+					return &s3tables.GetTableBucketOutput{Arn: aws.String(bucketARN)}, nil
+				},
+				GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+					if pollsAtNamespace < 0 {
+						pollsAtNamespace = polls
+					}
+					return nil, &types.NotFoundException{Message: aws.String("not found")}
+				},
+			}
+
+			creator := NewS3TablesCreator(mock)
+			creator.SetWaitBucketReady(time.Second)
+			creator.waitBackoff = BackoffConfig{BaseDelay: time.Millisecond, Strategy: BackoffConstant}
+
+			result, err := creator.Create(context.Background(), "new-bucket", "sales", "orders")
+			if tt.wantErr {
+				if GetErrorType(err) != ErrorTypeForbidden {
+					t.Fatalf("Create() error = %v, want forbidden error", err)
+				}
+				// The bucket was created before the wait failed
+				if result == nil || !result.TableBucketCreated || result.TableBucketARN == "" {
+					t.Errorf("Create() result = %+v, want the created table bucket recorded", result)
+				}
+			} else if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if polls != tt.wantPolls {
+				t.Errorf("GetTableBucket polls = %d, want %d", polls, tt.wantPolls)
+			}
+			if !tt.wantErr && pollsAtNamespace != tt.wantPolls {
+				t.Errorf("namespace step started after %d polls, want %d", pollsAtNamespace, tt.wantPolls)
+			}
+		})
+	}
+}
+
+// TestCreateTableBucketRegionMismatchWarns tests the region check on the ARN of a created bucket
+func TestCreateTableBucketRegionMismatchWarns(t *testing.T) {
+	tests := []struct {
+		name     string
+		arn      string
+		expected string
+		wantWarn string
+	}{
+		{name: "same region", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/new-bucket", expected: "us-east-1"},
+		{name: "different region", arn: "arn:aws:s3tables:eu-west-1:123456789012:bucket/new-bucket", expected: "us-east-1", wantWarn: "created in region 'eu-west-1' but the configured region is 'us-east-1'"},
+		{name: "malformed ARN", arn: "not-an-arn", expected: "us-east-1", wantWarn: "malformed ARN 'not-an-arn'"},
+		{name: "no configured region", arn: "arn:aws:s3tables:eu-west-1:123456789012:bucket/new-bucket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &FuncMockS3TablesAPI{
+				CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+					return &s3tables.CreateTableBucketOutput{Arn: aws.String(tt.arn)}, nil
+				},
+			}
+			var warnings bytes.Buffer
+			creator := NewS3TablesCreator(mock)
+			creator.SetExpectedRegion(tt.expected)
+			creator.SetWarningOutput(&warnings)
+
+			result, err := creator.Create(context.Background(), "new-bucket", "sales", "orders")
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if result.TableBucketARN != tt.arn {
+				t.Errorf("TableBucketARN = %q, want %q", result.TableBucketARN, tt.arn)
+			}
+			if tt.wantWarn == "" && warnings.Len() != 0 {
+				t.Errorf("unexpected warning %q", warnings.String())
+			}
+			if tt.wantWarn != "" && !strings.Contains(warnings.String(), tt.wantWarn) {
+				t.Errorf("warning = %q, want it to contain %q", warnings.String(), tt.wantWarn)
+			}
+		})
+	}
+}
+
+// TestCreateForceRecreate tests that an existing table is deleted before it is created again
+func TestCreateForceRecreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		exists     bool
+		confirm    bool
+		wantCalls  []string
+		wantMsg    string
+		wantPrompt bool
+	}{
+		{name: "existing table is deleted then created", exists: true, confirm: true, wantCalls: []string{"GetTable", "DeleteTable", "CreateTable"}, wantMsg: "Table 'orders' recreated", wantPrompt: true},
+		{name: "absent table is only created", exists: false, confirm: true, wantCalls: []string{"GetTable", "CreateTable"}, wantMsg: "Table 'orders' created"},
+		{name: "declined confirmation keeps the table", exists: true, confirm: false, wantCalls: []string{"GetTable"}, wantMsg: "Table 'orders' skipped (recreate declined)", wantPrompt: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			exists := tt.exists
+			mock := &FuncMockS3TablesAPI{
+				GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+					return &s3tables.GetNamespaceOutput{}, nil
+				},
+				GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+					calls = append(calls, "GetTable")
+					if !exists {
+						return nil, &types.NotFoundException{Message: aws.String("not found")}
+					}
+					return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/old")}, nil
+				},
+				DeleteTableFunc: func(ctx context.Context, params *s3tables.DeleteTableInput) (*s3tables.DeleteTableOutput, error) {
+					calls = append(calls, "DeleteTable")
+					exists = false
+					return &s3tables.DeleteTableOutput{}, nil
+				},
+				CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+					calls = append(calls, "CreateTable")
+					return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/new")}, nil
+				},
+			}
+
+			prompted := false
+			deleter := NewS3TablesDeleter(mock)
+			deleter.SetConfirmFunc(func(ctx context.Context, label string) (bool, error) {
+				prompted = true
+				return tt.confirm, nil
+			})
+			creator := NewS3TablesCreator(mock)
+			creator.SetForceRecreate(deleter)
+
+			result, err := creator.Create(context.Background(), "test-bucket", "sales", "orders")
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			if prompted != tt.wantPrompt {
+				t.Errorf("prompted = %v, want %v", prompted, tt.wantPrompt)
+			}
+			wantARN := "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/new"
+			if !tt.confirm {
+				wantARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/old"
+			}
+			if result.TableCreated != tt.confirm || result.TableARN != wantARN {
+				t.Errorf("TableCreated = %v, TableARN = %q, want %v, %q", result.TableCreated, result.TableARN, tt.confirm, wantARN)
+			}
+			if skipped := result.Tables[len(result.Tables)-1].Skipped; skipped == tt.confirm {
+				t.Errorf("Skipped = %v, want %v", skipped, !tt.confirm)
+			}
+			if last := result.Messages[len(result.Messages)-1]; last != tt.wantMsg {
+				t.Errorf("last message = %q, want %q", last, tt.wantMsg)
+			}
+		})
+	}
+}
+
+// TestCreateForceRecreateDeclinedContinues tests that declining one table's recreate still processes the others
+func TestCreateForceRecreateDeclinedContinues(t *testing.T) {
+	var deleted, created []string
+	mock := &FuncMockS3TablesAPI{
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			return &s3tables.GetNamespaceOutput{}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			name := aws.ToString(params.Name)
+			if slices.Contains(deleted, name) {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + name)}, nil
+		},
+		DeleteTableFunc: func(ctx context.Context, params *s3tables.DeleteTableInput) (*s3tables.DeleteTableOutput, error) {
+			deleted = append(deleted, aws.ToString(params.Name))
+			return &s3tables.DeleteTableOutput{}, nil
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			created = append(created, aws.ToString(params.Name))
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + aws.ToString(params.Name))}, nil
+		},
+	}
+	deleter := NewS3TablesDeleter(mock)
+	deleter.SetConfirmFunc(func(ctx context.Context, label string) (bool, error) {
+		return !strings.Contains(label, "orders"), nil
+	})
+	creator := NewS3TablesCreator(mock)
+	creator.SetForceRecreate(deleter)
+
+	result, err := creator.CreateTables(context.Background(), "test-bucket", "sales", []string{"orders", "customers"})
+	if err != nil {
+		t.Fatalf("CreateTables() error = %v", err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"customers"}) || !reflect.DeepEqual(created, []string{"customers"}) {
+		t.Errorf("deleted = %v, created = %v, want only customers", deleted, created)
+	}
+	want := []TableResult{
+		{Name: "orders", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/orders", Skipped: true},
+		{Name: "customers", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/customers", Created: true},
+	}
+	if !reflect.DeepEqual(result.Tables, want) {
+		t.Errorf("Tables = %+v, want %+v", result.Tables, want)
+	}
+}
+
+// TestCreateWithoutBucketCreation tests SetCreateBucketIfMissing(false) with a missing and an existing bucket
+func TestCreateWithoutBucketCreation(t *testing.T) {
+	tests := []struct {
+		name         string
+		bucketExists bool
+		wantErr      bool
+		wantCalls    []string
+	}{
+		{name: "missing bucket fails before namespace and table", bucketExists: false, wantErr: true},
+		{name: "existing bucket proceeds", bucketExists: true, wantCalls: []string{"CreateNamespace", "CreateTable"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			mock := &FuncMockS3TablesAPI{
+				ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+					if !tt.bucketExists {
+						return &s3tables.ListTableBucketsOutput{}, nil
+					}
+					return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+						{Name: aws.String("my-bucket"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")},
+					}}, nil
+				},
+				CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+					calls = append(calls, "CreateTableBucket")
+					return &s3tables.CreateTableBucketOutput{Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")}, nil
+				},
+				CreateNamespaceFunc: func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error) {
+					calls = append(calls, "CreateNamespace")
+					return &s3tables.CreateNamespaceOutput{}, nil
+				},
+				CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+					calls = append(calls, "CreateTable")
+					return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/t")}, nil
+				},
+			}
+			creator := NewS3TablesCreator(mock)
+			creator.SetCreateBucketIfMissing(false)
+
+			_, err := creator.Create(context.Background(), "my-bucket", "sales", "orders")
+			if tt.wantErr {
+				if GetErrorType(err) != ErrorTypeNotFound {
+					t.Errorf("Create() error = %v, want a NotFound error", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// TestCreateTableMetadata tests that the metadata set with SetTableMetadata is passed to CreateTable
+func TestCreateTableMetadata(t *testing.T) {
+	var got types.TableMetadata
+	mock := &FuncMockS3TablesAPI{
+		CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+			return &s3tables.CreateTableBucketOutput{Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")}, nil
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			got = params.Metadata
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket/table/t")}, nil
+		},
+	}
+	metadata, err := BuildIcebergMetadata([]SchemaColumn{{Name: "ts", Type: "timestamp"}}, []PartitionField{{Column: "ts", Transform: "day"}})
+	if err != nil {
+		t.Fatalf("BuildIcebergMetadata() error = %v", err)
+	}
+	creator := NewS3TablesCreator(mock)
+	creator.SetTableMetadata(metadata)
+
+	if _, err := creator.Create(context.Background(), "my-bucket", "sales", "events"); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if got != metadata {
+		t.Errorf("CreateTable Metadata = %v, want %v", got, metadata)
+	}
+}
+
+// TestCreateExpectTableType tests that an existing table is checked against the expected type
+// using the type found by the existence check, with either Get or List calls
+func TestCreateExpectTableType(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected types.TableType
+		check    ExistenceCheck
+		wantErr  bool
+	}{
+		{name: "no expectation", expected: ""},
+		{name: "matching type", expected: types.TableTypeCustomer},
+		{name: "mismatching type", expected: types.TableTypeAws, wantErr: true},
+		{name: "matching type with list", expected: types.TableTypeCustomer, check: ExistenceCheckList},
+		{name: "mismatching type with list", expected: types.TableTypeAws, check: ExistenceCheckList, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newSingleAccessMock(tt.check != ExistenceCheckList)
+			getTable := mock.GetTableFunc
+			getTableCalls := 0
+			mock.GetTableFunc = func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+				getTableCalls++
+				output, err := getTable(ctx, params)
+				if err == nil {
+					output.Type = types.TableTypeCustomer
+				}
+				return output, err
+			}
+			listTables := mock.ListTablesFunc
+			mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+				output, err := listTables(ctx, params)
+				if err == nil {
+					for i := range output.Tables {
+						output.Tables[i].Type = types.TableTypeCustomer
+					}
+				}
+				return output, err
+			}
+			mock.ListTableBucketsFunc = func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+				return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+					{Name: aws.String("test"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test")},
+				}}, nil
+			}
+			mock.CreateTableFunc = func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+				t.Fatal("CreateTable must not be called for an existing table")
+				return nil, nil
+			}
+
+			creator := NewS3TablesCreator(mock)
+			creator.SetExistenceCheck(tt.check)
+			creator.SetExpectTableType(tt.expected)
+			result, err := creator.Create(context.Background(), "test", "sales", "orders")
+			wantCalls := 1
+			if tt.check == ExistenceCheckList {
+				wantCalls = 0
+			}
+			if getTableCalls != wantCalls {
+				t.Errorf("GetTable calls = %d, want %d", getTableCalls, wantCalls)
+			}
+			if tt.wantErr {
+				if GetErrorType(err) != ErrorTypeConflict || !strings.Contains(err.Error(), "expected 'aws'") {
+					t.Fatalf("Create() error = %v, want type mismatch conflict", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if result.TableCreated {
+				t.Error("TableCreated = true, want the existing table to be kept")
+			}
+		})
+	}
+}
+
+// TestParseTableType tests parsing table type names
+func TestParseTableType(t *testing.T) {
+	for input, want := range map[string]types.TableType{"": "", "customer": types.TableTypeCustomer, "AWS": types.TableTypeAws} {
+		if got, err := ParseTableType(input); err != nil || got != want {
+			t.Errorf("ParseTableType(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseTableType("iceberg"); err == nil {
+		t.Error("ParseTableType(\"iceberg\") error = nil, want error")
+	}
+}
@@ -0,0 +1,238 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+)
+
+// namespacePreviewLimit is the number of tables listed before a namespace deletion is confirmed
+const namespacePreviewLimit = 5
+
+// ErrDeleteCancelled is returned when the user declines a delete confirmation
+var ErrDeleteCancelled = errors.New("delete cancelled")
+
+// S3TablesDeleter manages S3 Tables resource deletion
+type S3TablesDeleter struct {
+	client  S3TablesAPI
+	lister  *S3TablesLister
+	audit   *AuditLogger
+	confirm func(ctx context.Context, label string) (bool, error)
+	out     io.Writer
+	dryRun  bool // 存在確認のみ行い、削除 API は呼ばない
+}
+
+// DeletePlanItem is a resource that a delete would remove, or that is already absent
+type DeletePlanItem struct {
+	Kind   string // "namespace" または "table"
+	Name   string
+	Exists bool
+}
+
+// NewS3TablesDeleter creates a new S3TablesDeleter instance
+func NewS3TablesDeleter(client S3TablesAPI) *S3TablesDeleter {
+	return &S3TablesDeleter{
+		client:  client,
+		lister:  NewS3TablesLister(client),
+		confirm: Confirm,
+		out:     os.Stdout,
+	}
+}
+
+// SetAuditLogger sets the logger that records every mutating operation
+func (d *S3TablesDeleter) SetAuditLogger(audit *AuditLogger) {
+	d.audit = audit
+}
+
+// SetConfirmFunc replaces the confirmation prompt (useful for testing)
+func (d *S3TablesDeleter) SetConfirmFunc(confirm func(ctx context.Context, label string) (bool, error)) {
+	d.confirm = confirm
+}
+
+// SetOutput sets the writer that the deletion preview is printed to
+func (d *S3TablesDeleter) SetOutput(w io.Writer) {
+	d.out = w
+}
+
+// SetDryRun makes deletes print their plan instead of deleting anything.
+// Only existence checks are performed and no confirmation is requested.
+func (d *S3TablesDeleter) SetDryRun(dryRun bool) {
+	d.dryRun = dryRun
+}
+
+// DeleteNamespace deletes a namespace after showing how many tables it contains
+// and asking for confirmation. A namespace that still contains tables is refused
+// unless force is set, in which case its tables are deleted first.
+func (d *S3TablesDeleter) DeleteNamespace(ctx context.Context, tableBucketARN, namespace string, force bool) error {
+	if d.dryRun {
+		plan, err := d.PlanDeleteNamespace(ctx, tableBucketARN, namespace, force)
+		if err != nil {
+			return err
+		}
+		d.printPlan(plan)
+		return nil
+	}
+
+	tables, err := d.lister.ListTablesAll(ctx, tableBucketARN, namespace, "")
+	if err != nil {
+		return err
+	}
+
+	d.printNamespacePreview(namespace, tables)
+
+	if len(tables) > 0 && !force {
+		return namespaceNotEmptyError(namespace, len(tables))
+	}
+
+	label := fmt.Sprintf("Delete namespace '%s'", namespace)
+	if len(tables) > 0 {
+		label = fmt.Sprintf("Delete namespace '%s' and its %d table(s)", namespace, len(tables))
+	}
+	ok, err := d.confirm(ctx, label)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrDeleteCancelled
+	}
+
+	for _, tbl := range tables {
+		_, err := d.client.DeleteTable(ctx, &s3tables.DeleteTableInput{
+			TableBucketARN: aws.String(tableBucketARN),
+			Namespace:      aws.String(namespace),
+			Name:           aws.String(tbl.Name),
+		})
+		d.audit.logResult("DeleteTable", "table", namespace+"/"+tbl.Name, tbl.ARN, err)
+		if err != nil {
+			return WrapError("DeleteTable", err)
+		}
+	}
+
+	_, err = d.client.DeleteNamespace(ctx, &s3tables.DeleteNamespaceInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+	})
+	d.audit.logResult("DeleteNamespace", "namespace", namespace, tableBucketARN, err)
+	if err != nil {
+		return WrapError("DeleteNamespace", err)
+	}
+
+	return nil
+}
+
+// DeleteTable deletes a single table after asking for confirmation
+func (d *S3TablesDeleter) DeleteTable(ctx context.Context, tableBucketARN, namespace, table string) error {
+	if d.dryRun {
+		plan, err := d.PlanDeleteTable(ctx, tableBucketARN, namespace, table)
+		if err != nil {
+			return err
+		}
+		d.printPlan(plan)
+		return nil
+	}
+
+	ok, err := d.confirm(ctx, fmt.Sprintf("Delete table '%s/%s'", namespace, table))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrDeleteCancelled
+	}
+
+	_, err = d.client.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+		Name:           aws.String(table),
+	})
+	d.audit.logResult("DeleteTable", "table", namespace+"/"+table, tableBucketARN, err)
+	if err != nil {
+		return WrapError("DeleteTable", err)
+	}
+	return nil
+}
+
+// PlanDeleteNamespace reports what DeleteNamespace would remove, using only
+// existence checks. Like DeleteNamespace, a non-empty namespace is refused without force.
+func (d *S3TablesDeleter) PlanDeleteNamespace(ctx context.Context, tableBucketARN, namespace string, force bool) ([]DeletePlanItem, error) {
+	_, err := d.client.GetNamespace(ctx, &s3tables.GetNamespaceInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+	})
+	if isNotFoundError(err) {
+		return []DeletePlanItem{{Kind: "namespace", Name: namespace}}, nil
+	}
+	if err != nil {
+		return nil, WrapError("GetNamespace", err)
+	}
+
+	tables, err := d.lister.ListTablesAll(ctx, tableBucketARN, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) > 0 && !force {
+		return nil, namespaceNotEmptyError(namespace, len(tables))
+	}
+
+	plan := make([]DeletePlanItem, 0, len(tables)+1)
+	for _, tbl := range tables {
+		plan = append(plan, DeletePlanItem{Kind: "table", Name: namespace + "/" + tbl.Name, Exists: true})
+	}
+	return append(plan, DeletePlanItem{Kind: "namespace", Name: namespace, Exists: true}), nil
+}
+
+// PlanDeleteTable reports whether DeleteTable would remove the table
+func (d *S3TablesDeleter) PlanDeleteTable(ctx context.Context, tableBucketARN, namespace, table string) ([]DeletePlanItem, error) {
+	_, err := d.client.GetTable(ctx, &s3tables.GetTableInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+		Name:           aws.String(table),
+	})
+	if err != nil && !isNotFoundError(err) {
+		return nil, WrapError("GetTable", err)
+	}
+	return []DeletePlanItem{{Kind: "table", Name: namespace + "/" + table, Exists: err == nil}}, nil
+}
+
+// printPlan prints a dry-run plan, one resource per line
+func (d *S3TablesDeleter) printPlan(plan []DeletePlanItem) {
+	fmt.Fprintln(d.out, "Dry run: no resources will be deleted")
+	for _, item := range plan {
+		status := "would delete"
+		if !item.Exists {
+			status = "absent"
+		}
+		fmt.Fprintf(d.out, "  %-12s  %-9s  %s\n", status, item.Kind, item.Name)
+	}
+}
+
+// namespaceNotEmptyError is returned when a non-empty namespace is deleted without force
+func namespaceNotEmptyError(namespace string, tableCount int) error {
+	return &S3TablesError{
+		Operation:   "DeleteNamespace",
+		Message:     fmt.Sprintf("namespace '%s' contains %d table(s)", namespace, tableCount),
+		Suggestions: []string{msg(MsgSuggestDeleteTablesFirst)},
+		Type:        ErrorTypeConflict,
+	}
+}
+
+// printNamespacePreview prints the table count of a namespace and the first few table names
+func (d *S3TablesDeleter) printNamespacePreview(namespace string, tables []TableInfo) {
+	if len(tables) == 0 {
+		fmt.Fprintf(d.out, "Namespace '%s' is empty\n", namespace)
+		return
+	}
+
+	fmt.Fprintf(d.out, "Namespace '%s' contains %d table(s):\n", namespace, len(tables))
+	for i, tbl := range tables {
+		if i == namespacePreviewLimit {
+			fmt.Fprintf(d.out, "  ... and %d more\n", len(tables)-namespacePreviewLimit)
+			break
+		}
+		fmt.Fprintf(d.out, "  - %s\n", tbl.Name)
+	}
+}
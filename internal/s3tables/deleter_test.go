@@ -0,0 +1,227 @@
+package s3tables
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+const deleterTestBucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/test"
+
+// newDeleterMock returns a mock whose namespace holds tables and records delete calls
+func newDeleterMock(tables []string, calls *[]string) *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			var summaries []types.TableSummary
+			for _, name := range tables {
+				summaries = append(summaries, types.TableSummary{
+					Name:      aws.String(name),
+					Namespace: []string{aws.ToString(params.Namespace)},
+					TableARN:  aws.String(deleterTestBucketARN + "/table/" + name),
+				})
+			}
+			return &s3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+		DeleteTableFunc: func(ctx context.Context, params *s3tables.DeleteTableInput) (*s3tables.DeleteTableOutput, error) {
+			*calls = append(*calls, "DeleteTable:"+aws.ToString(params.Name))
+			return &s3tables.DeleteTableOutput{}, nil
+		},
+		DeleteNamespaceFunc: func(ctx context.Context, params *s3tables.DeleteNamespaceInput) (*s3tables.DeleteNamespaceOutput, error) {
+			*calls = append(*calls, "DeleteNamespace:"+aws.ToString(params.Namespace))
+			return &s3tables.DeleteNamespaceOutput{}, nil
+		},
+	}
+}
+
+// newTestDeleter creates a deleter that answers confirmations with answer
+func newTestDeleter(mock S3TablesAPI, answer bool, out *bytes.Buffer) *S3TablesDeleter {
+	deleter := NewS3TablesDeleter(mock)
+	deleter.SetOutput(out)
+	deleter.SetConfirmFunc(func(ctx context.Context, label string) (bool, error) {
+		return answer, nil
+	})
+	return deleter
+}
+
+func TestDeleteNamespace_EmptyProceeds(t *testing.T) {
+	var calls []string
+	var out bytes.Buffer
+	deleter := newTestDeleter(newDeleterMock(nil, &calls), true, &out)
+
+	if err := deleter.DeleteNamespace(context.Background(), deleterTestBucketARN, "sales", false); err != nil {
+		t.Fatalf("DeleteNamespace() error = %v", err)
+	}
+
+	if want := []string{"DeleteNamespace:sales"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+	if !strings.Contains(out.String(), "is empty") {
+		t.Errorf("output = %q, want empty namespace notice", out.String())
+	}
+}
+
+func TestDeleteNamespace_NonEmptyWithoutForceRefuses(t *testing.T) {
+	var calls []string
+	var out bytes.Buffer
+	deleter := newTestDeleter(newDeleterMock([]string{"a", "b", "c", "d", "e", "f", "g"}, &calls), true, &out)
+
+	err := deleter.DeleteNamespace(context.Background(), deleterTestBucketARN, "sales", false)
+
+	var s3tErr *S3TablesError
+	if !errors.As(err, &s3tErr) || s3tErr.Type != ErrorTypeConflict {
+		t.Fatalf("DeleteNamespace() error = %v, want conflict S3TablesError", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("calls = %v, want no delete calls", calls)
+	}
+	if !strings.Contains(out.String(), "contains 7 table(s)") || !strings.Contains(out.String(), "... and 2 more") {
+		t.Errorf("output = %q, want table count and truncated preview", out.String())
+	}
+}
+
+func TestDeleteNamespace_NonEmptyWithForceProceeds(t *testing.T) {
+	var calls []string
+	var out bytes.Buffer
+	deleter := newTestDeleter(newDeleterMock([]string{"orders", "customers"}, &calls), true, &out)
+
+	if err := deleter.DeleteNamespace(context.Background(), deleterTestBucketARN, "sales", true); err != nil {
+		t.Fatalf("DeleteNamespace() error = %v", err)
+	}
+
+	want := []string{"DeleteTable:orders", "DeleteTable:customers", "DeleteNamespace:sales"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestDeleteNamespace_DeclinedConfirmation(t *testing.T) {
+	var calls []string
+	var out bytes.Buffer
+	deleter := newTestDeleter(newDeleterMock(nil, &calls), false, &out)
+
+	err := deleter.DeleteNamespace(context.Background(), deleterTestBucketARN, "sales", false)
+	if !errors.Is(err, ErrDeleteCancelled) {
+		t.Fatalf("DeleteNamespace() error = %v, want ErrDeleteCancelled", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("calls = %v, want no delete calls", calls)
+	}
+}
+
+// newDryRunMock returns a deleter mock where only the given namespaces and tables exist
+func newDryRunMock(namespaces map[string][]string, calls *[]string) *FuncMockS3TablesAPI {
+	mock := newDeleterMock(nil, calls)
+	notFound := &types.NotFoundException{Message: aws.String("not found")}
+	mock.GetNamespaceFunc = func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+		if _, ok := namespaces[aws.ToString(params.Namespace)]; !ok {
+			return nil, notFound
+		}
+		return &s3tables.GetNamespaceOutput{}, nil
+	}
+	mock.GetTableFunc = func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+		for _, name := range namespaces[aws.ToString(params.Namespace)] {
+			if name == aws.ToString(params.Name) {
+				return &s3tables.GetTableOutput{Name: params.Name}, nil
+			}
+		}
+		return nil, notFound
+	}
+	mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+		var summaries []types.TableSummary
+		for _, name := range namespaces[aws.ToString(params.Namespace)] {
+			summaries = append(summaries, types.TableSummary{Name: aws.String(name), Namespace: []string{aws.ToString(params.Namespace)}})
+		}
+		return &s3tables.ListTablesOutput{Tables: summaries}, nil
+	}
+	return mock
+}
+
+func TestDeleteDryRun_Plans(t *testing.T) {
+	namespaces := map[string][]string{"sales": {"orders", "refunds"}}
+
+	tests := []struct {
+		name string
+		run  func(d *S3TablesDeleter) error
+		want []string
+	}{
+		{
+			name: "existing namespace with force",
+			run: func(d *S3TablesDeleter) error {
+				return d.DeleteNamespace(context.Background(), deleterTestBucketARN, "sales", true)
+			},
+			want: []string{"would delete  table      sales/orders", "would delete  table      sales/refunds", "would delete  namespace  sales"},
+		},
+		{
+			name: "absent namespace",
+			run: func(d *S3TablesDeleter) error {
+				return d.DeleteNamespace(context.Background(), deleterTestBucketARN, "marketing", false)
+			},
+			want: []string{"absent        namespace  marketing"},
+		},
+		{
+			name: "existing table",
+			run: func(d *S3TablesDeleter) error {
+				return d.DeleteTable(context.Background(), deleterTestBucketARN, "sales", "orders")
+			},
+			want: []string{"would delete  table      sales/orders"},
+		},
+		{
+			name: "absent table",
+			run: func(d *S3TablesDeleter) error {
+				return d.DeleteTable(context.Background(), deleterTestBucketARN, "sales", "returns")
+			},
+			want: []string{"absent        table      sales/returns"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			var out bytes.Buffer
+			deleter := newTestDeleter(newDryRunMock(namespaces, &calls), false, &out)
+			deleter.SetDryRun(true)
+
+			if err := tt.run(deleter); err != nil {
+				t.Fatalf("dry run error = %v", err)
+			}
+			for _, line := range tt.want {
+				if !strings.Contains(out.String(), line) {
+					t.Errorf("output = %q, want line %q", out.String(), line)
+				}
+			}
+			if len(calls) != 0 {
+				t.Errorf("calls = %v, want no delete calls", calls)
+			}
+		})
+	}
+}
+
+func TestPlanDeleteNamespace_NonEmptyWithoutForceRefuses(t *testing.T) {
+	var calls []string
+	deleter := NewS3TablesDeleter(newDryRunMock(map[string][]string{"sales": {"orders"}}, &calls))
+
+	_, err := deleter.PlanDeleteNamespace(context.Background(), deleterTestBucketARN, "sales", false)
+	if !IsConflictError(err) {
+		t.Errorf("PlanDeleteNamespace() error = %v, want conflict", err)
+	}
+}
+
+func TestDeleteTable_Confirmed(t *testing.T) {
+	var calls []string
+	var out bytes.Buffer
+	deleter := newTestDeleter(newDeleterMock(nil, &calls), true, &out)
+
+	if err := deleter.DeleteTable(context.Background(), deleterTestBucketARN, "sales", "orders"); err != nil {
+		t.Fatalf("DeleteTable() error = %v", err)
+	}
+	if want := []string{"DeleteTable:orders"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,73 @@
+package s3tables
+
+import "sort"
+
+// DiffSet splits names into those only in the second hierarchy (Added), only in
+// the first (Removed) and in both (Common)
+type DiffSet struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Common  []string `json:"common"`
+}
+
+// HierarchyDiff compares the namespaces and tables of two table buckets.
+// Tables are named namespace/table.
+type HierarchyDiff struct {
+	TableBucketA string  `json:"table_bucket_a"`
+	TableBucketB string  `json:"table_bucket_b"`
+	Namespaces   DiffSet `json:"namespaces"`
+	Tables       DiffSet `json:"tables"`
+}
+
+// Equal reports whether both hierarchies hold the same namespaces and tables
+func (d *HierarchyDiff) Equal() bool {
+	return len(d.Namespaces.Added) == 0 && len(d.Namespaces.Removed) == 0 &&
+		len(d.Tables.Added) == 0 && len(d.Tables.Removed) == 0
+}
+
+// DiffHierarchies compares hierarchy a with hierarchy b. Names present only in b
+// are reported as added and names present only in a as removed, all sorted.
+func DiffHierarchies(a, b HierarchySpec) HierarchyDiff {
+	namespacesA, tablesA := hierarchyNames(a)
+	namespacesB, tablesB := hierarchyNames(b)
+	return HierarchyDiff{
+		TableBucketA: a.TableBucket,
+		TableBucketB: b.TableBucket,
+		Namespaces:   diffNames(namespacesA, namespacesB),
+		Tables:       diffNames(tablesA, tablesB),
+	}
+}
+
+// hierarchyNames returns the namespace names and namespace/table names of spec
+func hierarchyNames(spec HierarchySpec) (namespaces, tables map[string]bool) {
+	namespaces = make(map[string]bool, len(spec.Namespaces))
+	tables = make(map[string]bool)
+	for _, ns := range spec.Namespaces {
+		namespaces[ns.Name] = true
+		for _, table := range ns.Tables {
+			tables[ns.Name+"/"+table] = true
+		}
+	}
+	return namespaces, tables
+}
+
+// diffNames splits the names of a and b into a sorted DiffSet
+func diffNames(a, b map[string]bool) DiffSet {
+	set := DiffSet{Added: []string{}, Removed: []string{}, Common: []string{}}
+	for name := range a {
+		if b[name] {
+			set.Common = append(set.Common, name)
+		} else {
+			set.Removed = append(set.Removed, name)
+		}
+	}
+	for name := range b {
+		if !a[name] {
+			set.Added = append(set.Added, name)
+		}
+	}
+	sort.Strings(set.Added)
+	sort.Strings(set.Removed)
+	sort.Strings(set.Common)
+	return set
+}
@@ -0,0 +1,49 @@
+package s3tables
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestDiffHierarchies tests the added, removed and common sets of two hierarchies
+func TestDiffHierarchies(t *testing.T) {
+	a := HierarchySpec{
+		TableBucket: "bucket-a",
+		Namespaces: []NamespaceSpec{
+			{Name: "sales", Tables: []string{"orders", "refunds"}},
+			{Name: "legacy", Tables: []string{"users"}},
+		},
+	}
+	b := HierarchySpec{
+		TableBucket: "bucket-b",
+		Namespaces: []NamespaceSpec{
+			{Name: "sales", Tables: []string{"orders", "returns"}},
+			{Name: "marketing", Tables: []string{}},
+		},
+	}
+
+	diff := DiffHierarchies(a, b)
+	if diff.TableBucketA != "bucket-a" || diff.TableBucketB != "bucket-b" {
+		t.Errorf("buckets = %q, %q, want bucket-a, bucket-b", diff.TableBucketA, diff.TableBucketB)
+	}
+	wantNamespaces := DiffSet{Added: []string{"marketing"}, Removed: []string{"legacy"}, Common: []string{"sales"}}
+	if !reflect.DeepEqual(diff.Namespaces, wantNamespaces) {
+		t.Errorf("Namespaces = %+v, want %+v", diff.Namespaces, wantNamespaces)
+	}
+	wantTables := DiffSet{
+		Added:   []string{"sales/returns"},
+		Removed: []string{"legacy/users", "sales/refunds"},
+		Common:  []string{"sales/orders"},
+	}
+	if !reflect.DeepEqual(diff.Tables, wantTables) {
+		t.Errorf("Tables = %+v, want %+v", diff.Tables, wantTables)
+	}
+	if diff.Equal() {
+		t.Error("Equal() = true, want false")
+	}
+
+	same := DiffHierarchies(a, a)
+	if !same.Equal() || len(same.Tables.Common) != 3 {
+		t.Errorf("DiffHierarchies(a, a) = %+v, want equal with 3 common tables", same)
+	}
+}
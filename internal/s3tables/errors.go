@@ -1,8 +1,12 @@
 package s3tables
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/aws/smithy-go"
@@ -26,6 +30,12 @@ const (
 	ErrorTypeInternalServer
 	// ErrorTypeCredentials represents missing or invalid AWS credentials
 	ErrorTypeCredentials
+	// ErrorTypeThrottling represents a request rate limit error (429)
+	ErrorTypeThrottling
+	// ErrorTypeTimeout represents a request that timed out
+	ErrorTypeTimeout
+	// ErrorTypeUnsupportedRegion represents S3 Tables being unreachable in the configured region
+	ErrorTypeUnsupportedRegion
 )
 
 // S3TablesError represents a user-friendly error from S3 Tables operations
@@ -33,22 +43,71 @@ type S3TablesError struct {
 	OriginalErr error
 	Operation   string
 	Message     string
-	Suggestion  string
+	Suggestions []string // 対処方法（Error では "; " 区切りの 1 行、Detail では複数ある場合に箇条書き）
 	Type        ErrorType
 }
 
+// Error renders the message on one line, followed by the suggestions joined
+// with "; "; Detail lists several suggestions one per line instead
 func (e *S3TablesError) Error() string {
-	if e.Suggestion != "" {
-		return fmt.Sprintf("Error: %s: %s - %s", e.Operation, e.Message, e.Suggestion)
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("Error: %s: %s", e.Operation, e.Message)
 	}
-	return fmt.Sprintf("Error: %s: %s", e.Operation, e.Message)
+	return fmt.Sprintf("Error: %s: %s - %s", e.Operation, e.Message, e.Suggestion())
+}
+
+// Detail renders the error for display. Several suggestions are listed one per
+// line below the message; otherwise it is the same as Error.
+func (e *S3TablesError) Detail() string {
+	if len(e.Suggestions) < 2 {
+		return e.Error()
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Error: %s: %s", e.Operation, e.Message)
+	for _, suggestion := range e.Suggestions {
+		fmt.Fprintf(&b, "\n  - %s", suggestion)
+	}
+	return b.String()
+}
+
+// Suggestion returns the suggestions joined into one line ("" when there are none)
+func (e *S3TablesError) Suggestion() string {
+	return strings.Join(e.Suggestions, "; ")
 }
 
 func (e *S3TablesError) Unwrap() error {
 	return e.OriginalErr
 }
 
-// WrapError converts an AWS API error to a user-friendly S3TablesError
+// ItemError records a per-item failure that was skipped while aggregating results,
+// e.g. a region or namespace whose sub-fetch failed
+type ItemError struct {
+	Item string
+	Err  error
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Item, e.Err)
+}
+
+// Retryable reports whether retrying the operation may succeed.
+// True for internal server, throttling, and timeout errors.
+func (e *S3TablesError) Retryable() bool {
+	switch e.Type {
+	case ErrorTypeInternalServer, ErrorTypeThrottling, ErrorTypeTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Temporary reports whether the error is likely transient
+func (e *S3TablesError) Temporary() bool {
+	return e.Retryable()
+}
+
+// WrapError converts an AWS API error to a user-friendly S3TablesError.
+// Messages and suggestions come from the catalog selected by SetLanguage.
 func WrapError(operation string, err error) error {
 	if err == nil {
 		return nil
@@ -69,40 +128,47 @@ func WrapError(operation string, err error) error {
 	switch {
 	case errors.As(err, &notFoundErr):
 		s3tErr.Type = ErrorTypeNotFound
-		s3tErr.Message = "resource not found"
-		s3tErr.Suggestion = "verify the resource name and try again"
+		s3tErr.Message = msg(MsgNotFound)
+		s3tErr.Suggestions = []string{msg(MsgSuggestVerifyName)}
 
 	case errors.As(err, &conflictErr):
 		s3tErr.Type = ErrorTypeConflict
-		s3tErr.Message = "resource already exists"
-		s3tErr.Suggestion = "use a different name or check existing resources"
+		s3tErr.Message = msg(MsgAlreadyExists)
+		s3tErr.Suggestions = []string{msg(MsgSuggestDifferentName)}
 
 	case errors.As(err, &forbiddenErr):
 		s3tErr.Type = ErrorTypeForbidden
-		s3tErr.Message = "access denied"
-		s3tErr.Suggestion = "check your AWS credentials and permissions"
+		s3tErr.Message = msg(MsgAccessDenied)
+		s3tErr.Suggestions = []string{msg(MsgSuggestCheckPermissions), msg(MsgSuggestCheckResourcePolicy)}
 
 	case errors.As(err, &badRequestErr):
 		s3tErr.Type = ErrorTypeBadRequest
-		s3tErr.Message = "invalid request"
-		s3tErr.Suggestion = "check your input parameters"
+		s3tErr.Message = msg(MsgInvalidRequest)
+		s3tErr.Suggestions = []string{msg(MsgSuggestCheckInput)}
 
 	case errors.As(err, &internalErr):
 		s3tErr.Type = ErrorTypeInternalServer
-		s3tErr.Message = "AWS service error"
-		s3tErr.Suggestion = "please retry the operation"
+		s3tErr.Message = msg(MsgServiceError)
+		s3tErr.Suggestions = []string{msg(MsgSuggestRetry)}
+
+	case errors.Is(err, context.DeadlineExceeded):
+		s3tErr.Type = ErrorTypeTimeout
+		s3tErr.Message = msg(MsgTimeout)
+		s3tErr.Suggestions = []string{msg(MsgSuggestRetry)}
 
 	default:
 		// Check for smithy API errors
 		var apiErr smithy.APIError
 		if errors.As(err, &apiErr) {
 			s3tErr = handleAPIError(operation, apiErr, err)
+		} else if region, ok := unsupportedRegion(err); ok {
+			s3tErr = NewUnsupportedRegionError(operation, region, err)
 		} else {
 			// Check for credential-related errors
 			if isCredentialError(err) {
 				s3tErr.Type = ErrorTypeCredentials
-				s3tErr.Message = "AWS credentials not configured"
-				s3tErr.Suggestion = "configure AWS credentials using 'aws configure' or environment variables"
+				s3tErr.Message = msg(MsgCredentialsMissing)
+				s3tErr.Suggestions = []string{msg(MsgSuggestConfigureCreds), msg(MsgSuggestCheckProfile)}
 			} else {
 				s3tErr.Type = ErrorTypeUnknown
 				s3tErr.Message = err.Error()
@@ -124,36 +190,46 @@ func handleAPIError(operation string, apiErr smithy.APIError, originalErr error)
 	switch code {
 	case "NotFoundException":
 		s3tErr.Type = ErrorTypeNotFound
-		s3tErr.Message = "resource not found"
-		s3tErr.Suggestion = "verify the resource name and try again"
+		s3tErr.Message = msg(MsgNotFound)
+		s3tErr.Suggestions = []string{msg(MsgSuggestVerifyName)}
 
 	case "ConflictException":
 		s3tErr.Type = ErrorTypeConflict
-		s3tErr.Message = "resource already exists"
-		s3tErr.Suggestion = "use a different name or check existing resources"
+		s3tErr.Message = msg(MsgAlreadyExists)
+		s3tErr.Suggestions = []string{msg(MsgSuggestDifferentName)}
 
 	case "ForbiddenException", "AccessDeniedException", "AccessDenied":
 		s3tErr.Type = ErrorTypeForbidden
-		s3tErr.Message = "access denied"
-		s3tErr.Suggestion = "check your AWS credentials and permissions"
+		s3tErr.Message = msg(MsgAccessDenied)
+		s3tErr.Suggestions = []string{msg(MsgSuggestCheckPermissions), msg(MsgSuggestCheckResourcePolicy)}
 
 	case "BadRequestException", "ValidationException":
 		s3tErr.Type = ErrorTypeBadRequest
-		s3tErr.Message = "invalid request"
-		if msg := apiErr.ErrorMessage(); msg != "" {
-			s3tErr.Message = msg
+		s3tErr.Message = msg(MsgInvalidRequest)
+		if apiMessage := apiErr.ErrorMessage(); apiMessage != "" {
+			s3tErr.Message = apiMessage
 		}
-		s3tErr.Suggestion = "check your input parameters"
+		s3tErr.Suggestions = []string{msg(MsgSuggestCheckInput)}
 
 	case "InternalServerErrorException", "InternalServerError", "ServiceException":
 		s3tErr.Type = ErrorTypeInternalServer
-		s3tErr.Message = "AWS service error"
-		s3tErr.Suggestion = "please retry the operation"
+		s3tErr.Message = msg(MsgServiceError)
+		s3tErr.Suggestions = []string{msg(MsgSuggestRetry)}
+
+	case "ThrottlingException", "TooManyRequestsException", "SlowDown", "RequestLimitExceeded":
+		s3tErr.Type = ErrorTypeThrottling
+		s3tErr.Message = msg(MsgThrottled)
+		s3tErr.Suggestions = []string{msg(MsgSuggestWaitRetry)}
+
+	case "RequestTimeout", "RequestTimeoutException":
+		s3tErr.Type = ErrorTypeTimeout
+		s3tErr.Message = msg(MsgTimeout)
+		s3tErr.Suggestions = []string{msg(MsgSuggestRetry)}
 
 	case "UnrecognizedClientException", "InvalidSignatureException":
 		s3tErr.Type = ErrorTypeCredentials
-		s3tErr.Message = "invalid AWS credentials"
-		s3tErr.Suggestion = "check your AWS credentials configuration"
+		s3tErr.Message = msg(MsgCredentialsInvalid)
+		s3tErr.Suggestions = []string{msg(MsgSuggestCheckCredsConfig), msg(MsgSuggestCheckProfile), msg(MsgSuggestCheckClock)}
 
 	default:
 		s3tErr.Type = ErrorTypeUnknown
@@ -166,6 +242,58 @@ func handleAPIError(operation string, apiErr smithy.APIError, originalErr error)
 	return s3tErr
 }
 
+// endpointHostPattern extracts the region from an S3 Tables endpoint host name,
+// e.g. s3tables.ap-east-1.amazonaws.com
+var endpointHostPattern = regexp.MustCompile(`s3tables[a-z.-]*?\.([a-z]{2}(?:-[a-z]+)+-\d+)\.(?:amazonaws\.com|api\.aws)`)
+
+// NewUnsupportedRegionError returns an error stating that S3 Tables may not be
+// available in region. An empty region is reported as the configured region.
+func NewUnsupportedRegionError(operation, region string, err error) *S3TablesError {
+	message := msg(MsgUnsupportedRegionUnknown)
+	if region != "" {
+		message = msg(MsgUnsupportedRegion, region)
+	}
+	return &S3TablesError{
+		Operation:   operation,
+		OriginalErr: err,
+		Message:     message,
+		Suggestions: []string{msg(MsgSuggestSupportedRegion)},
+		Type:        ErrorTypeUnsupportedRegion,
+	}
+}
+
+// unsupportedRegion reports whether err is an endpoint resolution failure and
+// returns the region named by the endpoint, if any.
+// DNS failures only count for the standard S3 Tables host names so that a
+// mistyped custom endpoint is not blamed on the region.
+func unsupportedRegion(err error) (string, bool) {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		if !dnsErr.IsNotFound {
+			return "", false
+		}
+		if m := endpointHostPattern.FindStringSubmatch(dnsErr.Name); m != nil {
+			return m[1], true
+		}
+		return "", false
+	}
+
+	errStr := err.Error()
+	if containsIgnoreCase(errStr, "no such host") {
+		if m := endpointHostPattern.FindStringSubmatch(errStr); m != nil {
+			return m[1], true
+		}
+		return "", false
+	}
+
+	// 解決ルールのエラー。リージョン未設定は設定漏れなので対象外
+	if (strings.Contains(errStr, "failed to resolve service endpoint") || strings.Contains(errStr, "endpoint rule error")) &&
+		!strings.Contains(errStr, "Missing Region") {
+		return "", true
+	}
+	return "", false
+}
+
 // isCredentialError checks if the error is related to AWS credentials
 func isCredentialError(err error) bool {
 	errStr := err.Error()
@@ -1,9 +1,16 @@
 package s3tables
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/aws/smithy-go"
 )
@@ -34,7 +41,7 @@ func TestWrapError_NotFoundException(t *testing.T) {
 	if s3tErr.Operation != "GetTableBucket" {
 		t.Errorf("expected operation 'GetTableBucket', got '%s'", s3tErr.Operation)
 	}
-	if s3tErr.Suggestion == "" {
+	if s3tErr.Suggestion() == "" {
 		t.Error("expected non-empty suggestion")
 	}
 }
@@ -68,7 +75,7 @@ func TestWrapError_ForbiddenException(t *testing.T) {
 	if s3tErr.Type != ErrorTypeForbidden {
 		t.Errorf("expected ErrorTypeForbidden, got %v", s3tErr.Type)
 	}
-	if s3tErr.Suggestion == "" {
+	if s3tErr.Suggestion() == "" {
 		t.Error("expected non-empty suggestion for credential check")
 	}
 }
@@ -99,7 +106,7 @@ func TestWrapError_InternalServerErrorException(t *testing.T) {
 	if s3tErr.Type != ErrorTypeInternalServer {
 		t.Errorf("expected ErrorTypeInternalServer, got %v", s3tErr.Type)
 	}
-	if s3tErr.Suggestion == "" {
+	if s3tErr.Suggestion() == "" {
 		t.Error("expected retry suggestion")
 	}
 }
@@ -167,6 +174,68 @@ func TestWrapError_CredentialError(t *testing.T) {
 	}
 }
 
+func TestWrapError_UnsupportedRegion(t *testing.T) {
+	dnsErr := &net.DNSError{Err: "no such host", Name: "s3tables.xx-nowhere-1.amazonaws.com", IsNotFound: true}
+	tests := []struct {
+		name        string
+		err         error
+		wantType    ErrorType
+		wantMessage string
+	}{
+		{
+			name:        "DNS failure for the regional endpoint",
+			err:         &smithy.OperationError{ServiceID: "S3Tables", OperationName: "ListTableBuckets", Err: &url.Error{Op: "Get", URL: "https://s3tables.xx-nowhere-1.amazonaws.com/buckets", Err: dnsErr}},
+			wantType:    ErrorTypeUnsupportedRegion,
+			wantMessage: "S3 Tables may not be available in region xx-nowhere-1",
+		},
+		{
+			name:        "untyped no such host message",
+			err:         errors.New("dial tcp: lookup s3tables.xx-nowhere-1.amazonaws.com on 127.0.0.53:53: no such host"),
+			wantType:    ErrorTypeUnsupportedRegion,
+			wantMessage: "S3 Tables may not be available in region xx-nowhere-1",
+		},
+		{
+			name:        "endpoint rule error",
+			err:         errors.New("failed to resolve service endpoint, endpoint rule error, FIPS is enabled but this partition does not support FIPS"),
+			wantType:    ErrorTypeUnsupportedRegion,
+			wantMessage: "S3 Tables may not be available in the configured region",
+		},
+		{
+			name:     "missing region is not a region availability problem",
+			err:      errors.New("failed to resolve service endpoint, endpoint rule error, Invalid Configuration: Missing Region"),
+			wantType: ErrorTypeUnknown,
+		},
+		{
+			name:     "DNS failure for a custom endpoint",
+			err:      &net.DNSError{Err: "no such host", Name: "localhost.invalid", IsNotFound: true},
+			wantType: ErrorTypeUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WrapError("ListTableBuckets", tt.err)
+
+			var s3tErr *S3TablesError
+			if !errors.As(err, &s3tErr) {
+				t.Fatalf("expected *S3TablesError, got %T", err)
+			}
+			if s3tErr.Type != tt.wantType {
+				t.Fatalf("Type = %v, want %v", s3tErr.Type, tt.wantType)
+			}
+			if tt.wantType != ErrorTypeUnsupportedRegion {
+				return
+			}
+			if s3tErr.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", s3tErr.Message, tt.wantMessage)
+			}
+			if !strings.Contains(err.Error(), "try a supported region") {
+				t.Errorf("Error() = %q, want a supported region suggestion", err.Error())
+			}
+		})
+	}
+}
+
 func TestWrapError_NilError(t *testing.T) {
 	err := WrapError("TestOperation", nil)
 	if err != nil {
@@ -200,9 +269,9 @@ func TestS3TablesError_Error(t *testing.T) {
 		{
 			name: "with suggestion",
 			err: &S3TablesError{
-				Operation:  "CreateTableBucket",
-				Message:    "access denied",
-				Suggestion: "check your credentials",
+				Operation:   "CreateTableBucket",
+				Message:     "access denied",
+				Suggestions: []string{"check your credentials"},
 			},
 			wantSubstr: "check your credentials",
 		},
@@ -226,6 +295,56 @@ func TestS3TablesError_Error(t *testing.T) {
 	}
 }
 
+// TestS3TablesError_ErrorSuggestions tests that Error stays on one line and
+// Detail renders several suggestions as a bulleted list
+func TestS3TablesError_ErrorSuggestions(t *testing.T) {
+	single := &S3TablesError{Operation: "GetTable", Message: "resource not found", Suggestions: []string{"verify the resource name and try again"}}
+	if got, want := single.Error(), "Error: GetTable: resource not found - verify the resource name and try again"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	multiple := &S3TablesError{Operation: "GetTable", Message: "access denied", Suggestions: []string{"check permissions", "check the resource policy"}}
+	if got, want := multiple.Error(), "Error: GetTable: access denied - check permissions; check the resource policy"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	want := "Error: GetTable: access denied\n  - check permissions\n  - check the resource policy"
+	if got := multiple.Detail(); got != want {
+		t.Errorf("Detail() = %q, want %q", got, want)
+	}
+	if got := single.Detail(); got != single.Error() {
+		t.Errorf("Detail() = %q, want Error() for a single suggestion", got)
+	}
+	if got := multiple.Suggestion(); got != "check permissions; check the resource policy" {
+		t.Errorf("Suggestion() = %q, want the suggestions joined", got)
+	}
+}
+
+// TestWrapError_MultipleSuggestions tests that forbidden and credential errors carry
+// more than one suggestion
+func TestWrapError_MultipleSuggestions(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{name: "forbidden exception", err: &types.ForbiddenException{Message: aws.String("denied")}, want: []string{msg(MsgSuggestCheckPermissions), msg(MsgSuggestCheckResourcePolicy)}},
+		{name: "access denied code", err: &mockAPIError{code: "AccessDeniedException"}, want: []string{msg(MsgSuggestCheckPermissions), msg(MsgSuggestCheckResourcePolicy)}},
+		{name: "invalid signature", err: &mockAPIError{code: "InvalidSignatureException"}, want: []string{msg(MsgSuggestCheckCredsConfig), msg(MsgSuggestCheckProfile), msg(MsgSuggestCheckClock)}},
+		{name: "missing credentials", err: errors.New("no credentials found"), want: []string{msg(MsgSuggestConfigureCreds), msg(MsgSuggestCheckProfile)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s3tErr *S3TablesError
+			if !errors.As(WrapError("GetTable", tt.err), &s3tErr) {
+				t.Fatalf("WrapError() = %T, want *S3TablesError", tt.err)
+			}
+			if !reflect.DeepEqual(s3tErr.Suggestions, tt.want) {
+				t.Errorf("Suggestions = %q, want %q", s3tErr.Suggestions, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsNotFoundError(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -395,3 +514,52 @@ func TestS3TablesError_Unwrap(t *testing.T) {
 func ptrString(s string) *string {
 	return &s
 }
+
+func TestS3TablesError_RetryablePredicates(t *testing.T) {
+	tests := []struct {
+		errType ErrorType
+		want    bool
+	}{
+		{ErrorTypeUnknown, false},
+		{ErrorTypeNotFound, false},
+		{ErrorTypeConflict, false},
+		{ErrorTypeForbidden, false},
+		{ErrorTypeBadRequest, false},
+		{ErrorTypeInternalServer, true},
+		{ErrorTypeCredentials, false},
+		{ErrorTypeThrottling, true},
+		{ErrorTypeTimeout, true},
+	}
+
+	for _, tt := range tests {
+		err := &S3TablesError{Type: tt.errType}
+		if got := err.Retryable(); got != tt.want {
+			t.Errorf("ErrorType(%d).Retryable() = %v, want %v", tt.errType, got, tt.want)
+		}
+		if got := err.Temporary(); got != tt.want {
+			t.Errorf("ErrorType(%d).Temporary() = %v, want %v", tt.errType, got, tt.want)
+		}
+	}
+}
+
+func TestWrapError_ThrottlingAndTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantType ErrorType
+	}{
+		{name: "throttling", err: &mockAPIError{code: "ThrottlingException", message: "rate exceeded"}, wantType: ErrorTypeThrottling},
+		{name: "too many requests", err: &mockAPIError{code: "TooManyRequestsException", message: "slow down"}, wantType: ErrorTypeThrottling},
+		{name: "request timeout", err: &mockAPIError{code: "RequestTimeout", message: "timeout"}, wantType: ErrorTypeTimeout},
+		{name: "deadline exceeded", err: fmt.Errorf("operation error: %w", context.DeadlineExceeded), wantType: ErrorTypeTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := WrapError("TestOp", tt.err)
+			if got := GetErrorType(wrapped); got != tt.wantType {
+				t.Errorf("GetErrorType() = %v, want %v", got, tt.wantType)
+			}
+		})
+	}
+}
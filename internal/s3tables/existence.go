@@ -0,0 +1,38 @@
+package s3tables
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExistenceCheck selects the API used to determine whether a namespace or table exists
+type ExistenceCheck int
+
+const (
+	// ExistenceCheckAuto calls Get and falls back to List when Get is forbidden
+	ExistenceCheckAuto ExistenceCheck = iota
+	// ExistenceCheckGet only calls GetNamespace/GetTable
+	ExistenceCheckGet
+	// ExistenceCheckList only calls ListNamespaces/ListTables
+	ExistenceCheckList
+)
+
+// existenceCheckNames maps the ExistenceCheck values to their flag names
+var existenceCheckNames = []string{"auto", "get", "list"}
+
+func (e ExistenceCheck) String() string {
+	if int(e) < 0 || int(e) >= len(existenceCheckNames) {
+		return "unknown"
+	}
+	return existenceCheckNames[e]
+}
+
+// ParseExistenceCheck parses an existence check mode name: auto, get or list
+func ParseExistenceCheck(s string) (ExistenceCheck, error) {
+	for i, name := range existenceCheckNames {
+		if strings.EqualFold(s, name) {
+			return ExistenceCheck(i), nil
+		}
+	}
+	return ExistenceCheckAuto, fmt.Errorf("invalid existence check '%s' (available: %s)", s, strings.Join(existenceCheckNames, ", "))
+}
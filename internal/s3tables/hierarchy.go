@@ -0,0 +1,123 @@
+package s3tables
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// HierarchySpec describes a table bucket together with its namespaces and tables
+type HierarchySpec struct {
+	TableBucket string          `json:"table_bucket" yaml:"table_bucket"`
+	Namespaces  []NamespaceSpec `json:"namespaces" yaml:"namespaces"`
+}
+
+// NamespaceSpec describes a namespace and the tables it contains
+type NamespaceSpec struct {
+	Name   string   `json:"name" yaml:"name"`
+	Tables []string `json:"tables" yaml:"tables"`
+}
+
+// ExportHierarchy walks a table bucket and returns its namespaces and tables,
+// sorted by name for stable output
+func (l *S3TablesLister) ExportHierarchy(ctx context.Context, tableBucketName, tableBucketARN string) (*HierarchySpec, error) {
+	namespaces, err := l.ListNamespacesAll(ctx, tableBucketARN, "")
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &HierarchySpec{TableBucket: tableBucketName}
+	for _, ns := range namespaces {
+		tables, err := l.ListTablesAll(ctx, tableBucketARN, ns.Name, "")
+		if err != nil {
+			return nil, err
+		}
+
+		nsSpec := NamespaceSpec{Name: ns.Name, Tables: make([]string, 0, len(tables))}
+		for _, tbl := range tables {
+			nsSpec.Tables = append(nsSpec.Tables, tbl.Name)
+		}
+		sort.Strings(nsSpec.Tables)
+		spec.Namespaces = append(spec.Namespaces, nsSpec)
+	}
+
+	sort.Slice(spec.Namespaces, func(i, j int) bool {
+		return spec.Namespaces[i].Name < spec.Namespaces[j].Name
+	})
+
+	return spec, nil
+}
+
+// RepairItem is a namespace or table of a spec together with its state before repair
+type RepairItem struct {
+	Kind    string // "namespace" または "table"
+	Name    string // namespace または namespace/table
+	Created bool   // 修復前に存在せず、作成したもの
+}
+
+// RepairReport lists what a spec expected below a table bucket and which gaps were filled
+type RepairReport struct {
+	TableBucket    string
+	TableBucketARN string
+	Items          []RepairItem
+}
+
+// Gaps returns the items that were missing and have been created
+func (r *RepairReport) Gaps() []RepairItem {
+	var gaps []RepairItem
+	for _, item := range r.Items {
+		if item.Created {
+			gaps = append(gaps, item)
+		}
+	}
+	return gaps
+}
+
+// RepairHierarchy creates the namespaces and tables of spec that are missing below
+// its table bucket and reports which were present and which were filled in.
+// Only children of an existing table bucket are repaired: a missing table bucket
+// is returned as a NotFound error instead of being created.
+func (c *S3TablesCreator) RepairHierarchy(ctx context.Context, spec HierarchySpec) (*RepairReport, error) {
+	exists, tableBucketARN, err := c.checkTableBucketExists(ctx, spec.TableBucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, &S3TablesError{
+			Operation:   "Repair",
+			Message:     fmt.Sprintf("table bucket '%s' does not exist", spec.TableBucket),
+			Suggestions: []string{msg(MsgSuggestRepairExistingOnly)},
+			Type:        ErrorTypeNotFound,
+		}
+	}
+
+	report := &RepairReport{TableBucket: spec.TableBucket, TableBucketARN: tableBucketARN}
+	// The namespaces are checked together and created first, before any table
+	names := make([]string, len(spec.Namespaces))
+	for i, ns := range spec.Namespaces {
+		names[i] = ns.Name
+	}
+	created, err := c.EnsureNamespaces(ctx, tableBucketARN, names)
+	if err != nil {
+		return nil, err
+	}
+	// A namespace listed twice is reported as created only the first time
+	newNamespaces := make(map[string]bool, len(created))
+	for _, name := range created {
+		newNamespaces[name] = true
+	}
+
+	for _, ns := range spec.Namespaces {
+		result := &CreateResult{}
+		report.Items = append(report.Items, RepairItem{Kind: "namespace", Name: ns.Name, Created: newNamespaces[ns.Name]})
+		delete(newNamespaces, ns.Name)
+
+		for _, table := range ns.Tables {
+			if err := c.ensureTable(ctx, tableBucketARN, ns.Name, table, result); err != nil {
+				return nil, err
+			}
+			report.Items = append(report.Items, RepairItem{Kind: "table", Name: ns.Name + "/" + table, Created: result.TableCreated})
+		}
+	}
+	return report, nil
+}
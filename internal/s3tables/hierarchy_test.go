@@ -0,0 +1,146 @@
+package s3tables
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// newHierarchyFuncMock returns a mock serving the given namespace → tables hierarchy
+func newHierarchyFuncMock(hierarchy map[string][]string) *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			var summaries []types.NamespaceSummary
+			for ns := range hierarchy {
+				summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			ns := aws.ToString(params.Namespace)
+			var summaries []types.TableSummary
+			for _, name := range hierarchy[ns] {
+				summaries = append(summaries, types.TableSummary{
+					Name:      aws.String(name),
+					Namespace: []string{ns},
+					TableARN:  aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/" + name),
+					Type:      types.TableTypeCustomer,
+				})
+			}
+			return &s3tables.ListTablesOutput{Tables: summaries}, nil
+		},
+	}
+}
+
+func TestExportHierarchy(t *testing.T) {
+	mock := newHierarchyFuncMock(map[string][]string{
+		"sales":     {"orders", "customers"},
+		"analytics": {"events"},
+		"empty_ns":  nil,
+	})
+	lister := NewS3TablesLister(mock)
+
+	spec, err := lister.ExportHierarchy(context.Background(), "my-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")
+	if err != nil {
+		t.Fatalf("ExportHierarchy() error = %v", err)
+	}
+
+	want := &HierarchySpec{
+		TableBucket: "my-bucket",
+		Namespaces: []NamespaceSpec{
+			{Name: "analytics", Tables: []string{"events"}},
+			{Name: "empty_ns", Tables: []string{}},
+			{Name: "sales", Tables: []string{"customers", "orders"}},
+		},
+	}
+	if !reflect.DeepEqual(spec, want) {
+		t.Errorf("ExportHierarchy() = %+v, want %+v", spec, want)
+	}
+}
+
+// TestRepairHierarchy tests that only the missing namespaces and tables below an existing bucket are created
+func TestRepairHierarchy(t *testing.T) {
+	present := map[string][]string{
+		"sales": {"orders"},
+	}
+	var created []string
+
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String("test-bucket"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket")},
+			}}, nil
+		},
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			if _, ok := present[aws.ToString(params.Namespace)]; !ok {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetNamespaceOutput{}, nil
+		},
+		CreateNamespaceFunc: func(ctx context.Context, params *s3tables.CreateNamespaceInput) (*s3tables.CreateNamespaceOutput, error) {
+			created = append(created, params.Namespace[0])
+			return &s3tables.CreateNamespaceOutput{}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			for _, name := range present[aws.ToString(params.Namespace)] {
+				if name == aws.ToString(params.Name) {
+					return &s3tables.GetTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/" + name)}, nil
+				}
+			}
+			return nil, &types.NotFoundException{Message: aws.String("not found")}
+		},
+		CreateTableFunc: func(ctx context.Context, params *s3tables.CreateTableInput) (*s3tables.CreateTableOutput, error) {
+			created = append(created, aws.ToString(params.Namespace)+"/"+aws.ToString(params.Name))
+			return &s3tables.CreateTableOutput{TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/" + aws.ToString(params.Name))}, nil
+		},
+	}
+
+	spec := HierarchySpec{
+		TableBucket: "test-bucket",
+		Namespaces: []NamespaceSpec{
+			{Name: "sales", Tables: []string{"orders", "customers"}},
+			{Name: "analytics", Tables: []string{"events"}},
+		},
+	}
+	report, err := NewS3TablesCreator(mock).RepairHierarchy(context.Background(), spec)
+	if err != nil {
+		t.Fatalf("RepairHierarchy() error = %v", err)
+	}
+
+	// Missing namespaces are created before any table
+	if want := []string{"analytics", "sales/customers", "analytics/events"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created = %v, want %v", created, want)
+	}
+	wantItems := []RepairItem{
+		{Kind: "namespace", Name: "sales"},
+		{Kind: "table", Name: "sales/orders"},
+		{Kind: "table", Name: "sales/customers", Created: true},
+		{Kind: "namespace", Name: "analytics", Created: true},
+		{Kind: "table", Name: "analytics/events", Created: true},
+	}
+	if !reflect.DeepEqual(report.Items, wantItems) {
+		t.Errorf("Items = %+v, want %+v", report.Items, wantItems)
+	}
+	if gaps := report.Gaps(); len(gaps) != 3 {
+		t.Errorf("Gaps() = %+v, want 3 items", gaps)
+	}
+}
+
+// TestRepairHierarchy_MissingBucket tests that a missing table bucket is reported, not created
+func TestRepairHierarchy_MissingBucket(t *testing.T) {
+	mock := &FuncMockS3TablesAPI{
+		CreateTableBucketFunc: func(ctx context.Context, params *s3tables.CreateTableBucketInput) (*s3tables.CreateTableBucketOutput, error) {
+			t.Fatal("CreateTableBucket must not be called during repair")
+			return nil, nil
+		},
+	}
+
+	_, err := NewS3TablesCreator(mock).RepairHierarchy(context.Background(), HierarchySpec{TableBucket: "missing-bucket"})
+	if GetErrorType(err) != ErrorTypeNotFound {
+		t.Errorf("RepairHierarchy() error = %v, want a NotFound error", err)
+	}
+}
@@ -3,162 +3,458 @@ package s3tables
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 )
 
-// TableBucketInfo represents a table bucket with its metadata
+// TableBucketInfo represents a table bucket with its metadata.
+// The info types marshal to JSON with snake_case keys and RFC3339 timestamps;
+// unknown (zero) timestamps are omitted.
 type TableBucketInfo struct {
-	Name      string
-	ARN       string
-	CreatedAt time.Time
+	Name      string    `json:"name"`
+	ARN       string    `json:"arn"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
 }
 
 // NamespaceInfo represents a namespace with its metadata
 type NamespaceInfo struct {
-	Name      string
-	CreatedAt time.Time
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
 }
 
 // TableInfo represents a table with its metadata
 type TableInfo struct {
-	Name      string
-	ARN       string
-	Namespace string
-	CreatedAt time.Time
-	Type      string
+	Name              string    `json:"name"`
+	ARN               string    `json:"arn"`
+	Namespace         string    `json:"namespace"`
+	CreatedAt         time.Time `json:"created_at,omitzero"`
+	ModifiedAt        time.Time `json:"modified_at,omitzero"`
+	Type              string    `json:"type"`
+	WarehouseLocation string    `json:"warehouse_location,omitempty"` // GetTableDetails でのみ取得
+}
+
+// DisplayLocation returns the warehouse location for display, or "(none)" when unknown
+func (t *TableInfo) DisplayLocation() string {
+	if t.WarehouseLocation == "" {
+		return "(none)"
+	}
+	return t.WarehouseLocation
+}
+
+// DisplayTimeLayout is the timestamp layout of human-readable details
+const DisplayTimeLayout = "2006-01-02 15:04:05"
+
+// UnknownTime is shown in place of a zero timestamp, e.g. a field the API left out
+const UnknownTime = "-"
+
+// FormatTime formats t with layout, or returns UnknownTime when t is the zero time
+func FormatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return UnknownTime
+	}
+	return t.Format(layout)
+}
+
+// humanizeAge returns how long ago t was in its largest whole unit, such as
+// "45s ago", "12m ago", "5h ago" or "3d ago", or UnknownTime when t is the zero time
+func humanizeAge(t time.Time) string {
+	if t.IsZero() {
+		return UnknownTime
+	}
+	age := max(clockOr(nil).Now().Sub(t), 0)
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf("%ds ago", int(age/time.Second))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age/time.Minute))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age/time.Hour))
+	default:
+		return fmt.Sprintf("%dd ago", int(age/(24*time.Hour)))
+	}
+}
+
+// CompactString returns the table details as a single key=value line suitable for logs.
+// showLocation appends the warehouse location.
+func (t *TableInfo) CompactString(showLocation bool) string {
+	line := fmt.Sprintf("name=%s namespace=%s arn=%s type=%s created=%s",
+		t.Name, t.Namespace, t.ARN, t.Type, FormatTime(t.CreatedAt, time.RFC3339))
+	if showLocation {
+		line += " location=" + t.DisplayLocation()
+	}
+	return line
+}
+
+// WriteDetails writes the table details shown by list and by the navigator, or the
+// CompactString line when compact is set. The ARN is truncated to arnMaxLen as with
+// DisplayARN; callers that redact pass a copy with the ARN already redacted.
+// showLocation adds the warehouse location.
+func (t *TableInfo) WriteDetails(w io.Writer, compact, showLocation bool, arnMaxLen int) {
+	if compact {
+		fmt.Fprintln(w, t.CompactString(showLocation))
+		return
+	}
+	fmt.Fprintf(w, "\nTable Details:\n\n")
+	fmt.Fprintf(w, "  Name:      %s\n", t.Name)
+	fmt.Fprintf(w, "  Namespace: %s\n", t.Namespace)
+	fmt.Fprintf(w, "  ARN:       %s\n", t.DisplayARN(arnMaxLen))
+	fmt.Fprintf(w, "  Type:      %s\n", t.Type)
+	fmt.Fprintf(w, "  Created:   %s\n", FormatTime(t.CreatedAt, DisplayTimeLayout))
+	if showLocation {
+		fmt.Fprintf(w, "  Location:  %s\n", t.DisplayLocation())
+	}
+	fmt.Fprintln(w)
 }
 
 // S3TablesLister manages S3 Tables resource listing
 type S3TablesLister struct {
-	client S3TablesAPI
+	client     S3TablesAPI
+	bucketType types.TableBucketType // Table Bucket 一覧の種別フィルタ（空は指定なし）
+	strictARN  bool                  // 不正な ARN を警告ではなくエラーにする
+	warnOut    io.Writer             // 警告の出力先
+	statsFunc  func(ListStats)       // 全件取得ごとのページ数・件数の通知先（nil は通知なし）
+	retry      BackoffConfig         // スロットリング等の一時的なエラーで一覧 API を再試行する設定
+}
+
+// ListStats reports how many pages and items one paginated listing fetched
+type ListStats struct {
+	Operation string // "ListTableBuckets"、"ListNamespaces" または "ListTables"
+	Pages     int
+	Items     int
 }
 
 // NewS3TablesLister creates a new S3TablesLister instance
 func NewS3TablesLister(client S3TablesAPI) *S3TablesLister {
-	return &S3TablesLister{client: client}
+	return &S3TablesLister{client: client, warnOut: os.Stderr, retry: DefaultBackoffConfig()}
 }
 
-// ListTableBucketsAll retrieves all table buckets with pagination
-func (l *S3TablesLister) ListTableBucketsAll(ctx context.Context, prefix string) ([]TableBucketInfo, error) {
-	var buckets []TableBucketInfo
-	var continuationToken *string
+// SetRetry sets how list calls failing with a throttling, timeout or internal
+// server error are retried. MaxAttempts of 1 disables retries.
+func (l *S3TablesLister) SetRetry(cfg BackoffConfig) {
+	l.retry = cfg
+}
 
-	for {
-		input := &s3tables.ListTableBucketsInput{
-			ContinuationToken: continuationToken,
+// SetStrictARN makes malformed table bucket ARNs returned by the API an error.
+// Without strict mode they are reported as warnings and listing continues.
+func (l *S3TablesLister) SetStrictARN(strict bool) {
+	l.strictARN = strict
+}
+
+// SetStatsFunc sets the function that receives the page and item counts of every
+// completed ListTableBucketsAll, ListNamespacesAll and ListTablesAll call.
+// It may be called concurrently when listings run in parallel (e.g. CountRecursive).
+func (l *S3TablesLister) SetStatsFunc(fn func(ListStats)) {
+	l.statsFunc = fn
+}
+
+// SetWarningOutput sets the writer that warnings are printed to
+func (l *S3TablesLister) SetWarningOutput(w io.Writer) {
+	l.warnOut = w
+}
+
+// checkBucketARN validates a table bucket ARN read from the API, warning or
+// failing depending on strict mode
+func (l *S3TablesLister) checkBucketARN(operation string, bucket TableBucketInfo) error {
+	err := ValidateTableBucketARN(bucket.ARN)
+	if err == nil {
+		return nil
+	}
+	if l.strictARN {
+		return &S3TablesError{
+			Operation:   operation,
+			Message:     fmt.Sprintf("table bucket '%s' has a malformed ARN '%s': %v", bucket.Name, bucket.ARN, err),
+			Suggestions: []string{msg(MsgSuggestLenientARN)},
+			Type:        ErrorTypeUnknown,
 		}
-		if prefix != "" {
-			input.Prefix = aws.String(prefix)
+	}
+	fmt.Fprintf(l.warnOut, "Warning: table bucket '%s' has a malformed ARN '%s': %v\n", bucket.Name, bucket.ARN, err)
+	return nil
+}
+
+// SetBucketType restricts table bucket listings to the given bucket type (empty lists every type)
+func (l *S3TablesLister) SetBucketType(bucketType types.TableBucketType) {
+	l.bucketType = bucketType
+}
+
+// ParseBucketType validates a table bucket type name against the types known to the SDK
+func ParseBucketType(s string) (types.TableBucketType, error) {
+	var known []string
+	for _, t := range types.TableBucketType("").Values() {
+		if string(t) == s {
+			return t, nil
 		}
+		known = append(known, string(t))
+	}
+	return "", fmt.Errorf("invalid bucket type '%s' (available: %s)", s, strings.Join(known, ", "))
+}
+
+// paginate collects the items of every page returned by fetch, starting with a nil
+// token and following the returned tokens until one is nil or empty. ctx is checked
+// before every page, and a token that repeats is reported instead of looping forever.
+func paginate[T any](ctx context.Context, fetch func(token *string) (items []T, next *string, err error)) ([]T, error) {
+	var all []T
+	var token *string
+	seen := make(map[string]bool)
 
-		output, err := l.client.ListTableBuckets(ctx, input)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		items, next, err := fetch(token)
 		if err != nil {
-			return nil, WrapError("ListTableBuckets", err)
+			return nil, err
 		}
+		all = append(all, items...)
 
-		for _, bucket := range output.TableBuckets {
-			buckets = append(buckets, TableBucketInfo{
-				Name:      aws.ToString(bucket.Name),
-				ARN:       aws.ToString(bucket.Arn),
-				CreatedAt: aws.ToTime(bucket.CreatedAt),
-			})
+		if aws.ToString(next) == "" {
+			return all, nil
+		}
+		if err := markToken(seen, *next); err != nil {
+			return nil, err
 		}
+		token = next
+	}
+}
 
-		if output.ContinuationToken == nil || *output.ContinuationToken == "" {
-			break
+// markToken records a continuation token in seen, or reports a token that was
+// already returned, since following it again would never end the listing
+func markToken(seen map[string]bool, token string) error {
+	if seen[token] {
+		return &S3TablesError{
+			Operation:   "paginate",
+			Message:     fmt.Sprintf("continuation token '%s' was returned twice", token),
+			Suggestions: []string{msg(MsgSuggestRetryListing)},
+			Type:        ErrorTypeUnknown,
 		}
-		continuationToken = output.ContinuationToken
 	}
+	seen[token] = true
+	return nil
+}
 
-	return buckets, nil
+// paginateCounted runs paginate while counting pages and items, and reports the
+// counts of a successful listing to the lister's stats function
+func paginateCounted[T any](ctx context.Context, l *S3TablesLister, operation string, fetch func(token *string) ([]T, *string, error)) ([]T, error) {
+	stats := ListStats{Operation: operation}
+	all, err := paginate(ctx, func(token *string) ([]T, *string, error) {
+		items, next, err := fetch(token)
+		if err == nil {
+			stats.Pages++
+			stats.Items += len(items)
+		}
+		return items, next, err
+	})
+	if err == nil && l.statsFunc != nil {
+		l.statsFunc(stats)
+	}
+	return all, err
 }
 
-// ListNamespacesAll retrieves all namespaces in a table bucket with pagination
-func (l *S3TablesLister) ListNamespacesAll(ctx context.Context, tableBucketARN, prefix string) ([]NamespaceInfo, error) {
-	var namespaces []NamespaceInfo
-	var continuationToken *string
+// pageFetcher adapts a Page method taking and returning string tokens to paginate
+func pageFetcher[T any](page func(token string) ([]T, string, error)) func(token *string) ([]T, *string, error) {
+	return func(token *string) ([]T, *string, error) {
+		items, next, err := page(aws.ToString(token))
+		return items, &next, err
+	}
+}
 
-	for {
-		input := &s3tables.ListNamespacesInput{
-			TableBucketARN:    aws.String(tableBucketARN),
-			ContinuationToken: continuationToken,
+// ListTableBucketsAll retrieves all table buckets with pagination
+func (l *S3TablesLister) ListTableBucketsAll(ctx context.Context, prefix string) ([]TableBucketInfo, error) {
+	return paginateCounted(ctx, l, "ListTableBuckets", pageFetcher(func(token string) ([]TableBucketInfo, string, error) {
+		return l.ListTableBucketsPage(ctx, prefix, token)
+	}))
+}
+
+// ListTableBucketsPage retrieves one page of table buckets starting at token ("" for the first page).
+// The returned token continues the listing and is "" after the last page.
+func (l *S3TablesLister) ListTableBucketsPage(ctx context.Context, prefix, token string) ([]TableBucketInfo, string, error) {
+	input := &s3tables.ListTableBucketsInput{
+		Type: l.bucketType,
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
+
+	var output *s3tables.ListTableBucketsOutput
+	err := Retry(ctx, l.retry, func() error {
+		var err error
+		output, err = l.client.ListTableBuckets(ctx, input)
+		return WrapError("ListTableBuckets", err)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	buckets := make([]TableBucketInfo, 0, len(output.TableBuckets))
+	for _, bucket := range output.TableBuckets {
+		info := TableBucketInfo{
+			Name:      aws.ToString(bucket.Name),
+			ARN:       aws.ToString(bucket.Arn),
+			CreatedAt: aws.ToTime(bucket.CreatedAt),
 		}
-		if prefix != "" {
-			input.Prefix = aws.String(prefix)
+		if err := l.checkBucketARN("ListTableBuckets", info); err != nil {
+			return nil, "", err
 		}
+		buckets = append(buckets, info)
+	}
+	return buckets, aws.ToString(output.ContinuationToken), nil
+}
 
-		output, err := l.client.ListNamespaces(ctx, input)
-		if err != nil {
-			return nil, WrapError("ListNamespaces", err)
-		}
+// ListNamespacesAll retrieves all namespaces in a table bucket with pagination
+func (l *S3TablesLister) ListNamespacesAll(ctx context.Context, tableBucketARN, prefix string) ([]NamespaceInfo, error) {
+	return paginateCounted(ctx, l, "ListNamespaces", pageFetcher(func(token string) ([]NamespaceInfo, string, error) {
+		return l.ListNamespacesPage(ctx, tableBucketARN, prefix, token)
+	}))
+}
 
-		for _, ns := range output.Namespaces {
-			var name string
-			if len(ns.Namespace) > 0 {
-				name = ns.Namespace[0]
-			}
-			namespaces = append(namespaces, NamespaceInfo{
-				Name:      name,
-				CreatedAt: aws.ToTime(ns.CreatedAt),
-			})
-		}
+// ListNamespacesPage retrieves one page of namespaces starting at token ("" for the first page).
+// The returned token continues the listing and is "" after the last page.
+func (l *S3TablesLister) ListNamespacesPage(ctx context.Context, tableBucketARN, prefix, token string) ([]NamespaceInfo, string, error) {
+	input := &s3tables.ListNamespacesInput{
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
 
-		if output.ContinuationToken == nil || *output.ContinuationToken == "" {
-			break
-		}
-		continuationToken = output.ContinuationToken
+	var output *s3tables.ListNamespacesOutput
+	err := Retry(ctx, l.retry, func() error {
+		var err error
+		output, err = l.client.ListNamespaces(ctx, input)
+		return WrapError("ListNamespaces", err)
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
-	return namespaces, nil
+	namespaces := make([]NamespaceInfo, 0, len(output.Namespaces))
+	for _, ns := range output.Namespaces {
+		var name string
+		if len(ns.Namespace) > 0 {
+			name = ns.Namespace[0]
+		}
+		namespaces = append(namespaces, NamespaceInfo{
+			Name:      name,
+			CreatedAt: aws.ToTime(ns.CreatedAt),
+		})
+	}
+	return namespaces, aws.ToString(output.ContinuationToken), nil
 }
 
 // ListTablesAll retrieves all tables in a namespace with pagination
 func (l *S3TablesLister) ListTablesAll(ctx context.Context, tableBucketARN, namespace, prefix string) ([]TableInfo, error) {
-	var tables []TableInfo
-	var continuationToken *string
+	return paginateCounted(ctx, l, "ListTables", pageFetcher(func(token string) ([]TableInfo, string, error) {
+		return l.ListTablesPage(ctx, tableBucketARN, namespace, prefix, token)
+	}))
+}
 
-	for {
-		input := &s3tables.ListTablesInput{
-			TableBucketARN:    aws.String(tableBucketARN),
-			Namespace:         aws.String(namespace),
-			ContinuationToken: continuationToken,
-		}
-		if prefix != "" {
-			input.Prefix = aws.String(prefix)
-		}
+// ListTablesPage retrieves one page of tables in a namespace starting at token ("" for the first page).
+// The returned token continues the listing and is "" after the last page.
+func (l *S3TablesLister) ListTablesPage(ctx context.Context, tableBucketARN, namespace, prefix, token string) ([]TableInfo, string, error) {
+	input := &s3tables.ListTablesInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
 
-		output, err := l.client.ListTables(ctx, input)
-		if err != nil {
-			return nil, WrapError("ListTables", err)
+	var output *s3tables.ListTablesOutput
+	err := Retry(ctx, l.retry, func() error {
+		var err error
+		output, err = l.client.ListTables(ctx, input)
+		return WrapError("ListTables", err)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	tables := make([]TableInfo, 0, len(output.Tables))
+	for _, tbl := range output.Tables {
+		var ns string
+		if len(tbl.Namespace) > 0 {
+			ns = tbl.Namespace[0]
 		}
+		tables = append(tables, TableInfo{
+			Name:       aws.ToString(tbl.Name),
+			ARN:        aws.ToString(tbl.TableARN),
+			Namespace:  ns,
+			CreatedAt:  aws.ToTime(tbl.CreatedAt),
+			ModifiedAt: aws.ToTime(tbl.ModifiedAt),
+			Type:       string(tbl.Type),
+		})
+	}
+	return tables, aws.ToString(output.ContinuationToken), nil
+}
+
+// ListAllTables retrieves every table in every namespace of a table bucket.
+// With continueOnError, namespaces whose tables cannot be listed are skipped and
+// reported as ItemErrors keyed by namespace; otherwise the first failure aborts.
+func (l *S3TablesLister) ListAllTables(ctx context.Context, tableBucketARN string, continueOnError bool) ([]TableInfo, []ItemError, error) {
+	namespaces, err := l.ListNamespacesAll(ctx, tableBucketARN, "")
+	if err != nil {
+		return nil, nil, err
+	}
 
-		for _, tbl := range output.Tables {
-			var ns string
-			if len(tbl.Namespace) > 0 {
-				ns = tbl.Namespace[0]
+	var tables []TableInfo
+	var skipped []ItemError
+	for _, ns := range namespaces {
+		nsTables, err := l.ListTablesAll(ctx, tableBucketARN, ns.Name, "")
+		if err != nil {
+			if !continueOnError || IsCredentialError(err) {
+				return nil, nil, err
 			}
-			tables = append(tables, TableInfo{
-				Name:      aws.ToString(tbl.Name),
-				ARN:       aws.ToString(tbl.TableARN),
-				Namespace: ns,
-				CreatedAt: aws.ToTime(tbl.CreatedAt),
-				Type:      string(tbl.Type),
-			})
+			skipped = append(skipped, ItemError{Item: ns.Name, Err: err})
+			continue
 		}
+		tables = append(tables, nsTables...)
+	}
 
-		if output.ContinuationToken == nil || *output.ContinuationToken == "" {
-			break
+	return tables, skipped, nil
+}
+
+// FindTableByARN returns the details of the table whose ARN is tableARN.
+// Table ARNs carry the table ID rather than its name, so the table is looked up
+// by ARN with a single GetTable call.
+func (l *S3TablesLister) FindTableByARN(ctx context.Context, tableARN string) (*TableInfo, error) {
+	output, err := l.client.GetTable(ctx, &s3tables.GetTableInput{TableArn: aws.String(tableARN)})
+	if isNotFoundError(err) {
+		return nil, &S3TablesError{
+			Operation:   "GetTable",
+			Message:     fmt.Sprintf("no table with ARN '%s'", tableARN),
+			Suggestions: []string{msg(MsgSuggestVerifyName)},
+			Type:        ErrorTypeNotFound,
+			OriginalErr: err,
 		}
-		continuationToken = output.ContinuationToken
+	}
+	if err != nil {
+		return nil, WrapError("GetTable", err)
 	}
 
-	return tables, nil
+	var namespace string
+	if len(output.Namespace) > 0 {
+		namespace = output.Namespace[0]
+	}
+	return tableInfoFromOutput(output, namespace), nil
 }
 
-// GetTableDetails retrieves detailed information about a specific table
+// GetTableDetails retrieves detailed information about a specific table.
+// When the table is not found, the namespace is probed so that the error
+// says whether the namespace or only the table is missing.
 func (l *S3TablesLister) GetTableDetails(ctx context.Context, tableBucketARN, namespace, table string) (*TableInfo, error) {
 	input := &s3tables.GetTableInput{
 		TableBucketARN: aws.String(tableBucketARN),
@@ -167,36 +463,201 @@ func (l *S3TablesLister) GetTableDetails(ctx context.Context, tableBucketARN, na
 	}
 
 	output, err := l.client.GetTable(ctx, input)
+	if isNotFoundError(err) {
+		return nil, l.tableNotFoundError(ctx, tableBucketARN, namespace, table, err)
+	}
 	if err != nil {
 		return nil, WrapError("GetTable", err)
 	}
 
+	return tableInfoFromOutput(output, namespace), nil
+}
+
+// tableInfoFromOutput converts a GetTable response of a table in namespace
+func tableInfoFromOutput(output *s3tables.GetTableOutput, namespace string) *TableInfo {
 	return &TableInfo{
-		Name:      aws.ToString(output.Name),
-		ARN:       aws.ToString(output.TableARN),
-		Namespace: namespace,
-		CreatedAt: aws.ToTime(output.CreatedAt),
-		Type:      string(output.Type),
-	}, nil
+		Name:              aws.ToString(output.Name),
+		ARN:               aws.ToString(output.TableARN),
+		Namespace:         namespace,
+		CreatedAt:         aws.ToTime(output.CreatedAt),
+		ModifiedAt:        aws.ToTime(output.ModifiedAt),
+		Type:              string(output.Type),
+		WarehouseLocation: aws.ToString(output.WarehouseLocation),
+	}
+}
+
+// ResolveARN returns the ARN that identifies path: the table ARN for a table,
+// and the table bucket ARN otherwise. Namespaces have no ARN of their own, so
+// for a namespace path the namespace is checked to exist and its bucket ARN is
+// returned. A path without BucketARN is resolved by exact bucket name, a given
+// BucketARN of a bucket path is checked to exist, and a table ARN is checked to
+// belong to the table bucket.
+func (l *S3TablesLister) ResolveARN(ctx context.Context, path ResourcePath) (string, error) {
+	bucketARN := path.BucketARN
+	if bucketARN == "" {
+		var err error
+		if bucketARN, err = l.GetTableBucketARN(ctx, path.Bucket); err != nil {
+			return "", err
+		}
+	} else if path.Namespace == "" {
+		// The namespace and table lookups below fail for a missing bucket on their own
+		_, err := l.client.GetTableBucket(ctx, &s3tables.GetTableBucketInput{
+			TableBucketARN: aws.String(bucketARN),
+		})
+		if isNotFoundError(err) {
+			return "", &S3TablesError{
+				OriginalErr: err,
+				Operation:   "GetTableBucket",
+				Message:     fmt.Sprintf("table bucket '%s' not found", bucketARN),
+				Suggestions: []string{msg(MsgSuggestVerifyName)},
+				Type:        ErrorTypeNotFound,
+			}
+		}
+		if err != nil {
+			return "", WrapError("GetTableBucket", err)
+		}
+	}
+
+	switch {
+	case path.Table != "":
+		details, err := l.GetTableDetails(ctx, bucketARN, path.Namespace, path.Table)
+		if err != nil {
+			return "", err
+		}
+		resource, err := ParseResourceARN(details.ARN)
+		if err != nil || resource.TableID == "" || resource.BucketARN() != bucketARN {
+			return "", &S3TablesError{
+				Operation: "GetTable",
+				Message:   fmt.Sprintf("table ARN '%s' does not belong to table bucket '%s'", details.ARN, bucketARN),
+				Type:      ErrorTypeUnknown,
+			}
+		}
+		return details.ARN, nil
+	case path.Namespace != "":
+		_, err := l.client.GetNamespace(ctx, &s3tables.GetNamespaceInput{
+			TableBucketARN: aws.String(bucketARN),
+			Namespace:      aws.String(path.Namespace),
+		})
+		if isNotFoundError(err) {
+			return "", &S3TablesError{
+				OriginalErr: err,
+				Operation:   "GetNamespace",
+				Message:     fmt.Sprintf("namespace '%s' not found", path.Namespace),
+				Suggestions: []string{msg(MsgSuggestVerifyName)},
+				Type:        ErrorTypeNotFound,
+			}
+		}
+		if err != nil {
+			return "", WrapError("GetNamespace", err)
+		}
+	}
+	return bucketARN, nil
+}
+
+// tableNotFoundError distinguishes a missing namespace from a missing table after
+// GetTable returned not-found. If the namespace probe itself fails, the plain
+// GetTable error is returned.
+func (l *S3TablesLister) tableNotFoundError(ctx context.Context, tableBucketARN, namespace, table string, getErr error) error {
+	_, err := l.client.GetNamespace(ctx, &s3tables.GetNamespaceInput{
+		TableBucketARN: aws.String(tableBucketARN),
+		Namespace:      aws.String(namespace),
+	})
+	message := fmt.Sprintf("table '%s' not found in namespace '%s'", table, namespace)
+	switch {
+	case isNotFoundError(err):
+		message = fmt.Sprintf("namespace '%s' not found", namespace)
+	case err != nil:
+		return WrapError("GetTable", getErr)
+	}
+	return &S3TablesError{
+		OriginalErr: getErr,
+		Operation:   "GetTable",
+		Message:     message,
+		Suggestions: []string{msg(MsgSuggestVerifyName)},
+		Type:        ErrorTypeNotFound,
+	}
 }
 
-// GetTableBucketARN retrieves the ARN for a table bucket by name
+// GetTableBucketARN retrieves the ARN for a table bucket by exact name
 func (l *S3TablesLister) GetTableBucketARN(ctx context.Context, tableBucketName string) (string, error) {
-	buckets, err := l.ListTableBucketsAll(ctx, tableBucketName)
+	arn, _, err := l.ResolveTableBucket(ctx, tableBucketName)
+	return arn, err
+}
+
+// FindBucketByExactName looks up the table bucket named exactly name. The API's
+// prefix filter only narrows the listing (a bucket "sales" also matches
+// "sales-archive", possibly on a later page), so every page of prefix results is
+// read and compared by exact name. It returns nil when no bucket has that name,
+// together with the other buckets whose names start with name. An empty name
+// matches nothing rather than listing every bucket.
+func (l *S3TablesLister) FindBucketByExactName(ctx context.Context, name string) (*TableBucketInfo, []TableBucketInfo, error) {
+	if name == "" {
+		return nil, nil, nil
+	}
+	buckets, err := l.ListTableBucketsAll(ctx, name)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
-	for _, bucket := range buckets {
-		if bucket.Name == tableBucketName {
-			return bucket.ARN, nil
+	var match *TableBucketInfo
+	var others []TableBucketInfo
+	for i, bucket := range buckets {
+		switch {
+		case bucket.Name == name:
+			if match == nil {
+				match = &buckets[i]
+			}
+		case strings.HasPrefix(bucket.Name, name):
+			others = append(others, bucket)
 		}
 	}
+	return match, others, nil
+}
+
+// ResolveTableBucket looks up a table bucket by exact name. When there is no exact
+// match, the buckets whose names start with tableBucketName are returned as
+// candidates together with the not-found error, so that callers can offer a choice.
+func (l *S3TablesLister) ResolveTableBucket(ctx context.Context, tableBucketName string) (string, []TableBucketInfo, error) {
+	match, candidates, err := l.FindBucketByExactName(ctx, tableBucketName)
+	if err != nil {
+		return "", nil, err
+	}
+	if match != nil {
+		return match.ARN, nil, nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, bucket := range candidates {
+		names = append(names, bucket.Name)
+	}
+
+	suggestion := msg(MsgSuggestVerifyBucketName)
+	if len(names) > 0 {
+		suggestion = didYouMean(names)
+	}
+
+	return "", candidates, &S3TablesError{
+		Operation:   "GetTableBucketARN",
+		Message:     fmt.Sprintf("table bucket '%s' not found", tableBucketName),
+		Suggestions: []string{suggestion},
+		Type:        ErrorTypeNotFound,
+	}
+}
+
+// maxSuggestions limits the number of names listed in a "did you mean" hint
+const maxSuggestions = 5
 
-	return "", &S3TablesError{
-		Operation:  "GetTableBucketARN",
-		Message:    fmt.Sprintf("table bucket '%s' not found", tableBucketName),
-		Suggestion: "verify the table bucket name and try again",
-		Type:       ErrorTypeNotFound,
+// didYouMean formats close name matches as a suggestion
+func didYouMean(candidates []string) string {
+	quoted := make([]string, 0, maxSuggestions)
+	for i, name := range candidates {
+		if i == maxSuggestions {
+			break
+		}
+		quoted = append(quoted, fmt.Sprintf("'%s'", name))
+	}
+	if len(candidates) > maxSuggestions {
+		return msg(MsgSuggestDidYouMeanMore, strings.Join(quoted, ", "), len(candidates)-maxSuggestions)
 	}
+	return msg(MsgSuggestDidYouMean, strings.Join(quoted, ", "))
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,7 @@ type PaginatedMockS3TablesAPI struct {
 	OnListTableBuckets func()
 	OnListNamespaces   func()
 	OnListTables       func()
+	OnGetTable         func()
 
 	// Error simulation
 	ListTableBucketsError error
@@ -39,7 +41,7 @@ type PaginatedMockS3TablesAPI struct {
 	ListTablesError       error
 	GetTableError         error
 
-	// GetTable response
+	// GetTable response; when nil, the matching entry of Tables is returned
 	GetTableResponse *s3tables.GetTableOutput
 }
 
@@ -91,12 +93,27 @@ func (m *PaginatedMockS3TablesAPI) CreateNamespace(ctx context.Context, params *
 }
 
 func (m *PaginatedMockS3TablesAPI) GetTable(ctx context.Context, params *s3tables.GetTableInput, optFns ...func(*s3tables.Options)) (*s3tables.GetTableOutput, error) {
+	if m.OnGetTable != nil {
+		m.OnGetTable()
+	}
 	if m.GetTableError != nil {
 		return nil, m.GetTableError
 	}
 	if m.GetTableResponse != nil {
 		return m.GetTableResponse, nil
 	}
+	for _, tbl := range m.Tables {
+		if params.TableArn != nil && aws.ToString(tbl.TableARN) == aws.ToString(params.TableArn) ||
+			params.TableArn == nil && aws.ToString(tbl.Name) == aws.ToString(params.Name) {
+			return &s3tables.GetTableOutput{
+				Name:      tbl.Name,
+				TableARN:  tbl.TableARN,
+				Namespace: tbl.Namespace,
+				CreatedAt: tbl.CreatedAt,
+				Type:      tbl.Type,
+			}, nil
+		}
+	}
 	return nil, &types.NotFoundException{Message: aws.String("not found")}
 }
 
@@ -113,24 +130,34 @@ func (m *PaginatedMockS3TablesAPI) ListNamespaces(ctx context.Context, params *s
 		return nil, m.ListNamespacesError
 	}
 
+	namespaces := m.Namespaces
+	if prefix := aws.ToString(params.Prefix); prefix != "" {
+		namespaces = nil
+		for _, ns := range m.Namespaces {
+			if len(ns.Namespace) > 0 && strings.HasPrefix(ns.Namespace[0], prefix) {
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+
 	startIndex := 0
 	if params.ContinuationToken != nil && *params.ContinuationToken != "" {
 		_, _ = fmt.Sscanf(*params.ContinuationToken, "%d", &startIndex)
 	}
 
 	endIndex := startIndex + m.PageSize
-	if endIndex > len(m.Namespaces) {
-		endIndex = len(m.Namespaces)
+	if endIndex > len(namespaces) {
+		endIndex = len(namespaces)
 	}
 
 	var nextToken *string
-	if endIndex < len(m.Namespaces) {
+	if endIndex < len(namespaces) {
 		token := fmt.Sprintf("%d", endIndex)
 		nextToken = &token
 	}
 
 	return &s3tables.ListNamespacesOutput{
-		Namespaces:        m.Namespaces[startIndex:endIndex],
+		Namespaces:        namespaces[startIndex:endIndex],
 		ContinuationToken: nextToken,
 	}, nil
 }
@@ -144,28 +171,46 @@ func (m *PaginatedMockS3TablesAPI) ListTables(ctx context.Context, params *s3tab
 		return nil, m.ListTablesError
 	}
 
+	tables := m.Tables
+	if prefix := aws.ToString(params.Prefix); prefix != "" {
+		tables = nil
+		for _, tbl := range m.Tables {
+			if strings.HasPrefix(aws.ToString(tbl.Name), prefix) {
+				tables = append(tables, tbl)
+			}
+		}
+	}
+
 	startIndex := 0
 	if params.ContinuationToken != nil && *params.ContinuationToken != "" {
 		_, _ = fmt.Sscanf(*params.ContinuationToken, "%d", &startIndex)
 	}
 
 	endIndex := startIndex + m.PageSize
-	if endIndex > len(m.Tables) {
-		endIndex = len(m.Tables)
+	if endIndex > len(tables) {
+		endIndex = len(tables)
 	}
 
 	var nextToken *string
-	if endIndex < len(m.Tables) {
+	if endIndex < len(tables) {
 		token := fmt.Sprintf("%d", endIndex)
 		nextToken = &token
 	}
 
 	return &s3tables.ListTablesOutput{
-		Tables:            m.Tables[startIndex:endIndex],
+		Tables:            tables[startIndex:endIndex],
 		ContinuationToken: nextToken,
 	}, nil
 }
 
+func (m *PaginatedMockS3TablesAPI) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return &s3tables.DeleteNamespaceOutput{}, nil
+}
+
+func (m *PaginatedMockS3TablesAPI) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return &s3tables.DeleteTableOutput{}, nil
+}
+
 // TestListTableBucketsAllError tests ListTableBucketsAll error handling
 func TestListTableBucketsAllError(t *testing.T) {
 	mock := &PaginatedMockS3TablesAPI{
@@ -213,10 +258,11 @@ func TestGetTableDetailsSuccess(t *testing.T) {
 	now := time.Now()
 	mock := &PaginatedMockS3TablesAPI{
 		GetTableResponse: &s3tables.GetTableOutput{
-			Name:      aws.String("test-table"),
-			TableARN:  aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/test-table"),
-			CreatedAt: aws.Time(now),
-			Type:      types.TableTypeCustomer,
+			Name:              aws.String("test-table"),
+			TableARN:          aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/test-table"),
+			CreatedAt:         aws.Time(now),
+			Type:              types.TableTypeCustomer,
+			WarehouseLocation: aws.String("s3://abc123--table-s3"),
 		},
 		PageSize: 10,
 	}
@@ -229,6 +275,9 @@ func TestGetTableDetailsSuccess(t *testing.T) {
 	if result.Name != "test-table" {
 		t.Errorf("GetTableDetails() Name = %v, want test-table", result.Name)
 	}
+	if result.WarehouseLocation != "s3://abc123--table-s3" {
+		t.Errorf("GetTableDetails() WarehouseLocation = %v, want s3://abc123--table-s3", result.WarehouseLocation)
+	}
 }
 
 // TestGetTableDetailsError tests GetTableDetails error handling
@@ -0,0 +1,790 @@
+package s3tables
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestTableInfoCompactString tests the single-line table details format
+func TestTableInfoCompactString(t *testing.T) {
+	tbl := &TableInfo{
+		Name:      "orders",
+		Namespace: "sales",
+		ARN:       "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/abc123",
+		Type:      "customer",
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	want := "name=orders namespace=sales arn=arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/abc123 type=customer created=2024-01-02T03:04:05Z"
+	if got := tbl.CompactString(false); got != want {
+		t.Errorf("CompactString(false) = %q, want %q", got, want)
+	}
+
+	tbl.WarehouseLocation = "s3://warehouse/orders"
+	if got := tbl.CompactString(true); got != want+" location=s3://warehouse/orders" {
+		t.Errorf("CompactString(true) = %q, want the location appended", got)
+	}
+}
+
+// TestTableInfoWriteDetails tests the multi-line table details shared by list and the navigator
+func TestTableInfoWriteDetails(t *testing.T) {
+	tbl := &TableInfo{
+		Name:              "orders",
+		Namespace:         "sales",
+		ARN:               "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket/table/abc123",
+		Type:              "customer",
+		CreatedAt:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		WarehouseLocation: "s3://warehouse/orders",
+	}
+
+	var buf bytes.Buffer
+	tbl.WriteDetails(&buf, false, true, 0)
+	want := "\nTable Details:\n\n" +
+		"  Name:      orders\n" +
+		"  Namespace: sales\n" +
+		"  ARN:       " + tbl.ARN + "\n" +
+		"  Type:      customer\n" +
+		"  Created:   " + FormatTime(tbl.CreatedAt, DisplayTimeLayout) + "\n" +
+		"  Location:  s3://warehouse/orders\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteDetails() = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	tbl.WriteDetails(&buf, false, false, 30)
+	if got := buf.String(); !strings.Contains(got, "  ARN:       "+tbl.DisplayARN(30)+"\n") || strings.Contains(got, "Location:") {
+		t.Errorf("WriteDetails() = %q, want the truncated ARN and no location", got)
+	}
+
+	buf.Reset()
+	tbl.WriteDetails(&buf, true, false, 30)
+	if got, want := buf.String(), tbl.CompactString(false)+"\n"; got != want {
+		t.Errorf("WriteDetails(compact) = %q, want %q", got, want)
+	}
+}
+
+// TestGetTableBucketARNNotFoundSuggestions tests that close prefix matches are suggested
+func TestGetTableBucketARNNotFoundSuggestions(t *testing.T) {
+	mock := &PaginatedMockS3TablesAPI{
+		TableBuckets: []types.TableBucketSummary{
+			{Name: aws.String("analytics-dev"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/analytics-dev")},
+			{Name: aws.String("analytics-prod"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/analytics-prod")},
+			{Name: aws.String("unrelated"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/unrelated")},
+		},
+		PageSize: 10,
+	}
+	lister := NewS3TablesLister(mock)
+
+	_, err := lister.GetTableBucketARN(context.Background(), "analytics")
+	if !IsNotFoundError(err) {
+		t.Fatalf("GetTableBucketARN() error = %v, want not found", err)
+	}
+
+	msg := err.Error()
+	want := "did you mean 'analytics-dev', 'analytics-prod'?"
+	if !strings.Contains(msg, want) {
+		t.Errorf("GetTableBucketARN() error = %q, want to contain %q", msg, want)
+	}
+	if strings.Contains(msg, "unrelated") {
+		t.Errorf("GetTableBucketARN() error = %q, should not suggest non-prefix matches", msg)
+	}
+}
+
+// TestListTableBucketsAllPrefix tests that an empty prefix is sent as nil (list everything)
+// and a non-empty prefix is passed through on every page
+func TestListTableBucketsAllPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		want   *string
+	}{
+		{name: "empty prefix", prefix: "", want: nil},
+		{name: "prefix", prefix: "sales", want: aws.String("sales")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prefixes []*string
+			mock := &FuncMockS3TablesAPI{
+				ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+					prefixes = append(prefixes, params.Prefix)
+					if params.ContinuationToken == nil {
+						return &s3tables.ListTableBucketsOutput{ContinuationToken: aws.String("page-2")}, nil
+					}
+					return &s3tables.ListTableBucketsOutput{}, nil
+				},
+			}
+			if _, err := NewS3TablesLister(mock).ListTableBucketsAll(context.Background(), tt.prefix); err != nil {
+				t.Fatalf("ListTableBucketsAll() error = %v", err)
+			}
+			if len(prefixes) != 2 {
+				t.Fatalf("ListTableBuckets called %d times, want 2", len(prefixes))
+			}
+			for _, got := range prefixes {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Prefix = %v, want %v", aws.ToString(got), aws.ToString(tt.want))
+				}
+			}
+		})
+	}
+}
+
+// TestFindBucketByExactNameAcrossPages tests that the exact match is found on a later
+// page behind buckets that only share its prefix
+func TestFindBucketByExactNameAcrossPages(t *testing.T) {
+	mock := &PaginatedMockS3TablesAPI{
+		TableBuckets: []types.TableBucketSummary{
+			{Name: aws.String("sales-archive"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales-archive")},
+			{Name: aws.String("sales-dev"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales-dev")},
+			{Name: aws.String("sales"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales")},
+		},
+		PageSize: 2,
+	}
+	lister := NewS3TablesLister(mock)
+
+	match, others, err := lister.FindBucketByExactName(context.Background(), "sales")
+	if err != nil {
+		t.Fatalf("FindBucketByExactName() error = %v", err)
+	}
+	if match == nil || match.Name != "sales" {
+		t.Fatalf("FindBucketByExactName() match = %+v, want sales", match)
+	}
+	if len(others) != 2 {
+		t.Errorf("FindBucketByExactName() others = %+v, want the 2 prefix matches", others)
+	}
+
+	arn, err := lister.GetTableBucketARN(context.Background(), "sales")
+	if err != nil || arn != "arn:aws:s3tables:us-east-1:123456789012:bucket/sales" {
+		t.Errorf("GetTableBucketARN() = %q, %v, want the exact match", arn, err)
+	}
+
+	match, _, err = lister.FindBucketByExactName(context.Background(), "")
+	if match != nil || err != nil {
+		t.Errorf("FindBucketByExactName(\"\") = %+v, %v, want no match", match, err)
+	}
+}
+
+// TestDidYouMeanLimitsSuggestions tests that long candidate lists are truncated
+func TestDidYouMeanLimitsSuggestions(t *testing.T) {
+	got := didYouMean([]string{"a1", "a2", "a3", "a4", "a5", "a6", "a7"})
+	want := "did you mean 'a1', 'a2', 'a3', 'a4', 'a5' (and 2 more)?"
+	if got != want {
+		t.Errorf("didYouMean() = %q, want %q", got, want)
+	}
+}
+
+// TestResolveTableBucketSuggestionsLocalized tests that the bucket name hints follow the selected language
+func TestResolveTableBucketSuggestionsLocalized(t *testing.T) {
+	t.Cleanup(func() { _ = SetLanguage(DefaultLanguage) })
+	if err := SetLanguage("ja"); err != nil {
+		t.Fatalf("SetLanguage(ja) error = %v", err)
+	}
+
+	if got, want := didYouMean([]string{"a1", "a2"}), "'a1', 'a2' のいずれかではありませんか？"; got != want {
+		t.Errorf("didYouMean() = %q, want %q", got, want)
+	}
+	if got, want := didYouMean([]string{"a1", "a2", "a3", "a4", "a5", "a6"}), "'a1', 'a2', 'a3', 'a4', 'a5'（ほか 1 件）のいずれかではありませんか？"; got != want {
+		t.Errorf("didYouMean() = %q, want %q", got, want)
+	}
+
+	lister := NewS3TablesLister(&PaginatedMockS3TablesAPI{})
+	_, _, err := lister.ResolveTableBucket(context.Background(), "missing")
+	var s3tErr *S3TablesError
+	if !errors.As(err, &s3tErr) || len(s3tErr.Suggestions) != 1 || s3tErr.Suggestions[0] != msg(MsgSuggestVerifyBucketName) {
+		t.Errorf("ResolveTableBucket() error = %v, want the localized verify hint", err)
+	}
+	if !strings.Contains(err.Error(), "Table Bucket 名を確認") {
+		t.Errorf("Error() = %q, want the ja suggestion", err.Error())
+	}
+}
+
+// TestListAllTables tests that tables from every namespace are returned
+func TestListAllTables(t *testing.T) {
+	mock := newHierarchyFuncMock(map[string][]string{
+		"sales": {"orders"},
+		"empty": nil,
+	})
+	lister := NewS3TablesLister(mock)
+
+	tables, skipped, err := lister.ListAllTables(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", false)
+	if err != nil || len(skipped) != 0 {
+		t.Fatalf("ListAllTables() error = %v, skipped = %v", err, skipped)
+	}
+	if len(tables) != 1 || tables[0].Name != "orders" || tables[0].Namespace != "sales" {
+		t.Errorf("ListAllTables() = %+v, want sales/orders", tables)
+	}
+}
+
+// newFailingNamespaceMock returns a mock with three namespaces where listing tables in "broken" fails
+func newFailingNamespaceMock() *FuncMockS3TablesAPI {
+	mock := newHierarchyFuncMock(map[string][]string{
+		"analytics": {"events"},
+		"broken":    {"lost"},
+		"sales":     {"orders"},
+	})
+	listTables := mock.ListTablesFunc
+	mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+		if aws.ToString(params.Namespace) == "broken" {
+			return nil, &types.InternalServerErrorException{Message: aws.String("boom")}
+		}
+		return listTables(ctx, params)
+	}
+	return mock
+}
+
+// TestListAllTablesContinueOnError tests that a failing namespace is skipped and reported
+func TestListAllTablesContinueOnError(t *testing.T) {
+	lister := NewS3TablesLister(newFailingNamespaceMock())
+
+	tables, skipped, err := lister.ListAllTables(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", true)
+	if err != nil {
+		t.Fatalf("ListAllTables() error = %v", err)
+	}
+	if len(tables) != 2 {
+		t.Errorf("ListAllTables() returned %d tables, want 2", len(tables))
+	}
+	if len(skipped) != 1 || skipped[0].Item != "broken" {
+		t.Errorf("skipped = %+v, want broken", skipped)
+	}
+}
+
+// TestListAllTablesFailFast tests that the first failing namespace aborts by default
+func TestListAllTablesFailFast(t *testing.T) {
+	lister := NewS3TablesLister(newFailingNamespaceMock())
+
+	if _, _, err := lister.ListAllTables(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", false); err == nil {
+		t.Error("ListAllTables() error = nil, want failure")
+	}
+}
+
+// TestListTablesRetriesThrottling tests that a throttled list call is retried with the backoff
+func TestListTablesRetriesThrottling(t *testing.T) {
+	mock := newHierarchyFuncMock(map[string][]string{"analytics": {"events"}})
+	listTables := mock.ListTablesFunc
+	calls := 0
+	mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+		calls++
+		if calls == 1 {
+			return nil, &types.TooManyRequestsException{Message: aws.String("slow down")}
+		}
+		return listTables(ctx, params)
+	}
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	lister := NewS3TablesLister(mock)
+	lister.SetRetry(BackoffConfig{MaxAttempts: 3, BaseDelay: time.Second, Strategy: BackoffConstant, Clock: clock})
+
+	tables, err := lister.ListTablesAll(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", "analytics", "")
+	if err != nil {
+		t.Fatalf("ListTablesAll() error = %v", err)
+	}
+	if len(tables) != 1 || calls != 2 {
+		t.Errorf("ListTablesAll() = %d tables after %d calls, want 1 table after 2 calls", len(tables), calls)
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != time.Second {
+		t.Errorf("sleeps = %v, want [1s]", clock.sleeps)
+	}
+
+	lister.SetRetry(BackoffConfig{MaxAttempts: 1})
+	calls = 0
+	if _, err := lister.ListTablesAll(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket", "analytics", ""); GetErrorType(err) != ErrorTypeThrottling {
+		t.Errorf("ListTablesAll() without retries error = %v, want throttling", err)
+	}
+}
+
+// TestListTableBucketsAllBucketType tests that the bucket type filter is passed to the API
+func TestListTableBucketsAllBucketType(t *testing.T) {
+	var got []types.TableBucketType
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			got = append(got, params.Type)
+			return &s3tables.ListTableBucketsOutput{}, nil
+		},
+	}
+	lister := NewS3TablesLister(mock)
+
+	if _, err := lister.ListTableBucketsAll(context.Background(), ""); err != nil {
+		t.Fatalf("ListTableBucketsAll() error = %v", err)
+	}
+	lister.SetBucketType(types.TableBucketTypeAws)
+	if _, err := lister.ListTableBucketsAll(context.Background(), ""); err != nil {
+		t.Fatalf("ListTableBucketsAll() error = %v", err)
+	}
+
+	want := []types.TableBucketType{"", types.TableBucketTypeAws}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Type parameters = %v, want %v", got, want)
+	}
+}
+
+// TestParseBucketType tests bucket type flag validation
+func TestParseBucketType(t *testing.T) {
+	if got, err := ParseBucketType("customer"); err != nil || got != types.TableBucketTypeCustomer {
+		t.Errorf("ParseBucketType(customer) = %q, %v", got, err)
+	}
+	if _, err := ParseBucketType("general-purpose"); err == nil {
+		t.Error("ParseBucketType(general-purpose) error = nil, want error")
+	}
+}
+
+// TestTableInfoDisplayLocation tests the warehouse location placeholder
+func TestTableInfoDisplayLocation(t *testing.T) {
+	if got := (&TableInfo{}).DisplayLocation(); got != "(none)" {
+		t.Errorf("DisplayLocation() = %q, want (none)", got)
+	}
+	if got := (&TableInfo{WarehouseLocation: "s3://loc"}).DisplayLocation(); got != "s3://loc" {
+		t.Errorf("DisplayLocation() = %q, want s3://loc", got)
+	}
+}
+
+// TestListNamespacesPage tests that a single page and its continuation token are returned
+func TestListNamespacesPage(t *testing.T) {
+	mock := &PaginatedMockS3TablesAPI{
+		Namespaces: []types.NamespaceSummary{
+			{Namespace: []string{"ns1"}},
+			{Namespace: []string{"ns2"}},
+			{Namespace: []string{"ns3"}},
+		},
+		PageSize: 2,
+	}
+	lister := NewS3TablesLister(mock)
+
+	page, next, err := lister.ListNamespacesPage(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "", "")
+	if err != nil {
+		t.Fatalf("ListNamespacesPage() error = %v", err)
+	}
+	if len(page) != 2 || next != "2" {
+		t.Fatalf("first page = %d items, token %q; want 2 items, token \"2\"", len(page), next)
+	}
+
+	page, next, err = lister.ListNamespacesPage(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "", next)
+	if err != nil {
+		t.Fatalf("ListNamespacesPage() error = %v", err)
+	}
+	if len(page) != 1 || page[0].Name != "ns3" || next != "" {
+		t.Errorf("last page = %v, token %q; want [ns3], empty token", page, next)
+	}
+}
+
+// TestListTableBucketsMalformedARN tests the warning and strict modes for malformed bucket ARNs
+func TestListTableBucketsMalformedARN(t *testing.T) {
+	newMock := func() *PaginatedMockS3TablesAPI {
+		return &PaginatedMockS3TablesAPI{
+			TableBuckets: []types.TableBucketSummary{
+				{Name: aws.String("good"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/good")},
+				{Name: aws.String("bad"), Arn: aws.String("not-an-arn")},
+			},
+			PageSize: 10,
+		}
+	}
+
+	t.Run("warning", func(t *testing.T) {
+		var warnings strings.Builder
+		lister := NewS3TablesLister(newMock())
+		lister.SetWarningOutput(&warnings)
+
+		buckets, err := lister.ListTableBucketsAll(context.Background(), "")
+		if err != nil {
+			t.Fatalf("ListTableBucketsAll() error = %v", err)
+		}
+		if len(buckets) != 2 {
+			t.Errorf("ListTableBucketsAll() returned %d buckets, want 2", len(buckets))
+		}
+		if !strings.Contains(warnings.String(), "Warning: table bucket 'bad' has a malformed ARN 'not-an-arn'") {
+			t.Errorf("warnings = %q, want a warning for 'bad'", warnings.String())
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		var warnings strings.Builder
+		lister := NewS3TablesLister(newMock())
+		lister.SetWarningOutput(&warnings)
+		lister.SetStrictARN(true)
+
+		_, err := lister.GetTableBucketARN(context.Background(), "good")
+		if err == nil || !strings.Contains(err.Error(), "malformed ARN") {
+			t.Errorf("GetTableBucketARN() error = %v, want malformed ARN error", err)
+		}
+		if warnings.Len() != 0 {
+			t.Errorf("warnings = %q, want none in strict mode", warnings.String())
+		}
+	})
+}
+
+// TestInfoJSONRoundTrip tests that each info type survives marshal and unmarshal
+func TestInfoJSONRoundTrip(t *testing.T) {
+	created := time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.FixedZone("JST", 9*60*60))
+
+	t.Run("TableBucketInfo", func(t *testing.T) {
+		in := TableBucketInfo{Name: "b", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b", CreatedAt: created}
+		var out TableBucketInfo
+		roundTripJSON(t, in, &out)
+		if out.Name != in.Name || out.ARN != in.ARN || !out.CreatedAt.Equal(in.CreatedAt) {
+			t.Errorf("round trip = %+v, want %+v", out, in)
+		}
+	})
+
+	t.Run("NamespaceInfo", func(t *testing.T) {
+		in := NamespaceInfo{Name: "sales", CreatedAt: created}
+		var out NamespaceInfo
+		roundTripJSON(t, in, &out)
+		if out.Name != in.Name || !out.CreatedAt.Equal(in.CreatedAt) {
+			t.Errorf("round trip = %+v, want %+v", out, in)
+		}
+	})
+
+	t.Run("TableInfo", func(t *testing.T) {
+		in := TableInfo{
+			Name:              "orders",
+			ARN:               "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/orders",
+			Namespace:         "sales",
+			CreatedAt:         created,
+			Type:              "customer",
+			WarehouseLocation: "s3://warehouse",
+		}
+		var out TableInfo
+		roundTripJSON(t, in, &out)
+		if !out.CreatedAt.Equal(in.CreatedAt) {
+			t.Errorf("CreatedAt = %v, want %v", out.CreatedAt, in.CreatedAt)
+		}
+		out.CreatedAt = in.CreatedAt
+		if out != in {
+			t.Errorf("round trip = %+v, want %+v", out, in)
+		}
+	})
+}
+
+// roundTripJSON marshals in, checks the timestamp is RFC3339 and unmarshals into out
+func roundTripJSON(t *testing.T, in any, out any) {
+	t.Helper()
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if _, err := time.Parse(time.RFC3339, raw["created_at"].(string)); err != nil {
+		t.Errorf("created_at = %v, want RFC3339: %v", raw["created_at"], err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+}
+
+// TestResolveTableBucketCandidates tests that prefix matches are returned when there is no exact match
+func TestResolveTableBucketCandidates(t *testing.T) {
+	mock := &PaginatedMockS3TablesAPI{
+		TableBuckets: []types.TableBucketSummary{
+			{Name: aws.String("sales-eu"), Arn: aws.String("arn:aws:s3tables:eu-west-1:123456789012:bucket/sales-eu")},
+			{Name: aws.String("sales-us"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/sales-us")},
+		},
+		PageSize: 10,
+	}
+	lister := NewS3TablesLister(mock)
+
+	arn, candidates, err := lister.ResolveTableBucket(context.Background(), "sales")
+	if !IsNotFoundError(err) {
+		t.Fatalf("ResolveTableBucket() error = %v, want not found", err)
+	}
+	if arn != "" || len(candidates) != 2 || candidates[0].Name != "sales-eu" || candidates[1].Name != "sales-us" {
+		t.Errorf("ResolveTableBucket() = %q, %+v", arn, candidates)
+	}
+
+	arn, candidates, err = lister.ResolveTableBucket(context.Background(), "sales-us")
+	if err != nil || arn != "arn:aws:s3tables:us-east-1:123456789012:bucket/sales-us" || candidates != nil {
+		t.Errorf("ResolveTableBucket(exact) = %q, %+v, %v", arn, candidates, err)
+	}
+}
+
+// TestPaginate tests the generic pagination loop over scripted pages
+func TestPaginate(t *testing.T) {
+	type page struct {
+		items []int
+		next  string
+	}
+	tests := []struct {
+		name      string
+		pages     map[string]page // 継続トークン → ページ（"" は最初のページ）
+		want      []int
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "single page",
+			pages:     map[string]page{"": {items: []int{1, 2}}},
+			want:      []int{1, 2},
+			wantCalls: 1,
+		},
+		{
+			name: "multiple pages",
+			pages: map[string]page{
+				"":   {items: []int{1, 2}, next: "p2"},
+				"p2": {items: nil, next: "p3"},
+				"p3": {items: []int{3}},
+			},
+			want:      []int{1, 2, 3},
+			wantCalls: 3,
+		},
+		{
+			name: "repeated token stops the loop",
+			pages: map[string]page{
+				"":   {items: []int{1}, next: "p2"},
+				"p2": {items: []int{2}, next: "p3"},
+				"p3": {items: []int{3}, next: "p2"},
+			},
+			wantCalls: 3,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := paginate(context.Background(), func(token *string) ([]int, *string, error) {
+				calls++
+				p := tt.pages[aws.ToString(token)]
+				return p.items, aws.String(p.next), nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("paginate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("paginate() = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("fetch calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// TestPaginateFirstTokenIsNil tests that the first fetch gets a nil token
+func TestPaginateFirstTokenIsNil(t *testing.T) {
+	_, err := paginate(context.Background(), func(token *string) ([]int, *string, error) {
+		if token != nil {
+			t.Errorf("first token = %q, want nil", *token)
+		}
+		return nil, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("paginate() error = %v", err)
+	}
+}
+
+// TestPaginateContextCancelled tests that a cancelled context stops before the next page
+func TestPaginateContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	_, err := paginate(ctx, func(token *string) ([]int, *string, error) {
+		calls++
+		cancel()
+		return []int{calls}, aws.String(fmt.Sprintf("p%d", calls)), nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("paginate() error = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("fetch calls = %d, want 1", calls)
+	}
+}
+
+// TestPaginateFetchError tests that a fetch error is returned as is
+func TestPaginateFetchError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := paginate(context.Background(), func(token *string) ([]int, *string, error) {
+		return nil, nil, want
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("paginate() error = %v, want %v", err, want)
+	}
+}
+
+// TestGetTableDetailsNotFound tests that a missing namespace and a missing table produce distinct errors
+func TestGetTableDetailsNotFound(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespaceErr error
+		wantMessage  string
+		wantType     ErrorType
+	}{
+		{name: "missing namespace", namespaceErr: &types.NotFoundException{Message: aws.String("not found")}, wantMessage: "namespace 'sales' not found", wantType: ErrorTypeNotFound},
+		{name: "missing table", wantMessage: "table 'orders' not found in namespace 'sales'", wantType: ErrorTypeNotFound},
+		{name: "namespace probe fails", namespaceErr: errors.New("connection reset"), wantMessage: "resource not found", wantType: ErrorTypeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &FuncMockS3TablesAPI{
+				GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+					return nil, &types.NotFoundException{Message: aws.String("not found")}
+				},
+				GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+					if tt.namespaceErr != nil {
+						return nil, tt.namespaceErr
+					}
+					return &s3tables.GetNamespaceOutput{Namespace: []string{"sales"}}, nil
+				},
+			}
+
+			_, err := NewS3TablesLister(mock).GetTableDetails(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "sales", "orders")
+			var s3tErr *S3TablesError
+			if !errors.As(err, &s3tErr) {
+				t.Fatalf("GetTableDetails() error = %v, want *S3TablesError", err)
+			}
+			if s3tErr.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", s3tErr.Message, tt.wantMessage)
+			}
+			if s3tErr.Type != tt.wantType {
+				t.Errorf("Type = %v, want %v", s3tErr.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+// TestListStats tests the page and item counts reported for a known item count and page size
+func TestListStats(t *testing.T) {
+	var tables []types.TableSummary
+	for i := range 25 {
+		tables = append(tables, types.TableSummary{
+			Name:      aws.String(fmt.Sprintf("table_%02d", i)),
+			Namespace: []string{"sales"},
+			TableARN:  aws.String(fmt.Sprintf("arn:aws:s3tables:us-east-1:123456789012:bucket/test/table/%d", i)),
+		})
+	}
+	mock := &PaginatedMockS3TablesAPI{Tables: tables, PageSize: 10}
+
+	var got []ListStats
+	lister := NewS3TablesLister(mock)
+	lister.SetStatsFunc(func(stats ListStats) { got = append(got, stats) })
+
+	if _, err := lister.ListTablesAll(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/test", "sales", ""); err != nil {
+		t.Fatalf("ListTablesAll() error = %v", err)
+	}
+	want := []ListStats{{Operation: "ListTables", Pages: 3, Items: 25}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+}
+
+// TestFormatTimeZero tests that zero timestamps render as the placeholder in text and are omitted from JSON
+func TestFormatTimeZero(t *testing.T) {
+	if got := FormatTime(time.Time{}, DisplayTimeLayout); got != UnknownTime {
+		t.Errorf("FormatTime(zero) = %q, want %q", got, UnknownTime)
+	}
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := FormatTime(created, DisplayTimeLayout); got != "2024-01-02 03:04:05" {
+		t.Errorf("FormatTime() = %q, want 2024-01-02 03:04:05", got)
+	}
+
+	tbl := TableInfo{Name: "orders", Namespace: "sales", Type: "customer"}
+	if got := tbl.CompactString(false); !strings.HasSuffix(got, "created=-") {
+		t.Errorf("CompactString() = %q, want created=-", got)
+	}
+	data, err := json.Marshal(tbl)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "created_at") || strings.Contains(string(data), "modified_at") {
+		t.Errorf("json.Marshal() = %s, want zero timestamps omitted", data)
+	}
+}
+
+// TestHumanizeAge tests that ages are rendered in their largest whole unit
+func TestHumanizeAge(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	SetClock(newFakeClock(now))
+	defer SetClock(nil)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{name: "zero", t: time.Time{}, want: UnknownTime},
+		{name: "seconds", t: now.Add(-45 * time.Second), want: "45s ago"},
+		{name: "minutes", t: now.Add(-12*time.Minute - 30*time.Second), want: "12m ago"},
+		{name: "hours", t: now.Add(-5*time.Hour - 59*time.Minute), want: "5h ago"},
+		{name: "days", t: now.Add(-3*24*time.Hour - 2*time.Hour), want: "3d ago"},
+		{name: "future", t: now.Add(time.Minute), want: "0s ago"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := humanizeAge(tt.t); got != tt.want {
+				t.Errorf("humanizeAge() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolveARN tests resolving the ARN of each path depth, including a missing
+// namespace and a table ARN outside the table bucket
+func TestResolveARN(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/sales"
+	tableARN := bucketARN + "/table/0123-abcd"
+	mock := &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			return &s3tables.ListTableBucketsOutput{TableBuckets: []types.TableBucketSummary{{Name: aws.String("sales"), Arn: aws.String(bucketARN)}}}, nil
+		},
+		GetTableBucketFunc: func(ctx context.Context, params *s3tables.GetTableBucketInput) (*s3tables.GetTableBucketOutput, error) {
+			if aws.ToString(params.TableBucketARN) != bucketARN {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetTableBucketOutput{Arn: params.TableBucketARN}, nil
+		},
+		GetNamespaceFunc: func(ctx context.Context, params *s3tables.GetNamespaceInput) (*s3tables.GetNamespaceOutput, error) {
+			if aws.ToString(params.Namespace) != "orders" {
+				return nil, &types.NotFoundException{Message: aws.String("not found")}
+			}
+			return &s3tables.GetNamespaceOutput{}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			if aws.ToString(params.Name) == "foreign" {
+				return &s3tables.GetTableOutput{Name: params.Name, TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/other/table/9")}, nil
+			}
+			return &s3tables.GetTableOutput{Name: params.Name, TableARN: aws.String(tableARN)}, nil
+		},
+	}
+	lister := NewS3TablesLister(mock)
+
+	tests := []struct {
+		name     string
+		path     ResourcePath
+		want     string
+		wantType ErrorType
+	}{
+		{name: "bucket", path: ResourcePath{Bucket: "sales"}, want: bucketARN},
+		{name: "bucket ARN", path: ResourcePath{Bucket: "sales", BucketARN: bucketARN}, want: bucketARN},
+		{name: "namespace", path: ResourcePath{Bucket: "sales", Namespace: "orders"}, want: bucketARN},
+		{name: "table", path: ResourcePath{Bucket: "sales", Namespace: "orders", Table: "daily"}, want: tableARN},
+		{name: "missing bucket", path: ResourcePath{Bucket: "missing"}, wantType: ErrorTypeNotFound},
+		{name: "missing bucket ARN", path: ResourcePath{Bucket: "missing", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/missing"}, wantType: ErrorTypeNotFound},
+		{name: "missing namespace", path: ResourcePath{Bucket: "sales", Namespace: "refunds"}, wantType: ErrorTypeNotFound},
+		{name: "foreign table", path: ResourcePath{Bucket: "sales", Namespace: "orders", Table: "foreign"}, wantType: ErrorTypeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lister.ResolveARN(context.Background(), tt.path)
+			if tt.want != "" {
+				if err != nil || got != tt.want {
+					t.Errorf("ResolveARN() = %q, %v, want %q", got, err, tt.want)
+				}
+				return
+			}
+			var s3Err *S3TablesError
+			if !errors.As(err, &s3Err) || s3Err.Type != tt.wantType {
+				t.Errorf("ResolveARN() error = %v, want S3TablesError of type %v", err, tt.wantType)
+			}
+		})
+	}
+}
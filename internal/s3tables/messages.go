@@ -0,0 +1,197 @@
+package s3tables
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MessageID identifies a user-facing message in the message catalogs
+type MessageID string
+
+// Message IDs used by WrapError, handleAPIError and the other errors of this package
+const (
+	MsgNotFound                   MessageID = "not_found"
+	MsgAlreadyExists              MessageID = "already_exists"
+	MsgAccessDenied               MessageID = "access_denied"
+	MsgInvalidRequest             MessageID = "invalid_request"
+	MsgServiceError               MessageID = "service_error"
+	MsgTimeout                    MessageID = "timeout"
+	MsgThrottled                  MessageID = "throttled"
+	MsgCredentialsMissing         MessageID = "credentials_missing"
+	MsgCredentialsInvalid         MessageID = "credentials_invalid"
+	MsgUnsupportedRegion          MessageID = "unsupported_region"
+	MsgUnsupportedRegionUnknown   MessageID = "unsupported_region_unknown"
+	MsgInvalidRegionFormat        MessageID = "invalid_region_format"
+	MsgSuggestVerifyName          MessageID = "suggest_verify_name"
+	MsgSuggestDifferentName       MessageID = "suggest_different_name"
+	MsgSuggestCheckPermissions    MessageID = "suggest_check_permissions"
+	MsgSuggestCheckResourcePolicy MessageID = "suggest_check_resource_policy"
+	MsgSuggestCheckInput          MessageID = "suggest_check_input"
+	MsgSuggestRetry               MessageID = "suggest_retry"
+	MsgSuggestWaitRetry           MessageID = "suggest_wait_retry"
+	MsgSuggestConfigureCreds      MessageID = "suggest_configure_credentials"
+	MsgSuggestCheckCredsConfig    MessageID = "suggest_check_credentials_config"
+	MsgSuggestCheckProfile        MessageID = "suggest_check_profile"
+	MsgSuggestCheckClock          MessageID = "suggest_check_clock"
+	MsgSuggestAlternativeName     MessageID = "suggest_alternative_name"
+	MsgSuggestSupportedRegion     MessageID = "suggest_supported_region"
+	MsgSuggestCheckTableType      MessageID = "suggest_check_table_type"
+	MsgSuggestLenientARN          MessageID = "suggest_lenient_arn"
+	MsgSuggestRetryListing        MessageID = "suggest_retry_listing"
+	MsgSuggestCreateBucketFirst   MessageID = "suggest_create_bucket_first"
+	MsgSuggestRepairExistingOnly  MessageID = "suggest_repair_existing_only"
+	MsgSuggestGrantBulkActions    MessageID = "suggest_grant_bulk_actions"
+	MsgSuggestDeleteTablesFirst   MessageID = "suggest_delete_tables_first"
+	MsgSuggestCheckSelectTarget   MessageID = "suggest_check_select_target"
+	MsgSuggestRegionFormat        MessageID = "suggest_region_format"
+	MsgSuggestVerifyBucketName    MessageID = "suggest_verify_bucket_name"
+	MsgSuggestDidYouMean          MessageID = "suggest_did_you_mean"
+	MsgSuggestDidYouMeanMore      MessageID = "suggest_did_you_mean_more"
+)
+
+// DefaultLanguage is the language whose catalog backs every other catalog
+const DefaultLanguage = "en"
+
+var (
+	// messagesMu guards messages and language
+	messagesMu sync.RWMutex
+	// messages holds one catalog per language; missing entries fall back to DefaultLanguage
+	messages = map[string]map[MessageID]string{
+		"en": {
+			MsgNotFound:                   "resource not found",
+			MsgAlreadyExists:              "resource already exists",
+			MsgAccessDenied:               "access denied",
+			MsgInvalidRequest:             "invalid request",
+			MsgServiceError:               "AWS service error",
+			MsgTimeout:                    "request timed out",
+			MsgThrottled:                  "request rate exceeded",
+			MsgCredentialsMissing:         "AWS credentials not configured",
+			MsgCredentialsInvalid:         "invalid AWS credentials",
+			MsgUnsupportedRegion:          "S3 Tables may not be available in region %s",
+			MsgUnsupportedRegionUnknown:   "S3 Tables may not be available in the configured region",
+			MsgInvalidRegionFormat:        "'%s' is not a well-formed AWS region name",
+			MsgSuggestVerifyName:          "verify the resource name and try again",
+			MsgSuggestDifferentName:       "use a different name or check existing resources",
+			MsgSuggestCheckPermissions:    "check your AWS credentials and permissions",
+			MsgSuggestCheckResourcePolicy: "check that the table bucket or table resource policy allows the action",
+			MsgSuggestCheckInput:          "check your input parameters",
+			MsgSuggestRetry:               "please retry the operation",
+			MsgSuggestWaitRetry:           "wait a moment and retry the operation",
+			MsgSuggestConfigureCreds:      "configure AWS credentials using 'aws configure' or environment variables",
+			MsgSuggestCheckCredsConfig:    "check your AWS credentials configuration",
+			MsgSuggestCheckProfile:        "check that the intended AWS profile is selected",
+			MsgSuggestCheckClock:          "check that the system clock is accurate",
+			MsgSuggestAlternativeName:     "use a different name, e.g. '%s'",
+			MsgSuggestSupportedRegion:     "try a supported region",
+			MsgSuggestCheckTableType:      "check the table configuration, or recreate the table with the expected type",
+			MsgSuggestLenientARN:          "disable strict ARN checking to continue with a warning",
+			MsgSuggestRetryListing:        "the listing would never end; retry the operation",
+			MsgSuggestCreateBucketFirst:   "create the table bucket first, or let s3t create missing table buckets",
+			MsgSuggestRepairExistingOnly:  "repair only fills gaps below existing table buckets; create the table bucket first",
+			MsgSuggestGrantBulkActions:    "grant s3tables:ListTableBuckets and the s3tables:Create*/Get* actions to your IAM identity before running bulk operations",
+			MsgSuggestDeleteTablesFirst:   "delete the tables first, or delete them together with the namespace",
+			MsgSuggestCheckSelectTarget:   "check the name of the item to select",
+			MsgSuggestRegionFormat:        "use a region name such as us-east-1",
+			MsgSuggestVerifyBucketName:    "verify the table bucket name and try again",
+			MsgSuggestDidYouMean:          "did you mean %s?",
+			MsgSuggestDidYouMeanMore:      "did you mean %s (and %d more)?",
+		},
+		"ja": {
+			MsgNotFound:                   "リソースが見つかりません",
+			MsgAlreadyExists:              "リソースは既に存在します",
+			MsgAccessDenied:               "アクセスが拒否されました",
+			MsgInvalidRequest:             "リクエストが不正です",
+			MsgServiceError:               "AWS サービスエラー",
+			MsgTimeout:                    "リクエストがタイムアウトしました",
+			MsgThrottled:                  "リクエストのレート制限を超えました",
+			MsgCredentialsMissing:         "AWS 認証情報が設定されていません",
+			MsgCredentialsInvalid:         "AWS 認証情報が無効です",
+			MsgUnsupportedRegion:          "リージョン %s では S3 Tables を利用できない可能性があります",
+			MsgUnsupportedRegionUnknown:   "設定されたリージョンでは S3 Tables を利用できない可能性があります",
+			MsgInvalidRegionFormat:        "'%s' は AWS リージョン名の形式ではありません",
+			MsgSuggestVerifyName:          "リソース名を確認して再実行してください",
+			MsgSuggestDifferentName:       "別の名前を使うか、既存のリソースを確認してください",
+			MsgSuggestCheckPermissions:    "AWS 認証情報と権限を確認してください",
+			MsgSuggestCheckResourcePolicy: "テーブルバケットまたはテーブルのリソースポリシーで操作が許可されているか確認してください",
+			MsgSuggestCheckInput:          "入力パラメータを確認してください",
+			MsgSuggestRetry:               "操作を再実行してください",
+			MsgSuggestWaitRetry:           "しばらく待ってから再実行してください",
+			MsgSuggestConfigureCreds:      "'aws configure' または環境変数で AWS 認証情報を設定してください",
+			MsgSuggestCheckCredsConfig:    "AWS 認証情報の設定を確認してください",
+			MsgSuggestCheckProfile:        "意図した AWS プロファイルが選ばれているか確認してください",
+			MsgSuggestCheckClock:          "システム時刻が正しいか確認してください",
+			MsgSuggestAlternativeName:     "別の名前を使ってください（例: '%s'）",
+			MsgSuggestSupportedRegion:     "対応リージョンを指定してください",
+			MsgSuggestCheckTableType:      "テーブルの設定を確認するか、期待する種別でテーブルを作成し直してください",
+			MsgSuggestLenientARN:          "厳密な ARN 検証を無効にすると、警告を表示して続行できます",
+			MsgSuggestRetryListing:        "一覧取得が終わらないため、操作を再実行してください",
+			MsgSuggestCreateBucketFirst:   "先にテーブルバケットを作成するか、存在しないテーブルバケットを s3t に作成させてください",
+			MsgSuggestRepairExistingOnly:  "修復は既存のテーブルバケット配下の欠けたリソースのみを作成します。先にテーブルバケットを作成してください",
+			MsgSuggestGrantBulkActions:    "一括操作の前に s3tables:ListTableBuckets と s3tables:Create*/Get* アクションを IAM アイデンティティに許可してください",
+			MsgSuggestDeleteTablesFirst:   "先にテーブルを削除するか、Namespace と一緒に削除してください",
+			MsgSuggestCheckSelectTarget:   "選択する項目の名前を確認してください",
+			MsgSuggestRegionFormat:        "us-east-1 のようなリージョン名を指定してください",
+			MsgSuggestVerifyBucketName:    "Table Bucket 名を確認して再実行してください",
+			MsgSuggestDidYouMean:          "%s のいずれかではありませんか？",
+			MsgSuggestDidYouMeanMore:      "%s（ほか %d 件）のいずれかではありませんか？",
+		},
+	}
+	// language is the catalog selected by SetLanguage
+	language = DefaultLanguage
+)
+
+// SetLanguage selects the message catalog used for error messages and suggestions.
+// An empty lang selects DefaultLanguage; an unknown lang returns an error.
+func SetLanguage(lang string) error {
+	if lang == "" {
+		lang = DefaultLanguage
+	}
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	if _, ok := messages[lang]; !ok {
+		return fmt.Errorf("unsupported language '%s' (available: %s)", lang, strings.Join(languagesLocked(), ", "))
+	}
+	language = lang
+	return nil
+}
+
+// RegisterCatalog adds or replaces the catalog for lang.
+// Messages missing from catalog fall back to the DefaultLanguage catalog.
+func RegisterCatalog(lang string, catalog map[MessageID]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	messages[lang] = catalog
+}
+
+// Languages returns the languages that have a registered catalog, sorted
+func Languages() []string {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	return languagesLocked()
+}
+
+func languagesLocked() []string {
+	langs := make([]string, 0, len(messages))
+	for lang := range messages {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// msg returns the message for id in the selected language, formatted with args
+func msg(id MessageID, args ...any) string {
+	messagesMu.RLock()
+	text, ok := messages[language][id]
+	if !ok {
+		text = messages[DefaultLanguage][id]
+	}
+	messagesMu.RUnlock()
+
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
@@ -0,0 +1,84 @@
+package s3tables
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestSetLanguageChangesSuggestion tests that the ja catalog localizes WrapError output
+func TestSetLanguageChangesSuggestion(t *testing.T) {
+	t.Cleanup(func() { _ = SetLanguage(DefaultLanguage) })
+
+	if err := SetLanguage("ja"); err != nil {
+		t.Fatalf("SetLanguage(ja) error = %v", err)
+	}
+
+	var s3tErr *S3TablesError
+	if !errors.As(WrapError("GetTable", &types.NotFoundException{}), &s3tErr) {
+		t.Fatal("WrapError() did not return *S3TablesError")
+	}
+	if s3tErr.Message != "リソースが見つかりません" {
+		t.Errorf("Message = %q, want the ja catalog entry", s3tErr.Message)
+	}
+	if s3tErr.Suggestion() != "リソース名を確認して再実行してください" {
+		t.Errorf("Suggestion = %q, want the ja catalog entry", s3tErr.Suggestion())
+	}
+}
+
+// TestRegisterCatalogFallsBackToDefault tests that missing entries use the English catalog
+func TestRegisterCatalogFallsBackToDefault(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetLanguage(DefaultLanguage)
+		messagesMu.Lock()
+		delete(messages, "xx")
+		messagesMu.Unlock()
+	})
+
+	RegisterCatalog("xx", map[MessageID]string{MsgSuggestRetry: "xx-retry"})
+	if err := SetLanguage("xx"); err != nil {
+		t.Fatalf("SetLanguage(xx) error = %v", err)
+	}
+
+	var s3tErr *S3TablesError
+	if !errors.As(WrapError("GetTable", &types.InternalServerErrorException{}), &s3tErr) {
+		t.Fatal("WrapError() did not return *S3TablesError")
+	}
+	if s3tErr.Suggestion() != "xx-retry" {
+		t.Errorf("Suggestion = %q, want xx-retry", s3tErr.Suggestion())
+	}
+	if s3tErr.Message != "AWS service error" {
+		t.Errorf("Message = %q, want English fallback", s3tErr.Message)
+	}
+}
+
+// TestSetLanguageUnknown tests that an unknown language is rejected and the selection kept
+func TestSetLanguageUnknown(t *testing.T) {
+	if err := SetLanguage("zz"); err == nil {
+		t.Error("SetLanguage(zz) error = nil, want error")
+	}
+	if got := msg(MsgSuggestRetry); got != "please retry the operation" {
+		t.Errorf("msg() = %q, want English default", got)
+	}
+}
+
+// TestCatalogsCoverSameMessages tests that the ja catalog translates every English message
+// and that no suggestion names a CLI flag, which belongs to the cmd layer
+func TestCatalogsCoverSameMessages(t *testing.T) {
+	en, ja := messages["en"], messages["ja"]
+	for id, text := range en {
+		if _, ok := ja[id]; !ok {
+			t.Errorf("ja catalog has no entry for %s", id)
+		}
+		if strings.Contains(text, "--") {
+			t.Errorf("en message %s names a CLI flag: %q", id, text)
+		}
+	}
+	for id := range ja {
+		if _, ok := en[id]; !ok {
+			t.Errorf("en catalog has no entry for %s", id)
+		}
+	}
+}
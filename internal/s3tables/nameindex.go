@@ -0,0 +1,81 @@
+package s3tables
+
+import (
+	"fmt"
+	"sort"
+)
+
+// nameIndex maps the display names shown in a selector back to the position of
+// the item in its backing slice. Duplicate names are disambiguated by appending
+// the occurrence number, e.g. "orders", "orders (2)"; the number is increased
+// further when that label is the name of another item.
+type nameIndex struct {
+	names []string
+	index map[string]int
+}
+
+// newNameIndex builds the display names for names
+func newNameIndex(names []string) *nameIndex {
+	idx := &nameIndex{
+		names: make([]string, len(names)),
+		index: make(map[string]int, len(names)),
+	}
+
+	// 実在する名前は先に確保し、重複の表示名がそれと衝突しないようにする
+	taken := make(map[string]bool, len(names))
+	for _, name := range names {
+		taken[name] = true
+	}
+	seen := make(map[string]int, len(names))
+	for i, name := range names {
+		seen[name]++
+		display := name
+		if seen[name] > 1 {
+			for n := seen[name]; ; n++ {
+				display = fmt.Sprintf("%s (%d)", name, n)
+				if !taken[display] {
+					break
+				}
+			}
+			taken[display] = true
+		}
+		idx.names[i] = display
+		idx.index[display] = i
+	}
+	return idx
+}
+
+// Names returns the display names in the original order
+func (n *nameIndex) Names() []string {
+	return n.names
+}
+
+// Lookup returns the position of the item shown as display
+func (n *nameIndex) Lookup(display string) (int, bool) {
+	i, ok := n.index[display]
+	return i, ok
+}
+
+// FindNameCollisions returns the table names that occur in more than one
+// namespace, mapped to those namespaces in sorted order
+func FindNameCollisions(tables []TableInfo) map[string][]string {
+	namespacesByName := make(map[string]map[string]bool)
+	for _, tbl := range tables {
+		if namespacesByName[tbl.Name] == nil {
+			namespacesByName[tbl.Name] = make(map[string]bool)
+		}
+		namespacesByName[tbl.Name][tbl.Namespace] = true
+	}
+
+	collisions := make(map[string][]string)
+	for name, namespaces := range namespacesByName {
+		if len(namespaces) < 2 {
+			continue
+		}
+		for ns := range namespaces {
+			collisions[name] = append(collisions[name], ns)
+		}
+		sort.Strings(collisions[name])
+	}
+	return collisions
+}
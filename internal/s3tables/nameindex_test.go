@@ -0,0 +1,106 @@
+package s3tables
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestNameIndex(t *testing.T) {
+	tables := []TableInfo{
+		{Name: "orders", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/first"},
+		{Name: "customers", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/customers"},
+		{Name: "orders", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/second"},
+	}
+	names := make([]string, len(tables))
+	for i, tbl := range tables {
+		names[i] = tbl.Name
+	}
+
+	idx := newNameIndex(names)
+
+	if want := []string{"orders", "customers", "orders (2)"}; !reflect.DeepEqual(idx.Names(), want) {
+		t.Errorf("Names() = %v, want %v", idx.Names(), want)
+	}
+
+	i, ok := idx.Lookup("orders (2)")
+	if !ok || tables[i].ARN != "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/second" {
+		t.Errorf("Lookup(orders (2)) resolved to %d (%v), want the second orders table", i, ok)
+	}
+	i, ok = idx.Lookup("orders")
+	if !ok || tables[i].ARN != "arn:aws:s3tables:us-east-1:123456789012:bucket/b/table/first" {
+		t.Errorf("Lookup(orders) resolved to %d (%v), want the first orders table", i, ok)
+	}
+	if _, ok := idx.Lookup("missing"); ok {
+		t.Error("Lookup(missing) ok = true, want false")
+	}
+}
+
+// TestNameIndexLabelCollision tests that a disambiguated label never equals the name of another item
+func TestNameIndexLabelCollision(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  []string
+	}{
+		{names: []string{"a", "a", "a (2)"}, want: []string{"a", "a (3)", "a (2)"}},
+		{names: []string{"a (2)", "a", "a", "a (3)"}, want: []string{"a (2)", "a", "a (4)", "a (3)"}},
+		{names: []string{"a", "a (2)", "a (2)", "a"}, want: []string{"a", "a (2)", "a (2) (2)", "a (3)"}},
+	}
+	for _, tt := range tests {
+		idx := newNameIndex(tt.names)
+		if !reflect.DeepEqual(idx.Names(), tt.want) {
+			t.Errorf("newNameIndex(%q).Names() = %q, want %q", tt.names, idx.Names(), tt.want)
+		}
+		for i, display := range idx.Names() {
+			if got, ok := idx.Lookup(display); !ok || got != i {
+				t.Errorf("Lookup(%q) = %d, %v, want %d", display, got, ok, i)
+			}
+		}
+	}
+}
+
+// TestNavigateDuplicateBucketNames tests that the selected duplicate resolves to its own ARN
+func TestNavigateDuplicateBucketNames(t *testing.T) {
+	controller := NewNavigationController(NewS3TablesLister(&PaginatedMockS3TablesAPI{PageSize: 10}), &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			return &SelectionResult{Selected: "dup (2)", Action: ActionSelect}, nil
+		},
+	})
+	controller.state.TableBuckets = []TableBucketInfo{
+		{Name: "dup", ARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/dup"},
+		{Name: "dup", ARN: "arn:aws:s3tables:us-west-2:123456789012:bucket/dup"},
+	}
+
+	action, err := controller.navigateTableBuckets(context.Background())
+	if err != nil || action != ActionSelect {
+		t.Fatalf("navigateTableBuckets() = %v, %v", action, err)
+	}
+
+	state := controller.GetState()
+	if state.Selected.Bucket != "dup" || state.Selected.BucketARN != "arn:aws:s3tables:us-west-2:123456789012:bucket/dup" {
+		t.Errorf("selected %q %q, want the us-west-2 bucket", state.Selected.Bucket, state.Selected.BucketARN)
+	}
+}
+
+// TestFindNameCollisions tests that only names used in several namespaces are reported
+func TestFindNameCollisions(t *testing.T) {
+	tables := []TableInfo{
+		{Name: "orders", Namespace: "sales"},
+		{Name: "orders", Namespace: "archive"},
+		{Name: "customers", Namespace: "sales"},
+		{Name: "events", Namespace: "analytics"},
+		{Name: "events", Namespace: "sales"},
+		{Name: "events", Namespace: "archive"},
+	}
+
+	want := map[string][]string{
+		"orders": {"archive", "sales"},
+		"events": {"analytics", "archive", "sales"},
+	}
+	if got := FindNameCollisions(tables); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindNameCollisions() = %v, want %v", got, want)
+	}
+	if got := FindNameCollisions(tables[2:4]); len(got) != 0 {
+		t.Errorf("FindNameCollisions() without shared names = %v, want none", got)
+	}
+}
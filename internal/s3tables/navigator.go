@@ -3,6 +3,16 @@ package s3tables
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manifoldco/promptui"
 )
 
 // NavigationLevel represents the current navigation level
@@ -28,13 +38,49 @@ func (l NavigationLevel) String() string {
 	}
 }
 
+// LevelLabels holds the prompt label shown at each navigation level, so that
+// the prompts can be localized or rebranded. Empty fields use the default label.
+type LevelLabels struct {
+	TableBucket string
+	Namespace   string
+	Table       string
+}
+
+// DefaultLevelLabels returns the English labels used when none are configured
+func DefaultLevelLabels() LevelLabels {
+	return LevelLabels{
+		TableBucket: "Select Table Bucket",
+		Namespace:   "Select Namespace",
+		Table:       "Select Table",
+	}
+}
+
+// For returns the label for level, falling back to the default when it is empty
+func (l LevelLabels) For(level NavigationLevel) string {
+	defaults := DefaultLevelLabels()
+	label, fallback := "", ""
+	switch level {
+	case LevelTableBucket:
+		label, fallback = l.TableBucket, defaults.TableBucket
+	case LevelNamespace:
+		label, fallback = l.Namespace, defaults.Namespace
+	case LevelTable:
+		label, fallback = l.Table, defaults.Table
+	}
+	if label == "" {
+		return fallback
+	}
+	return label
+}
+
 // NavigationAction represents the action taken by the user
 type NavigationAction int
 
 const (
-	ActionSelect NavigationAction = iota // アイテムを選択
-	ActionBack                           // ESC で戻る
-	ActionExit                           // 終了
+	ActionSelect      NavigationAction = iota // アイテムを選択
+	ActionBack                                // ESC で戻る
+	ActionExit                                // 終了
+	ActionResetFilter                         // 一致なしの検索フィルタをクリアして再表示
 )
 
 // String returns the string representation of NavigationAction
@@ -46,6 +92,8 @@ func (a NavigationAction) String() string {
 		return "Back"
 	case ActionExit:
 		return "Exit"
+	case ActionResetFilter:
+		return "ResetFilter"
 	default:
 		return "Unknown"
 	}
@@ -53,52 +101,331 @@ func (a NavigationAction) String() string {
 
 // NavigationState holds the current navigation state and cached data
 type NavigationState struct {
-	Level             NavigationLevel
-	TableBuckets      []TableBucketInfo // キャッシュされた Table Bucket 一覧
-	Namespaces        []NamespaceInfo   // キャッシュされた Namespace 一覧
-	Tables            []TableInfo       // キャッシュされた Table 一覧
-	SelectedBucket    string            // 選択された Table Bucket 名
-	SelectedBucketARN string            // 選択された Table Bucket ARN
-	SelectedNamespace string            // 選択された Namespace 名
+	Level        NavigationLevel
+	TableBuckets []TableBucketInfo          // キャッシュされた Table Bucket 一覧
+	Namespaces   []NamespaceInfo            // キャッシュされた Namespace 一覧
+	Tables       []TableInfo                // キャッシュされた Table 一覧
+	TableDetails map[string]*TableInfo      // キャッシュされた Table 詳細（Table ARN がキー、ロケーション表示時のみ取得）
+	Selected     ResourcePath               // 選択された Table Bucket（名前と ARN）、Namespace、詳細を表示した Table
+	NextTokens   map[NavigationLevel]string // ページ単位取得時の次ページの継続トークン（空は最終ページ）
 }
 
 // NavigationController manages hierarchical navigation
 type NavigationController struct {
-	lister   *S3TablesLister
-	selector InteractiveSelector
-	state    *NavigationState
+	lister          *S3TablesLister
+	selector        InteractiveSelector
+	mu              sync.Mutex // state を保護（GetState は Navigate と並行して呼ばれ得るため）
+	state           *NavigationState
+	compactDetails  bool
+	arnMaxLen       int                        // ARN 表示の最大長（0 は切り詰めなし）
+	targets         map[NavigationLevel]string // 自動選択する名前（一度だけ使用）
+	maxItems        int                        // 1 階層あたりの表示上限（0 は無制限）
+	reverseSort     bool                       // Namespace を降順に並べる
+	showLocation    bool                       // Table 詳細に保存場所を表示する
+	showAge         bool                       // Namespace / Table の一覧に作成からの経過時間を付ける
+	labels          LevelLabels                // 各階層のプロンプトのラベル（空は既定の英語）
+	autoSingle      bool                       // 項目が 1 件だけの階層は選択を省略して進む
+	returned        bool                       // 下位階層から戻った直後（1 件でもプロンプトを表示する）
+	redactARNs      bool                       // Table 詳細の ARN のアカウント ID を伏せる
+	noBackOption    bool                       // 下位階層で ".. (Back)" を表示せず、ESC で戻る
+	paged           bool                       // 1 ページずつ取得し "Load more" で続きを取得する
+	startTokens     map[NavigationLevel]string // 最初のページの取得を始める継続トークン（一度だけ使用）
+	expectedRegion  string                     // NavigateTo で ARN と照合するリージョン（空は照合しない）
+	expectedAccount string                     // NavigateTo で ARN と照合するアカウント ID（空は照合しない）
+	warnOut         io.Writer
+	inputFunc       func(ctx context.Context, label string) (string, error)
 }
 
 // NewNavigationController creates a new NavigationController
 func NewNavigationController(lister *S3TablesLister, selector InteractiveSelector) *NavigationController {
 	return &NavigationController{
-		lister:   lister,
-		selector: selector,
-		state:    &NavigationState{},
+		lister:    lister,
+		selector:  selector,
+		state:     &NavigationState{},
+		warnOut:   os.Stderr,
+		inputFunc: Input,
 	}
 }
 
-// GetState returns the current navigation state
+// GetState returns a snapshot of the current navigation state.
+// The snapshot is not updated by later navigation and may be read without locking.
 func (c *NavigationController) GetState() *NavigationState {
-	return c.state
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot := *c.state
+	snapshot.TableDetails = maps.Clone(c.state.TableDetails)
+	snapshot.NextTokens = maps.Clone(c.state.NextTokens)
+	return &snapshot
+}
+
+// update applies fn to the state while holding the state lock
+func (c *NavigationController) update(fn func(state *NavigationState)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.state)
+}
+
+// selection returns the selected bucket and namespace
+func (c *NavigationController) selection() ResourcePath {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state.Selected
+}
+
+// level returns the current navigation level
+func (c *NavigationController) level() NavigationLevel {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state.Level
+}
+
+// setLevel moves the navigation to level
+func (c *NavigationController) setLevel(level NavigationLevel) {
+	c.update(func(state *NavigationState) { state.Level = level })
+}
+
+// SetInitialState sets the bucket and namespace navigation starts in; the
+// table of path is ignored. Navigate from path.Level() to list its children.
+func (c *NavigationController) SetInitialState(path ResourcePath) {
+	path.Table = ""
+	c.update(func(state *NavigationState) { state.Selected = path })
+}
+
+// InvalidateFor clears the caches made stale by creating resources under the given
+// table bucket and namespace, so that the next visit re-fetches them:
+//   - bucket "" (a table bucket was created): the table bucket list
+//   - namespace "" (a namespace was created in bucket): the namespace list of bucket
+//   - otherwise (a table was created in bucket/namespace): the tables of that namespace
+//
+// Caches belonging to a different bucket or namespace than the selected one are left intact.
+func (c *NavigationController) InvalidateFor(bucket, namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if bucket == "" {
+		c.state.TableBuckets = nil
+		return
+	}
+	if bucket != c.state.Selected.Bucket {
+		return
+	}
+	if namespace == "" {
+		c.state.Namespaces = nil
+		return
+	}
+	if namespace == c.state.Selected.Namespace {
+		c.state.Tables = nil
+		c.state.TableDetails = nil
+	}
+}
+
+// SetMaxItemsPerLevel caps the number of namespaces or tables offered at once.
+// Above the cap the user must enter a prefix before the list is shown (0 disables the cap).
+func (c *NavigationController) SetMaxItemsPerLevel(maxItems int) {
+	c.maxItems = maxItems
+}
+
+// SetReverseSort lists namespaces in reverse alphabetical order
+func (c *NavigationController) SetReverseSort(reverse bool) {
+	c.reverseSort = reverse
+}
+
+// SetShowLocation adds the warehouse location to the table details view
+func (c *NavigationController) SetShowLocation(show bool) {
+	c.showLocation = show
+}
+
+// SetLevelLabels replaces the prompt labels; empty fields keep the default label
+func (c *NavigationController) SetLevelLabels(labels LevelLabels) {
+	c.labels = labels
+}
+
+// SetAutoSingle skips the prompt at levels that have exactly one item and
+// selects it, printing a notice. A level reached by going back always prompts,
+// so that the user can keep going back (or exit) from it.
+func (c *NavigationController) SetAutoSingle(auto bool) {
+	c.autoSingle = auto
+}
+
+// SetShowAge annotates namespace and table items with their relative age,
+// e.g. "orders (3d ago)". Items selected by SetTarget are matched by plain name.
+func (c *NavigationController) SetShowAge(show bool) {
+	c.showAge = show
+}
+
+// displayName returns the prompt item for name, annotated with the age of
+// created when annotate is set and the creation time is known
+func displayName(name string, created time.Time, annotate bool) string {
+	if !annotate || created.IsZero() {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, humanizeAge(created))
+}
+
+// SetPaged makes every level fetch a single page at a time. When more pages
+// remain, a LoadMoreOption item is offered that fetches the next page on demand.
+func (c *NavigationController) SetPaged(paged bool) {
+	c.paged = paged
+}
+
+// SetAfterToken resumes the first listing of level from a continuation token
+// instead of the first page. It is used once and implies paged loading.
+func (c *NavigationController) SetAfterToken(level NavigationLevel, token string) {
+	if c.startTokens == nil {
+		c.startTokens = make(map[NavigationLevel]string)
+	}
+	c.startTokens[level] = token
+	c.paged = true
+}
+
+// SetCompactDetails enables single-line table details output
+func (c *NavigationController) SetCompactDetails(compact bool) {
+	c.compactDetails = compact
+}
+
+// SetARNMaxLen truncates ARNs in table details to maxLen characters (0 disables truncation)
+func (c *NavigationController) SetARNMaxLen(maxLen int) {
+	c.arnMaxLen = maxLen
+}
+
+// SetNoBackOption hides the ".. (Back)" entry at the namespace and table levels.
+// ESC then is the only way back; Ctrl+C still exits.
+func (c *NavigationController) SetNoBackOption(noBack bool) {
+	c.noBackOption = noBack
+}
+
+// showBackOption reports whether the prompt at level lists ".. (Back)".
+// The table bucket level is the top, so it never has one.
+func (c *NavigationController) showBackOption(level NavigationLevel) bool {
+	return level != LevelTableBucket && !c.noBackOption
+}
+
+// AvailableActions returns the actions the user can take at the current level:
+// ActionSelect and ActionExit everywhere, and ActionBack below the table bucket level
+// (through ".. (Back)" or ESC, or ESC alone when the back option is hidden)
+func (c *NavigationController) AvailableActions() []NavigationAction {
+	if c.level() == LevelTableBucket {
+		return []NavigationAction{ActionSelect, ActionExit}
+	}
+	return []NavigationAction{ActionSelect, ActionBack, ActionExit}
+}
+
+// SetRedactARNs masks the account ID of ARNs in table details
+func (c *NavigationController) SetRedactARNs(redact bool) {
+	c.redactARNs = redact
+}
+
+// SetTarget makes the given level select name automatically instead of prompting.
+// The target is used once, so navigating back to the level prompts as usual.
+func (c *NavigationController) SetTarget(level NavigationLevel, name string) {
+	if c.targets == nil {
+		c.targets = make(map[NavigationLevel]string)
+	}
+	c.targets[level] = name
+}
+
+// SetExpectedLocation sets the configured region and account that NavigateTo
+// checks ARNs against; an empty value skips that check
+func (c *NavigationController) SetExpectedLocation(region, account string) {
+	c.expectedRegion = region
+	c.expectedAccount = account
+}
+
+// SetWarningOutput sets the writer that warnings are printed to
+func (c *NavigationController) SetWarningOutput(w io.Writer) {
+	c.warnOut = w
+}
+
+// SetInputFunc replaces the prompt that asks for a prefix above the item cap,
+// e.g. with one that fails when prompts are not allowed
+func (c *NavigationController) SetInputFunc(input func(ctx context.Context, label string) (string, error)) {
+	c.inputFunc = input
+}
+
+// NavigateTo jumps straight to the resource named by arn. A table bucket ARN
+// starts the navigation at its namespaces; a table ARN shows the table's details
+// with the bucket, namespace and level set as if they had been selected by hand.
+// An ARN of another region or account is only warned about, since the lookup
+// may still succeed through a custom endpoint.
+func (c *NavigationController) NavigateTo(ctx context.Context, arn string) error {
+	resource, err := ParseResourceARN(arn)
+	if err != nil {
+		return err
+	}
+	shown := arn
+	if c.redactARNs {
+		shown = RedactARN(arn)
+	}
+	if c.expectedRegion != "" && resource.Region != c.expectedRegion {
+		fmt.Fprintf(c.warnOut, "Warning: ARN %s: region '%s' differs from the configured region '%s'\n", shown, resource.Region, c.expectedRegion)
+	}
+	if c.expectedAccount != "" && resource.AccountID != c.expectedAccount {
+		fmt.Fprintf(c.warnOut, "Warning: ARN %s: account '%s' differs from the current account '%s'\n", shown, c.redactAccount(resource.AccountID), c.redactAccount(c.expectedAccount))
+	}
+
+	bucketARN := resource.BucketARN()
+	if resource.TableID == "" {
+		path := ResourcePath{Bucket: resource.BucketName, BucketARN: bucketARN}
+		c.SetInitialState(path)
+		return c.Navigate(ctx, path.Level())
+	}
+
+	table, err := c.lister.FindTableByARN(ctx, arn)
+	if err != nil {
+		return err
+	}
+	c.SetInitialState(ResourcePath{Bucket: resource.BucketName, BucketARN: bucketARN, Namespace: table.Namespace})
+	c.setLevel(LevelTable)
+
+	details, err := c.tableDetails(ctx, table)
+	if err != nil {
+		return err
+	}
+	c.displayTableDetails(details)
+	return nil
+}
+
+// redactAccount masks an account ID in warnings when ARNs are redacted
+func (c *NavigationController) redactAccount(accountID string) string {
+	if c.redactARNs {
+		return redactedAccount
+	}
+	return accountID
+}
+
+// selectorFor returns the selector for the level, consuming any pending target
+func (c *NavigationController) selectorFor(level NavigationLevel) InteractiveSelector {
+	if target, ok := c.targets[level]; ok {
+		delete(c.targets, level)
+		return &TargetSelector{Target: target}
+	}
+	return c.selector
 }
 
-// SetInitialState sets the initial state for navigation
-func (c *NavigationController) SetInitialState(bucketName, bucketARN, namespace string) {
-	c.state.SelectedBucket = bucketName
-	c.state.SelectedBucketARN = bucketARN
-	c.state.SelectedNamespace = namespace
+// singleItemSelector returns a selector that picks the only item without prompting
+// when auto-single is enabled, or selector otherwise. It consumes the "returned"
+// mark so that only the first prompt after going back is forced.
+func (c *NavigationController) singleItemSelector(selector InteractiveSelector, items []string) InteractiveSelector {
+	returned := c.returned
+	c.returned = false
+	if !c.autoSingle || returned || len(items) != 1 {
+		return selector
+	}
+	if _, targeted := selector.(*TargetSelector); targeted {
+		return selector
+	}
+	fmt.Printf("Auto-selected '%s' (the only item)\n", items[0])
+	return &TargetSelector{Target: items[0]}
 }
 
-// Navigate starts the navigation from the specified level
+// Navigate starts the navigation from the specified level.
 func (c *NavigationController) Navigate(ctx context.Context, startLevel NavigationLevel) error {
-	c.state.Level = startLevel
+	c.setLevel(startLevel)
 
 	for {
 		var action NavigationAction
 		var err error
 
-		switch c.state.Level {
+		switch c.level() {
 		case LevelTableBucket:
 			action, err = c.navigateTableBuckets(ctx)
 			if err != nil {
@@ -108,7 +435,7 @@ func (c *NavigationController) Navigate(ctx context.Context, startLevel Navigati
 				return nil // Exit application
 			}
 			if action == ActionSelect {
-				c.state.Level = LevelNamespace
+				c.setLevel(LevelNamespace)
 			}
 
 		case LevelNamespace:
@@ -120,11 +447,12 @@ func (c *NavigationController) Navigate(ctx context.Context, startLevel Navigati
 				return nil // Exit application
 			}
 			if action == ActionBack {
-				c.state.Level = LevelTableBucket
+				c.returned = true
+				c.setLevel(LevelTableBucket)
 				continue
 			}
 			if action == ActionSelect {
-				c.state.Level = LevelTable
+				c.setLevel(LevelTable)
 			}
 
 		case LevelTable:
@@ -136,7 +464,8 @@ func (c *NavigationController) Navigate(ctx context.Context, startLevel Navigati
 				return nil // Exit application
 			}
 			if action == ActionBack {
-				c.state.Level = LevelNamespace
+				c.returned = true
+				c.setLevel(LevelNamespace)
 				continue
 			}
 			// Table 選択後は詳細表示して終了
@@ -148,27 +477,27 @@ func (c *NavigationController) Navigate(ctx context.Context, startLevel Navigati
 // navigateTableBuckets handles Table Bucket level navigation
 func (c *NavigationController) navigateTableBuckets(ctx context.Context) (NavigationAction, error) {
 	// Fetch table buckets if not cached
-	if c.state.TableBuckets == nil {
-		buckets, err := c.lister.ListTableBucketsAll(ctx, "")
-		if err != nil {
-			return ActionExit, err
-		}
-		c.state.TableBuckets = buckets
+	buckets, err := c.tableBuckets(ctx)
+	if err != nil {
+		return ActionExit, err
 	}
 
-	if len(c.state.TableBuckets) == 0 {
+	if len(buckets) == 0 {
 		fmt.Println("No table buckets found")
 		return ActionExit, nil
 	}
 
 	// Extract names for selection
-	names := make([]string, len(c.state.TableBuckets))
-	for i, bucket := range c.state.TableBuckets {
+	names := make([]string, len(buckets))
+	for i, bucket := range buckets {
 		names[i] = bucket.Name
 	}
+	index := newNameIndex(names)
 
 	// No back option at top level
-	result, err := c.selector.SelectWithFilter("Select Table Bucket", names, false)
+	items := c.withLoadMore(LevelTableBucket, index.Names())
+	selector := c.singleItemSelector(c.selectorFor(LevelTableBucket), items)
+	result, err := selector.SelectWithFilter(ctx, c.labels.For(LevelTableBucket), items, c.showBackOption(LevelTableBucket))
 	if err != nil {
 		return ActionExit, err
 	}
@@ -176,19 +505,25 @@ func (c *NavigationController) navigateTableBuckets(ctx context.Context) (Naviga
 	if result.Action == ActionBack || result.Action == ActionExit {
 		return result.Action, nil
 	}
-
-	// Find selected bucket and store ARN
-	for _, bucket := range c.state.TableBuckets {
-		if bucket.Name == result.Selected {
-			c.state.SelectedBucket = bucket.Name
-			c.state.SelectedBucketARN = bucket.ARN
-			break
+	if result.Selected == LoadMoreOption {
+		if _, err := c.fetchTableBuckets(ctx); err != nil {
+			return ActionExit, err
 		}
+		return c.navigateTableBuckets(ctx)
 	}
 
-	// Clear namespace cache when bucket changes
-	c.state.Namespaces = nil
-	c.state.Tables = nil
+	c.update(func(state *NavigationState) {
+		// Find selected bucket and store ARN
+		if i, ok := index.Lookup(result.Selected); ok {
+			state.Selected.Bucket = buckets[i].Name
+			state.Selected.BucketARN = buckets[i].ARN
+		}
+		state.Selected.Table = ""
+
+		// Clear namespace cache when bucket changes
+		state.Namespaces = nil
+		state.Tables = nil
+	})
 
 	return ActionSelect, nil
 }
@@ -196,42 +531,66 @@ func (c *NavigationController) navigateTableBuckets(ctx context.Context) (Naviga
 // navigateNamespaces handles Namespace level navigation
 func (c *NavigationController) navigateNamespaces(ctx context.Context) (NavigationAction, error) {
 	// Fetch namespaces if not cached
-	if c.state.Namespaces == nil {
-		namespaces, err := c.lister.ListNamespacesAll(ctx, c.state.SelectedBucketARN, "")
-		if err != nil {
-			return ActionExit, err
-		}
-		c.state.Namespaces = namespaces
+	namespaces, err := c.namespaces(ctx)
+	if err != nil {
+		return ActionExit, err
 	}
 
-	if len(c.state.Namespaces) == 0 {
-		fmt.Printf("No namespaces found in table bucket '%s'\n", c.state.SelectedBucket)
+	if len(namespaces) == 0 {
+		fmt.Printf("No namespaces found in table bucket '%s'\n", c.selection().Bucket)
 		return ActionBack, nil
 	}
+	ok, err := c.capItems(ctx, LevelNamespace, len(namespaces), func(prefix string) (int, error) {
+		narrowed, err := c.narrowNamespaces(ctx, prefix)
+		if len(narrowed) > 0 {
+			namespaces = narrowed
+		}
+		return len(narrowed), err
+	})
+	if err != nil || !ok {
+		return ActionExit, err
+	}
 
-	// Extract names for selection
-	names := make([]string, len(c.state.Namespaces))
-	for i, ns := range c.state.Namespaces {
-		names[i] = ns.Name
+	// Extract names for selection; targets match plain names, so only prompts are annotated
+	selector := c.selectorFor(LevelNamespace)
+	_, targeted := selector.(*TargetSelector)
+	names := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		names[i] = displayName(ns.Name, ns.CreatedAt, c.showAge && !targeted)
 	}
+	index := newNameIndex(names)
+	items := index.Names()
 
-	// Show back option to return to table bucket selection
-	result, err := c.selector.SelectWithFilter("Select Namespace", names, true)
+	// Show back option (unless disabled) to return to table bucket selection
+	items = c.withLoadMore(LevelNamespace, items)
+	selector = c.singleItemSelector(selector, items)
+	result, err := selector.SelectWithFilter(ctx, c.labels.For(LevelNamespace), items, c.showBackOption(LevelNamespace))
 	if err != nil {
 		return ActionExit, err
 	}
 
-	if result.Action == ActionBack {
+	switch result.Action {
+	case ActionBack:
 		return ActionBack, nil
-	}
-	if result.Action == ActionExit {
+	case ActionExit:
 		return ActionExit, nil
 	}
+	if result.Selected == LoadMoreOption {
+		if _, err := c.fetchNamespaces(ctx); err != nil {
+			return ActionExit, err
+		}
+		return c.navigateNamespaces(ctx)
+	}
 
-	c.state.SelectedNamespace = result.Selected
+	c.update(func(state *NavigationState) {
+		if i, ok := index.Lookup(result.Selected); ok {
+			state.Selected.Namespace = namespaces[i].Name
+		}
+		state.Selected.Table = ""
 
-	// Clear tables cache when namespace changes
-	c.state.Tables = nil
+		// Clear tables cache when namespace changes
+		state.Tables = nil
+	})
 
 	return ActionSelect, nil
 }
@@ -239,55 +598,435 @@ func (c *NavigationController) navigateNamespaces(ctx context.Context) (Navigati
 // navigateTables handles Table level navigation
 func (c *NavigationController) navigateTables(ctx context.Context) (NavigationAction, error) {
 	// Fetch tables if not cached
-	if c.state.Tables == nil {
-		tables, err := c.lister.ListTablesAll(ctx, c.state.SelectedBucketARN, c.state.SelectedNamespace, "")
-		if err != nil {
-			return ActionExit, err
-		}
-		c.state.Tables = tables
+	tables, err := c.tables(ctx)
+	if err != nil {
+		return ActionExit, err
 	}
 
-	if len(c.state.Tables) == 0 {
-		fmt.Printf("No tables found in namespace '%s'\n", c.state.SelectedNamespace)
+	if len(tables) == 0 {
+		fmt.Printf("No tables found in namespace '%s'\n", c.selection().Namespace)
 		return ActionBack, nil
 	}
+	ok, err := c.capItems(ctx, LevelTable, len(tables), func(prefix string) (int, error) {
+		narrowed, err := c.narrowTables(ctx, prefix)
+		if len(narrowed) > 0 {
+			tables = narrowed
+		}
+		return len(narrowed), err
+	})
+	if err != nil || !ok {
+		return ActionExit, err
+	}
 
-	// Extract names for selection
-	names := make([]string, len(c.state.Tables))
-	for i, tbl := range c.state.Tables {
-		names[i] = tbl.Name
+	// Extract names for selection; targets match plain names, so only prompts are annotated
+	selector := c.selectorFor(LevelTable)
+	_, targeted := selector.(*TargetSelector)
+	names := make([]string, len(tables))
+	for i, tbl := range tables {
+		names[i] = displayName(tbl.Name, tbl.CreatedAt, c.showAge && !targeted)
 	}
+	index := newNameIndex(names)
+	items := index.Names()
 
-	// Show back option to return to namespace selection
-	result, err := c.selector.SelectWithFilter("Select Table", names, true)
+	// Show back option (unless disabled) to return to namespace selection
+	items = c.withLoadMore(LevelTable, items)
+	selector = c.singleItemSelector(selector, items)
+	result, err := selector.SelectWithFilter(ctx, c.labels.For(LevelTable), items, c.showBackOption(LevelTable))
 	if err != nil {
 		return ActionExit, err
 	}
 
-	if result.Action == ActionBack {
+	switch result.Action {
+	case ActionBack:
 		return ActionBack, nil
-	}
-	if result.Action == ActionExit {
+	case ActionExit:
 		return ActionExit, nil
 	}
+	if result.Selected == LoadMoreOption {
+		if _, err := c.fetchTables(ctx); err != nil {
+			return ActionExit, err
+		}
+		return c.navigateTables(ctx)
+	}
 
 	// Display table details
-	for _, tbl := range c.state.Tables {
-		if tbl.Name == result.Selected {
-			c.displayTableDetails(&tbl)
-			break
-		}
+	i, ok := index.Lookup(result.Selected)
+	if !ok {
+		return ActionSelect, nil
+	}
+	tbl, err := c.tableDetails(ctx, &tables[i])
+	if err != nil {
+		return ActionExit, err
 	}
+	c.displayTableDetails(tbl)
 
 	return ActionSelect, nil
 }
 
+// tableBuckets returns the cached table buckets, fetching them when nothing is cached
+func (c *NavigationController) tableBuckets(ctx context.Context) ([]TableBucketInfo, error) {
+	c.mu.Lock()
+	buckets := c.state.TableBuckets
+	c.mu.Unlock()
+	if buckets != nil {
+		return buckets, nil
+	}
+	return c.fetchTableBuckets(ctx)
+}
+
+// namespaces returns the cached namespaces of the selected bucket, fetching them when nothing is cached
+func (c *NavigationController) namespaces(ctx context.Context) ([]NamespaceInfo, error) {
+	c.mu.Lock()
+	namespaces := c.state.Namespaces
+	c.mu.Unlock()
+	if namespaces != nil {
+		return namespaces, nil
+	}
+	return c.fetchNamespaces(ctx)
+}
+
+// tables returns the cached tables of the selected namespace, fetching them when nothing is cached
+func (c *NavigationController) tables(ctx context.Context) ([]TableInfo, error) {
+	c.mu.Lock()
+	tables := c.state.Tables
+	c.mu.Unlock()
+	if tables != nil {
+		return tables, nil
+	}
+	return c.fetchTables(ctx)
+}
+
+// fetchTableBuckets loads all table buckets, or in paged mode the first page
+// (when nothing is cached) or the next page appended to the cached ones.
+// The API is called without holding the state lock; it returns the cached list after the update.
+func (c *NavigationController) fetchTableBuckets(ctx context.Context) ([]TableBucketInfo, error) {
+	if !c.paged {
+		buckets, err := c.lister.ListTableBucketsAll(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.state.TableBuckets = buckets
+		return buckets, nil
+	}
+
+	c.mu.Lock()
+	first := c.state.TableBuckets == nil
+	token := c.pageToken(LevelTableBucket, first)
+	c.mu.Unlock()
+
+	page, next, err := nextPage(ctx, token, func(token string) ([]TableBucketInfo, string, error) {
+		return c.lister.ListTableBucketsPage(ctx, "", token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.pageStillCurrent(LevelTableBucket, first, token, c.state.TableBuckets == nil) {
+		return c.state.TableBuckets, nil
+	}
+	if first {
+		c.state.TableBuckets = page
+	} else {
+		c.state.TableBuckets = slices.Concat(c.state.TableBuckets, page)
+	}
+	c.state.NextTokens[LevelTableBucket] = next
+	return c.state.TableBuckets, nil
+}
+
+// fetchNamespaces loads the namespaces of the selected bucket like fetchTableBuckets.
+// The list is sorted on every fetch so that cached re-visits keep the same order.
+// Results for a bucket that is no longer selected are returned but not cached.
+func (c *NavigationController) fetchNamespaces(ctx context.Context) ([]NamespaceInfo, error) {
+	bucketARN := c.selection().BucketARN
+
+	var page []NamespaceInfo
+	var first bool
+	var token, next string
+	if !c.paged {
+		all, err := c.lister.ListNamespacesAll(ctx, bucketARN, "")
+		if err != nil {
+			return nil, err
+		}
+		page = all
+		first = true
+	} else {
+		c.mu.Lock()
+		first = c.state.Namespaces == nil
+		token = c.pageToken(LevelNamespace, first)
+		c.mu.Unlock()
+
+		var err error
+		page, next, err = nextPage(ctx, token, func(token string) ([]NamespaceInfo, string, error) {
+			return c.lister.ListNamespacesPage(ctx, bucketARN, "", token)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state.Selected.BucketARN != bucketARN {
+		return c.sortNamespaces(page), nil
+	}
+	if c.paged && !c.pageStillCurrent(LevelNamespace, first, token, c.state.Namespaces == nil) {
+		return c.state.Namespaces, nil
+	}
+
+	if first {
+		c.state.Namespaces = c.sortNamespaces(page)
+	} else {
+		c.state.Namespaces = c.sortNamespaces(slices.Concat(c.state.Namespaces, page))
+	}
+	if c.paged {
+		c.state.NextTokens[LevelNamespace] = next
+	}
+	return c.state.Namespaces, nil
+}
+
+// sortNamespaces sorts namespaces in place by name, honoring SetReverseSort
+func (c *NavigationController) sortNamespaces(namespaces []NamespaceInfo) []NamespaceInfo {
+	sort.SliceStable(namespaces, func(i, j int) bool {
+		if c.reverseSort {
+			return namespaces[i].Name > namespaces[j].Name
+		}
+		return namespaces[i].Name < namespaces[j].Name
+	})
+	return namespaces
+}
+
+// fetchTables loads the tables of the selected namespace like fetchNamespaces
+func (c *NavigationController) fetchTables(ctx context.Context) ([]TableInfo, error) {
+	selected := c.selection()
+	bucketARN, namespace := selected.BucketARN, selected.Namespace
+
+	var page []TableInfo
+	var first bool
+	var token, next string
+	if !c.paged {
+		all, err := c.lister.ListTablesAll(ctx, bucketARN, namespace, "")
+		if err != nil {
+			return nil, err
+		}
+		page = all
+		first = true
+	} else {
+		c.mu.Lock()
+		first = c.state.Tables == nil
+		token = c.pageToken(LevelTable, first)
+		c.mu.Unlock()
+
+		var err error
+		page, next, err = nextPage(ctx, token, func(token string) ([]TableInfo, string, error) {
+			return c.lister.ListTablesPage(ctx, bucketARN, namespace, "", token)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state.Selected.BucketARN != bucketARN || c.state.Selected.Namespace != namespace {
+		return page, nil
+	}
+	if c.paged && !c.pageStillCurrent(LevelTable, first, token, c.state.Tables == nil) {
+		return c.state.Tables, nil
+	}
+
+	if first {
+		c.state.Tables = page
+	} else {
+		c.state.Tables = slices.Concat(c.state.Tables, page)
+	}
+	if c.paged {
+		c.state.NextTokens[LevelTable] = next
+	}
+	return c.state.Tables, nil
+}
+
+// pageToken returns the continuation token for the next fetch of level.
+// The first fetch starts at the token given to SetAfterToken, if any.
+// The caller must hold the state lock.
+func (c *NavigationController) pageToken(level NavigationLevel, first bool) string {
+	if c.state.NextTokens == nil {
+		c.state.NextTokens = make(map[NavigationLevel]string)
+	}
+	if !first {
+		return c.state.NextTokens[level]
+	}
+	token := c.startTokens[level]
+	delete(c.startTokens, level)
+	return token
+}
+
+// pageStillCurrent reports whether a page fetched from token may still be stored,
+// i.e. no concurrent fetch of level filled the cache (first page) or advanced
+// past token (later pages) in the meantime. The caller must hold the state lock.
+func (c *NavigationController) pageStillCurrent(level NavigationLevel, first bool, token string, empty bool) bool {
+	if first {
+		return empty
+	}
+	return !empty && c.state.NextTokens[level] == token
+}
+
+// nextPage fetches the page at token, skipping empty pages that still have a
+// continuation so that a fetch yields at least one item unless the listing ended.
+// Like paginate, it checks ctx before every page and reports a repeated token.
+func nextPage[T any](ctx context.Context, token string, fetch func(token string) ([]T, string, error)) ([]T, string, error) {
+	seen := make(map[string]bool)
+	if token != "" {
+		seen[token] = true
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+		page, next, err := fetch(token)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(page) > 0 || next == "" {
+			return page, next, nil
+		}
+		if err := markToken(seen, next); err != nil {
+			return nil, "", err
+		}
+		token = next
+	}
+}
+
+// withLoadMore appends LoadMoreOption to items when level has further pages to fetch
+func (c *NavigationController) withLoadMore(level NavigationLevel, items []string) []string {
+	if !c.paged {
+		return items
+	}
+	c.mu.Lock()
+	next := c.state.NextTokens[level]
+	c.mu.Unlock()
+	if next == "" {
+		return items
+	}
+	return append(items[:len(items):len(items)], LoadMoreOption)
+}
+
+// capItems enforces the per-level item cap. While the count items of level exceed
+// it, a warning is printed and the user is asked for a prefix, which narrow lists
+// from the API and returns the number of matches of; a prefix matching nothing is
+// asked again. ok is false when the user interrupts the prompt. Levels with an
+// auto-select target are not capped.
+func (c *NavigationController) capItems(ctx context.Context, level NavigationLevel, count int, narrow func(prefix string) (int, error)) (ok bool, err error) {
+	if c.maxItems <= 0 || c.targets[level] != "" {
+		return true, nil
+	}
+
+	for count > c.maxItems {
+		fmt.Fprintf(c.warnOut, "Warning: %d %s items exceed the limit of %d; enter a prefix to narrow them down\n", count, level, c.maxItems)
+		prefix, err := c.inputFunc(ctx, fmt.Sprintf("%s prefix", level))
+		if err != nil && ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if err != nil {
+			if err == promptui.ErrInterrupt {
+				return false, nil
+			}
+			return false, fmt.Errorf("prefix input failed: %w", err)
+		}
+
+		prefix = strings.TrimSpace(prefix)
+		matched, err := narrow(prefix)
+		if err != nil {
+			return false, err
+		}
+		if matched == 0 {
+			fmt.Fprintf(c.warnOut, "No items match prefix '%s'\n", prefix)
+			continue
+		}
+		count = matched
+	}
+	return true, nil
+}
+
+// narrowNamespaces lists the namespaces of the selected bucket starting with prefix
+// and, when any match, caches them in place of the current list. The listing is
+// complete, so no further page is offered.
+func (c *NavigationController) narrowNamespaces(ctx context.Context, prefix string) ([]NamespaceInfo, error) {
+	bucketARN := c.selection().BucketARN
+	namespaces, err := c.lister.ListNamespacesAll(ctx, bucketARN, prefix)
+	if err != nil || len(namespaces) == 0 {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	namespaces = c.sortNamespaces(namespaces)
+	if c.state.Selected.BucketARN == bucketARN {
+		c.state.Namespaces = namespaces
+		delete(c.state.NextTokens, LevelNamespace)
+	}
+	return namespaces, nil
+}
+
+// narrowTables lists the tables of the selected namespace like narrowNamespaces
+func (c *NavigationController) narrowTables(ctx context.Context, prefix string) ([]TableInfo, error) {
+	selected := c.selection()
+	tables, err := c.lister.ListTablesAll(ctx, selected.BucketARN, selected.Namespace, prefix)
+	if err != nil || len(tables) == 0 {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state.Selected.BucketARN == selected.BucketARN && c.state.Selected.Namespace == selected.Namespace {
+		c.state.Tables = tables
+		delete(c.state.NextTokens, LevelTable)
+	}
+	return tables, nil
+}
+
+// tableDetails returns the details shown for a listed table of the selected
+// namespace and records it as the selected table. GetTable is only called when
+// the warehouse location is shown, since the listing does not include it, and
+// only on the first view of each table ARN.
+func (c *NavigationController) tableDetails(ctx context.Context, tbl *TableInfo) (*TableInfo, error) {
+	c.mu.Lock()
+	bucketARN, namespace := c.state.Selected.BucketARN, c.state.Selected.Namespace
+	cached, ok := c.state.TableDetails[tbl.ARN]
+	c.mu.Unlock()
+
+	if c.showLocation && tbl.WarehouseLocation == "" {
+		if ok {
+			tbl = cached
+		} else {
+			details, err := c.lister.GetTableDetails(ctx, bucketARN, namespace, tbl.Name)
+			if err != nil {
+				return nil, err
+			}
+			if tbl.ARN != "" {
+				c.update(func(state *NavigationState) {
+					if state.TableDetails == nil {
+						state.TableDetails = make(map[string]*TableInfo)
+					}
+					state.TableDetails[tbl.ARN] = details
+				})
+			}
+			tbl = details
+		}
+	}
+	c.update(func(state *NavigationState) { state.Selected.Table = tbl.Name })
+	return tbl, nil
+}
+
 // displayTableDetails prints the details of a table
 func (c *NavigationController) displayTableDetails(tbl *TableInfo) {
-	fmt.Printf("\nTable Details:\n")
-	fmt.Printf("  Name:      %s\n", tbl.Name)
-	fmt.Printf("  ARN:       %s\n", tbl.ARN)
-	fmt.Printf("  Namespace: %s\n", tbl.Namespace)
-	fmt.Printf("  Type:      %s\n", tbl.Type)
-	fmt.Printf("  Created:   %s\n", tbl.CreatedAt.Format("2006-01-02 15:04:05"))
+	if c.redactARNs {
+		redacted := *tbl
+		redacted.ARN = RedactARN(tbl.ARN)
+		tbl = &redacted
+	}
+	tbl.WriteDetails(os.Stdout, c.compactDetails, c.showLocation, c.arnMaxLen)
 }
@@ -56,18 +56,31 @@ func TestNavigationActionString(t *testing.T) {
 	}
 }
 
-// TestGetState tests GetState method
+// TestGetState tests that GetState returns an independent snapshot of the state
 func TestGetState(t *testing.T) {
 	lister := NewS3TablesLister(&PaginatedMockS3TablesAPI{})
 	selector := &MockInteractiveSelector{}
 	controller := NewNavigationController(lister, selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket", Namespace: "ns"})
+	controller.state.NextTokens = map[NavigationLevel]string{LevelTable: "token"}
+	controller.state.TableDetails = map[string]*TableInfo{"arn:tbl": {Name: "tbl"}}
 
 	state := controller.GetState()
 	if state == nil {
-		t.Error("GetState() returned nil")
+		t.Fatal("GetState() returned nil")
 	}
-	if state != controller.state {
-		t.Error("GetState() returned different state object")
+	if state == controller.state {
+		t.Error("GetState() returned the live state object, want a snapshot")
+	}
+	if state.Selected.Bucket != "bucket" || state.Selected.Namespace != "ns" || state.NextTokens[LevelTable] != "token" {
+		t.Errorf("GetState() = %+v, want the current selection and caches", state)
+	}
+
+	state.Selected.Namespace = "changed"
+	delete(state.NextTokens, LevelTable)
+	delete(state.TableDetails, "arn:tbl")
+	if controller.state.Selected.Namespace != "ns" || controller.state.NextTokens[LevelTable] != "token" || controller.state.TableDetails["arn:tbl"] == nil {
+		t.Error("modifying the snapshot changed the controller state")
 	}
 }
 
@@ -85,7 +98,7 @@ type MockSelectorCall struct {
 	ShowBack bool
 }
 
-func (m *MockInteractiveSelector) SelectWithFilter(label string, items []string, showBack bool) (*SelectionResult, error) {
+func (m *MockInteractiveSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error) {
 	m.CallCount++
 	m.CallHistory = append(m.CallHistory, MockSelectorCall{Label: label, Items: items, ShowBack: showBack})
 	if m.SelectWithFilterFunc != nil {
@@ -147,7 +160,7 @@ func TestPropertyNavigationLevelDeterminesCorrectResourceListing(t *testing.T) {
 				SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
 					callCount++
 					// Verify correct label
-					if label != "Select Table Bucket" {
+					if label != DefaultLevelLabels().TableBucket {
 						return nil, fmt.Errorf("unexpected label: %s", label)
 					}
 					// Verify correct number of items
@@ -196,7 +209,7 @@ func TestPropertyNavigationLevelDeterminesCorrectResourceListing(t *testing.T) {
 				SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
 					callCount++
 					// Verify correct label
-					if label != "Select Namespace" {
+					if label != DefaultLevelLabels().Namespace {
 						return nil, fmt.Errorf("unexpected label: %s", label)
 					}
 					// Verify correct number of items
@@ -210,7 +223,7 @@ func TestPropertyNavigationLevelDeterminesCorrectResourceListing(t *testing.T) {
 
 			controller := NewNavigationController(lister, selector)
 			// Set initial state for namespace navigation
-			controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+			controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 			controller.state.Level = LevelNamespace
 
 			// Navigate from namespace level
@@ -254,7 +267,7 @@ func TestPropertyNavigationLevelDeterminesCorrectResourceListing(t *testing.T) {
 				SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
 					callCount++
 					// Verify correct label
-					if label != "Select Table" {
+					if label != DefaultLevelLabels().Table {
 						return nil, fmt.Errorf("unexpected label: %s", label)
 					}
 					// Verify correct number of items
@@ -268,7 +281,7 @@ func TestPropertyNavigationLevelDeterminesCorrectResourceListing(t *testing.T) {
 
 			controller := NewNavigationController(lister, selector)
 			// Set initial state for table navigation
-			controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "test_ns")
+			controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", Namespace: "test_ns"})
 			controller.state.Level = LevelTable
 
 			// Navigate from table level
@@ -344,8 +357,8 @@ func TestPropertySelectionAdvancesNavigationToNextLevel(t *testing.T) {
 			// Verify action is Select (which triggers level advancement in Navigate loop)
 			// and that the selected bucket info is stored
 			return action == ActionSelect &&
-				controller.state.SelectedBucket != "" &&
-				controller.state.SelectedBucketARN != ""
+				controller.state.Selected.Bucket != "" &&
+				controller.state.Selected.BucketARN != ""
 		},
 		navigationInputGen,
 	))
@@ -381,7 +394,7 @@ func TestPropertySelectionAdvancesNavigationToNextLevel(t *testing.T) {
 
 			controller := NewNavigationController(lister, selector)
 			// Set initial state for namespace navigation
-			controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+			controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 			controller.state.Level = LevelNamespace
 
 			// Call navigateNamespaces directly
@@ -392,7 +405,7 @@ func TestPropertySelectionAdvancesNavigationToNextLevel(t *testing.T) {
 
 			// Verify action is Select (which triggers level advancement in Navigate loop)
 			// and that the selected namespace is stored
-			return action == ActionSelect && controller.state.SelectedNamespace != ""
+			return action == ActionSelect && controller.state.Selected.Namespace != ""
 		},
 		navigationInputGen,
 	))
@@ -463,9 +476,9 @@ func TestPropertySelectionAdvancesNavigationToNextLevel(t *testing.T) {
 			if len(labelsVisited) != 3 {
 				return false
 			}
-			return labelsVisited[0] == "Select Table Bucket" &&
-				labelsVisited[1] == "Select Namespace" &&
-				labelsVisited[2] == "Select Table"
+			return labelsVisited[0] == DefaultLevelLabels().TableBucket &&
+				labelsVisited[1] == DefaultLevelLabels().Namespace &&
+				labelsVisited[2] == DefaultLevelLabels().Table
 		},
 		navigationInputGen,
 	))
@@ -570,11 +583,11 @@ func TestPropertyESCNavigatesBackOneLevel(t *testing.T) {
 
 			// Verify navigation sequence: Bucket -> Namespace -> Table -> Namespace -> Bucket
 			expectedLabels := []string{
-				"Select Table Bucket",
-				"Select Namespace",
-				"Select Table",
-				"Select Namespace",
-				"Select Table Bucket",
+				DefaultLevelLabels().TableBucket,
+				DefaultLevelLabels().Namespace,
+				DefaultLevelLabels().Table,
+				DefaultLevelLabels().Namespace,
+				DefaultLevelLabels().TableBucket,
 			}
 
 			if len(levelsVisited) != len(expectedLabels) {
@@ -656,9 +669,9 @@ func TestPropertyESCNavigatesBackOneLevel(t *testing.T) {
 
 			// Verify navigation sequence: Bucket -> Namespace -> Bucket
 			expectedLabels := []string{
-				"Select Table Bucket",
-				"Select Namespace",
-				"Select Table Bucket",
+				DefaultLevelLabels().TableBucket,
+				DefaultLevelLabels().Namespace,
+				DefaultLevelLabels().TableBucket,
 			}
 
 			if len(levelsVisited) != len(expectedLabels) {
@@ -1027,7 +1040,7 @@ func TestNavigateNamespacesError(t *testing.T) {
 	lister := NewS3TablesLister(mock)
 	selector := &MockInteractiveSelector{}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 
 	action, err := controller.navigateNamespaces(context.Background())
 	if err == nil {
@@ -1054,7 +1067,7 @@ func TestNavigateNamespacesSelectorError(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 
 	action, err := controller.navigateNamespaces(context.Background())
 	if err == nil {
@@ -1081,7 +1094,7 @@ func TestNavigateNamespacesExit(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 
 	action, err := controller.navigateNamespaces(context.Background())
 	if err != nil {
@@ -1101,7 +1114,7 @@ func TestNavigateTablesError(t *testing.T) {
 	lister := NewS3TablesLister(mock)
 	selector := &MockInteractiveSelector{}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "test_ns")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", Namespace: "test_ns"})
 
 	action, err := controller.navigateTables(context.Background())
 	if err == nil {
@@ -1128,7 +1141,7 @@ func TestNavigateTablesSelectorError(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "test_ns")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", Namespace: "test_ns"})
 
 	action, err := controller.navigateTables(context.Background())
 	if err == nil {
@@ -1155,7 +1168,7 @@ func TestNavigateTablesExit(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "test_ns")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", Namespace: "test_ns"})
 
 	action, err := controller.navigateTables(context.Background())
 	if err != nil {
@@ -1193,7 +1206,7 @@ func TestNavigateFromNamespaceLevel(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket"})
 
 	err := controller.Navigate(context.Background(), LevelNamespace)
 	if err != nil {
@@ -1221,7 +1234,7 @@ func TestNavigateFromTableLevel(t *testing.T) {
 		},
 	}
 	controller := NewNavigationController(lister, selector)
-	controller.SetInitialState("test-bucket", "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", "test_ns")
+	controller.SetInitialState(ResourcePath{Bucket: "test-bucket", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/test-bucket", Namespace: "test_ns"})
 
 	err := controller.Navigate(context.Background(), LevelTable)
 	if err != nil {
@@ -0,0 +1,838 @@
+package s3tables
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// newHierarchyMock returns a mock with two buckets, two namespaces and two tables
+func newHierarchyMock() *PaginatedMockS3TablesAPI {
+	now := time.Now()
+	return &PaginatedMockS3TablesAPI{
+		TableBuckets: []types.TableBucketSummary{
+			{Name: aws.String("bucket-a"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-a"), CreatedAt: aws.Time(now)},
+			{Name: aws.String("bucket-b"), Arn: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"), CreatedAt: aws.Time(now)},
+		},
+		Namespaces: []types.NamespaceSummary{
+			{Namespace: []string{"ns_a"}, CreatedAt: aws.Time(now)},
+			{Namespace: []string{"ns_b"}, CreatedAt: aws.Time(now)},
+		},
+		Tables: []types.TableSummary{
+			{Name: aws.String("tbl_a"), TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/a"), Namespace: []string{"ns_b"}, CreatedAt: aws.Time(now), Type: types.TableTypeCustomer},
+			{Name: aws.String("tbl_b"), TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/b"), Namespace: []string{"ns_b"}, CreatedAt: aws.Time(now), Type: types.TableTypeCustomer},
+		},
+		PageSize: 10,
+	}
+}
+
+// TestNavigateWithTargetsPresent tests that targets auto-select each level without prompting
+func TestNavigateWithTargetsPresent(t *testing.T) {
+	lister := NewS3TablesLister(newHierarchyMock())
+	selector := &MockInteractiveSelector{}
+	controller := NewNavigationController(lister, selector)
+	controller.SetTarget(LevelTableBucket, "bucket-b")
+	controller.SetTarget(LevelNamespace, "ns_b")
+	controller.SetTarget(LevelTable, "tbl_b")
+
+	if err := controller.Navigate(context.Background(), LevelTableBucket); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	if selector.CallCount != 0 {
+		t.Errorf("interactive selector called %d times, want 0", selector.CallCount)
+	}
+
+	state := controller.GetState()
+	if state.Selected.Bucket != "bucket-b" || state.Selected.Namespace != "ns_b" {
+		t.Errorf("state = bucket %q namespace %q, want bucket-b ns_b", state.Selected.Bucket, state.Selected.Namespace)
+	}
+}
+
+// TestNavigateWithTargetsAbsent tests that a missing target fails with a not-found error at each level
+func TestNavigateWithTargetsAbsent(t *testing.T) {
+	tests := []struct {
+		name  string
+		level NavigationLevel
+	}{
+		{name: "bucket", level: LevelTableBucket},
+		{name: "namespace", level: LevelNamespace},
+		{name: "table", level: LevelTable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lister := NewS3TablesLister(newHierarchyMock())
+			controller := NewNavigationController(lister, &MockInteractiveSelector{})
+			controller.SetTarget(tt.level, "missing")
+
+			err := controller.Navigate(context.Background(), LevelTableBucket)
+			if !IsNotFoundError(err) {
+				t.Errorf("Navigate() error = %v, want not found", err)
+			}
+		})
+	}
+}
+
+// TestNavigateTargetIsUsedOnce tests that going back to a targeted level prompts interactively
+func TestNavigateTargetIsUsedOnce(t *testing.T) {
+	lister := NewS3TablesLister(newHierarchyMock())
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			return &SelectionResult{Action: ActionExit}, nil
+		},
+	}
+	controller := NewNavigationController(lister, selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+	controller.SetTarget(LevelNamespace, "ns_b")
+
+	// First pass: namespace auto-selected, table prompt exits
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	if selector.CallCount != 1 || selector.CallHistory[0].Label != "Select Table" {
+		t.Fatalf("selector calls = %+v, want a single table prompt", selector.CallHistory)
+	}
+
+	// Second pass: target consumed, namespace level prompts
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	if selector.CallCount != 2 || selector.CallHistory[1].Label != "Select Namespace" {
+		t.Errorf("selector calls = %+v, want namespace prompt after target is consumed", selector.CallHistory)
+	}
+}
+
+// TestNavigateTableDetailsFromListing tests that the details view uses the listed
+// table, calling GetTable only when the location is shown
+func TestNavigateTableDetailsFromListing(t *testing.T) {
+	for _, showLocation := range []bool{false, true} {
+		mock := newHierarchyMock()
+		getTableCalls := 0
+		mock.OnGetTable = func() { getTableCalls++ }
+
+		controller := NewNavigationController(NewS3TablesLister(mock), &MockInteractiveSelector{})
+		controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+		controller.SetShowLocation(showLocation)
+
+		if err := controller.Navigate(context.Background(), LevelTable); err != nil {
+			t.Fatalf("Navigate() error = %v", err)
+		}
+
+		want := 0
+		if showLocation {
+			want = 1
+		}
+		if getTableCalls != want {
+			t.Errorf("showLocation=%v: GetTable called %d times, want %d", showLocation, getTableCalls, want)
+		}
+		if got := controller.GetState().Selected.Table; got != "tbl_a" {
+			t.Errorf("showLocation=%v: Selected.Table = %q, want tbl_a", showLocation, got)
+		}
+	}
+}
+
+// TestNavigateTableDetailsCached tests that viewing the same table twice with the
+// location shown calls GetTable once, caching the details by table ARN
+func TestNavigateTableDetailsCached(t *testing.T) {
+	mock := newHierarchyMock()
+	getTableCalls := 0
+	mock.OnGetTable = func() { getTableCalls++ }
+
+	controller := NewNavigationController(NewS3TablesLister(mock), &MockInteractiveSelector{})
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+	controller.SetShowLocation(true)
+
+	for i := 0; i < 2; i++ {
+		if err := controller.Navigate(context.Background(), LevelTable); err != nil {
+			t.Fatalf("Navigate() error = %v", err)
+		}
+	}
+
+	if getTableCalls != 1 {
+		t.Errorf("GetTable called %d times, want 1", getTableCalls)
+	}
+	details := controller.GetState().TableDetails
+	if len(details) != 1 {
+		t.Fatalf("TableDetails = %v, want one cached table", details)
+	}
+	for arn, tbl := range details {
+		if tbl.Name != "tbl_a" || tbl.ARN != arn {
+			t.Errorf("TableDetails[%q] = %+v, want tbl_a keyed by its ARN", arn, tbl)
+		}
+	}
+}
+
+// TestInvalidateForRefetchesNamespaces tests that a namespace created mid-session appears after invalidation
+func TestInvalidateForRefetchesNamespaces(t *testing.T) {
+	mock := newHierarchyMock()
+	listCalls := 0
+	mock.OnListNamespaces = func() { listCalls++ }
+
+	var seen [][]string
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			seen = append(seen, items)
+			return &SelectionResult{Action: ActionExit}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	// Simulate an inline create of a namespace in the selected bucket
+	mock.Namespaces = append(mock.Namespaces, types.NamespaceSummary{Namespace: []string{"ns_new"}, CreatedAt: aws.Time(time.Now())})
+
+	// Unrelated invalidations keep the cache
+	controller.InvalidateFor("bucket-a", "")
+	controller.InvalidateFor("bucket-b", "ns_a")
+	if controller.GetState().Namespaces == nil {
+		t.Fatal("namespace cache cleared by an unrelated invalidation")
+	}
+
+	controller.InvalidateFor("bucket-b", "")
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	if listCalls != 2 {
+		t.Errorf("ListNamespaces called %d times, want 2", listCalls)
+	}
+	if got := seen[len(seen)-1]; len(got) != 3 || got[2] != "ns_new" {
+		t.Errorf("namespaces shown after invalidation = %v, want ns_new included", got)
+	}
+}
+
+// TestInvalidateForClearsTables tests that creating a table clears the selected namespace's table caches
+func TestInvalidateForClearsTables(t *testing.T) {
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), &MockInteractiveSelector{})
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+	state := controller.state
+	state.Tables = []TableInfo{{Name: "tbl_a"}}
+	state.TableDetails = map[string]*TableInfo{"arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/tbl_a": {Name: "tbl_a"}}
+
+	controller.InvalidateFor("bucket-b", "ns_b")
+
+	if state.Tables != nil || state.TableDetails != nil {
+		t.Errorf("table caches not cleared: Tables=%v TableDetails=%v", state.Tables, state.TableDetails)
+	}
+}
+
+// TestNavigateMaxItemsPerLevel tests that exceeding the cap warns and asks for a prefix,
+// which is passed to ListTables, before selecting
+func TestNavigateMaxItemsPerLevel(t *testing.T) {
+	mock := newHierarchyMock()
+	mock.Tables = append(mock.Tables,
+		types.TableSummary{Name: aws.String("other_c"), Namespace: []string{"ns_b"}, CreatedAt: aws.Time(time.Now())},
+	)
+	listCalls := 0
+	mock.OnListTables = func() { listCalls++ }
+
+	selector := &MockInteractiveSelector{}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+	controller.SetMaxItemsPerLevel(2)
+	var warnings bytes.Buffer
+	controller.SetWarningOutput(&warnings)
+
+	var prompts []string
+	answers := []string{"zzz", "tbl_"}
+	controller.inputFunc = func(ctx context.Context, label string) (string, error) {
+		prompts = append(prompts, label)
+		answer := answers[0]
+		answers = answers[1:]
+		return answer, nil
+	}
+
+	if err := controller.Navigate(context.Background(), LevelTable); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	if len(prompts) != 2 {
+		t.Errorf("prefix prompted %d times, want 2 (no match, then match)", len(prompts))
+	}
+	if out := warnings.String(); !strings.Contains(out, "items exceed the limit of 2") || !strings.Contains(out, "No items match prefix 'zzz'") {
+		t.Errorf("warning output = %q, want the limit warning and the unmatched prefix", out)
+	}
+	if listCalls != 3 {
+		t.Errorf("ListTables called %d times, want 3 (full listing, then one per prefix)", listCalls)
+	}
+	if selector.CallCount != 1 {
+		t.Fatalf("selector called %d times, want 1", selector.CallCount)
+	}
+	if got := selector.CallHistory[0].Items; len(got) != 2 || got[0] != "tbl_a" || got[1] != "tbl_b" {
+		t.Errorf("selector items = %v, want [tbl_a tbl_b]", got)
+	}
+}
+
+// TestNavigateMaxItemsPerLevelUnderCap tests that no prefix is asked for at or below the cap
+func TestNavigateMaxItemsPerLevelUnderCap(t *testing.T) {
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), &MockInteractiveSelector{})
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+	controller.SetMaxItemsPerLevel(2)
+	controller.inputFunc = func(ctx context.Context, label string) (string, error) {
+		t.Errorf("unexpected prefix prompt %q", label)
+		return "", nil
+	}
+
+	if err := controller.Navigate(context.Background(), LevelTable); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+}
+
+// TestNavigateNamespacesSorted tests that namespaces are presented sorted and keep that order when cached
+func TestNavigateNamespacesSorted(t *testing.T) {
+	tests := []struct {
+		name    string
+		reverse bool
+		want    []string
+	}{
+		{name: "ascending", reverse: false, want: []string{"alpha", "mid", "zeta"}},
+		{name: "reverse", reverse: true, want: []string{"zeta", "mid", "alpha"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newHierarchyMock()
+			mock.Namespaces = []types.NamespaceSummary{
+				{Namespace: []string{"zeta"}},
+				{Namespace: []string{"alpha"}},
+				{Namespace: []string{"mid"}},
+			}
+			listCalls := 0
+			mock.OnListNamespaces = func() { listCalls++ }
+
+			selector := &MockInteractiveSelector{
+				SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+					return &SelectionResult{Action: ActionExit}, nil
+				},
+			}
+			controller := NewNavigationController(NewS3TablesLister(mock), selector)
+			controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+			controller.SetReverseSort(tt.reverse)
+
+			for i := 0; i < 2; i++ {
+				if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+					t.Fatalf("Navigate() error = %v", err)
+				}
+			}
+
+			if listCalls != 1 {
+				t.Errorf("ListNamespaces called %d times, want 1 (cached re-visit)", listCalls)
+			}
+			for i, call := range selector.CallHistory {
+				if !reflect.DeepEqual(call.Items, tt.want) {
+					t.Errorf("visit %d items = %v, want %v", i+1, call.Items, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestNavigatePagedLoadMore tests fetching a second page of tables through the load-more item
+func TestNavigatePagedLoadMore(t *testing.T) {
+	mock := newHierarchyMock()
+	mock.PageSize = 1
+	listCalls := 0
+	mock.OnListTables = func() { listCalls++ }
+
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			if items[len(items)-1] == LoadMoreOption {
+				return &SelectionResult{Selected: LoadMoreOption, Action: ActionSelect}, nil
+			}
+			return &SelectionResult{Selected: "tbl_b", Action: ActionSelect}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b", Namespace: "ns_b"})
+	controller.SetPaged(true)
+
+	if err := controller.Navigate(context.Background(), LevelTable); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	if listCalls != 2 {
+		t.Errorf("ListTables called %d times, want 2 (one per page)", listCalls)
+	}
+	want := [][]string{{"tbl_a", LoadMoreOption}, {"tbl_a", "tbl_b"}}
+	if len(selector.CallHistory) != len(want) {
+		t.Fatalf("selector called %d times, want %d", len(selector.CallHistory), len(want))
+	}
+	for i, call := range selector.CallHistory {
+		if !reflect.DeepEqual(call.Items, want[i]) {
+			t.Errorf("prompt %d items = %v, want %v", i+1, call.Items, want[i])
+		}
+	}
+	if token := controller.GetState().NextTokens[LevelTable]; token != "" {
+		t.Errorf("NextTokens[LevelTable] = %q, want empty after the last page", token)
+	}
+}
+
+// TestNavigatePagedAfterToken tests resuming the starting level from a continuation token
+func TestNavigatePagedAfterToken(t *testing.T) {
+	mock := newHierarchyMock()
+	mock.PageSize = 1
+
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			return &SelectionResult{Action: ActionExit}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+	controller.SetAfterToken(LevelNamespace, "1")
+
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	if got, want := selector.CallHistory[0].Items, []string{"ns_b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+// TestNextPageGuards tests that skipping empty pages stops on a cancelled context
+// and on a continuation token that repeats
+func TestNextPageGuards(t *testing.T) {
+	t.Run("repeated token", func(t *testing.T) {
+		pages := map[string]string{"": "p2", "p2": "p3", "p3": "p2"} // 空ページの継続トークン
+		calls := 0
+		_, _, err := nextPage(context.Background(), "", func(token string) ([]string, string, error) {
+			calls++
+			return nil, pages[token], nil
+		})
+		if err == nil {
+			t.Fatal("nextPage() error = nil, want a repeated token error")
+		}
+		if calls != 3 {
+			t.Errorf("fetch calls = %d, want 3", calls)
+		}
+	})
+
+	t.Run("starting token returned again", func(t *testing.T) {
+		_, _, err := nextPage(context.Background(), "p1", func(token string) ([]string, string, error) {
+			return nil, "p1", nil
+		})
+		if err == nil {
+			t.Fatal("nextPage() error = nil, want a repeated token error")
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		_, _, err := nextPage(ctx, "", func(token string) ([]string, string, error) {
+			calls++
+			cancel()
+			return nil, fmt.Sprintf("p%d", calls), nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("nextPage() error = %v, want context.Canceled", err)
+		}
+		if calls != 1 {
+			t.Errorf("fetch calls = %d, want 1", calls)
+		}
+	})
+}
+
+// TestGetStateConcurrentWithNavigation reads the state while navigation steps
+// update it; run with -race to detect unsynchronized access
+func TestGetStateConcurrentWithNavigation(t *testing.T) {
+	steps := []string{"ns_a", BackOption, "ns_b", "tbl_a"}
+	step := 0
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			if step == len(steps) {
+				return &SelectionResult{Action: ActionExit}, nil
+			}
+			next := steps[step]
+			step++
+			if next == BackOption {
+				return &SelectionResult{Action: ActionBack}, nil
+			}
+			return &SelectionResult{Selected: next, Action: ActionSelect}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					state := controller.GetState()
+					_ = len(state.Namespaces) + len(state.Tables)
+				}
+			}
+		}()
+	}
+
+	err := controller.Navigate(context.Background(), LevelNamespace)
+	close(done)
+	wg.Wait()
+
+	if err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	state := controller.GetState()
+	if state.Selected.Bucket != "bucket-b" || state.Selected.Namespace != "ns_b" {
+		t.Errorf("state = bucket %q namespace %q, want bucket-b ns_b", state.Selected.Bucket, state.Selected.Namespace)
+	}
+}
+
+// TestNavigateNoBackOption tests that the back entry is hidden, that ESC (ActionBack)
+// still goes back and that Ctrl+C (ActionExit) exits from a sub-level
+func TestNavigateNoBackOption(t *testing.T) {
+	var labels []string
+	var subLevelShowBack []bool
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			labels = append(labels, label)
+			switch len(labels) {
+			case 1:
+				return &SelectionResult{Selected: "bucket-b", Action: ActionSelect}, nil
+			case 2:
+				subLevelShowBack = append(subLevelShowBack, showBack)
+				for _, item := range items {
+					if item == BackOption {
+						t.Errorf("namespace items contain %q", BackOption)
+					}
+				}
+				// ESC at the namespace level
+				return &SelectionResult{Action: ActionBack}, nil
+			case 3:
+				return &SelectionResult{Selected: "bucket-a", Action: ActionSelect}, nil
+			default:
+				// Ctrl+C at the namespace level
+				return &SelectionResult{Action: ActionExit}, nil
+			}
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+	controller.SetNoBackOption(true)
+
+	if err := controller.Navigate(context.Background(), LevelTableBucket); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	want := []string{"Select Table Bucket", "Select Namespace", "Select Table Bucket", "Select Namespace"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("prompts = %v, want %v (ESC should go back, Ctrl+C should exit)", labels, want)
+	}
+	if !reflect.DeepEqual(subLevelShowBack, []bool{false}) {
+		t.Errorf("showBack at namespace level = %v, want [false]", subLevelShowBack)
+	}
+}
+
+// TestNavigatePromptTimeoutEnds tests that a timed-out prompt at a sub-level ends
+// the navigation with ErrPromptTimeout instead of going back
+func TestNavigatePromptTimeoutEnds(t *testing.T) {
+	var prompts int
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			prompts++
+			if prompts == 1 {
+				return &SelectionResult{Selected: "bucket-b", Action: ActionSelect}, nil
+			}
+			return &SelectionResult{Action: ActionExit}, ErrPromptTimeout
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+	controller.SetNoBackOption(true)
+
+	err := controller.Navigate(context.Background(), LevelTableBucket)
+	if !errors.Is(err, ErrPromptTimeout) {
+		t.Errorf("Navigate() error = %v, want ErrPromptTimeout", err)
+	}
+	if prompts != 2 {
+		t.Errorf("prompts = %d, want 2", prompts)
+	}
+}
+
+// TestNavigateToTableARN tests that a table ARN shows the details without prompting
+// and leaves the state as if the bucket, namespace and table had been selected
+func TestNavigateToTableARN(t *testing.T) {
+	mock := newHierarchyMock()
+	var getTableCalls, listCalls int
+	mock.OnGetTable = func() { getTableCalls++ }
+	mock.OnListNamespaces = func() { listCalls++ }
+	lister := NewS3TablesLister(mock)
+	selector := &MockInteractiveSelector{}
+	controller := NewNavigationController(lister, selector)
+	var warnings bytes.Buffer
+	controller.SetWarningOutput(&warnings)
+	controller.SetExpectedLocation("ap-northeast-1", "123456789012")
+
+	err := controller.NavigateTo(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/b")
+	if err != nil {
+		t.Fatalf("NavigateTo() error = %v", err)
+	}
+	if selector.CallCount != 0 {
+		t.Errorf("interactive selector called %d times, want 0", selector.CallCount)
+	}
+
+	state := controller.GetState()
+	if state.Level != LevelTable || state.Selected.Bucket != "bucket-b" || state.Selected.Namespace != "ns_b" {
+		t.Errorf("state = level %v bucket %q namespace %q, want Table bucket-b ns_b", state.Level, state.Selected.Bucket, state.Selected.Namespace)
+	}
+	if state.Selected.BucketARN != "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b" {
+		t.Errorf("Selected.BucketARN = %q", state.Selected.BucketARN)
+	}
+	if state.Selected.Table != "tbl_b" {
+		t.Errorf("Selected.Table = %q, want tbl_b", state.Selected.Table)
+	}
+	if got := warnings.String(); !strings.Contains(got, "region 'us-east-1' differs from the configured region 'ap-northeast-1'") || strings.Contains(got, "account") {
+		t.Errorf("warnings = %q, want only a region mismatch", got)
+	}
+	if getTableCalls != 1 || listCalls != 0 {
+		t.Errorf("GetTable calls = %d, ListNamespaces calls = %d, want a single GetTable", getTableCalls, listCalls)
+	}
+}
+
+// TestNavigateToRedactsWarnings tests that the location warnings mask account IDs when ARNs are redacted
+func TestNavigateToRedactsWarnings(t *testing.T) {
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), &MockInteractiveSelector{})
+	var warnings bytes.Buffer
+	controller.SetWarningOutput(&warnings)
+	controller.SetExpectedLocation("ap-northeast-1", "210987654321")
+	controller.SetRedactARNs(true)
+
+	if err := controller.NavigateTo(context.Background(), "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/b"); err != nil {
+		t.Fatalf("NavigateTo() error = %v", err)
+	}
+	got := warnings.String()
+	if strings.Contains(got, "123456789012") || strings.Contains(got, "210987654321") {
+		t.Errorf("warnings = %q, want account IDs redacted", got)
+	}
+	if !strings.Contains(got, "region 'us-east-1'") || !strings.Contains(got, "account '"+redactedAccount+"'") {
+		t.Errorf("warnings = %q, want region and account mismatches", got)
+	}
+}
+
+// TestNavigateToInvalidARN tests that malformed and unknown ARNs fail before prompting
+func TestNavigateToInvalidARN(t *testing.T) {
+	tests := []struct {
+		name         string
+		arn          string
+		wantNotFound bool
+	}{
+		{name: "malformed", arn: "not-an-arn"},
+		{name: "unsupported resource", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/namespace/ns_b"},
+		{name: "unknown table", arn: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/missing", wantNotFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := &MockInteractiveSelector{}
+			controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+
+			err := controller.NavigateTo(context.Background(), tt.arn)
+			if err == nil {
+				t.Fatal("NavigateTo() error = nil, want error")
+			}
+			if got := IsNotFoundError(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFoundError(%v) = %v, want %v", err, got, tt.wantNotFound)
+			}
+			if selector.CallCount != 0 {
+				t.Errorf("interactive selector called %d times, want 0", selector.CallCount)
+			}
+		})
+	}
+}
+
+// TestAvailableActions tests the actions offered at each level, with and without the back option
+func TestAvailableActions(t *testing.T) {
+	tests := []struct {
+		level  NavigationLevel
+		noBack bool
+		want   []NavigationAction
+	}{
+		{LevelTableBucket, false, []NavigationAction{ActionSelect, ActionExit}},
+		{LevelNamespace, false, []NavigationAction{ActionSelect, ActionBack, ActionExit}},
+		{LevelTable, false, []NavigationAction{ActionSelect, ActionBack, ActionExit}},
+		{LevelTableBucket, true, []NavigationAction{ActionSelect, ActionExit}},
+		{LevelNamespace, true, []NavigationAction{ActionSelect, ActionBack, ActionExit}},
+		{LevelTable, true, []NavigationAction{ActionSelect, ActionBack, ActionExit}},
+	}
+
+	for _, tt := range tests {
+		controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), &MockInteractiveSelector{})
+		controller.SetNoBackOption(tt.noBack)
+		controller.setLevel(tt.level)
+		if got := controller.AvailableActions(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("AvailableActions() at %v (noBack=%v) = %v, want %v", tt.level, tt.noBack, got, tt.want)
+		}
+	}
+}
+
+// TestNavigateCancelledLeavesNoPromptGoroutine tests that cancelling the context
+// while a prompt is open ends the prompt, and that no goroutine of it outlives Navigate
+func TestNavigateCancelledLeavesNoPromptGoroutine(t *testing.T) {
+	keys := idleKeys(t)
+	keys.next() // the terminal reader lives as long as the process, so start it before counting
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	selector := &FilterablePromptSelector{
+		keys: keys,
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			cancel()
+			return readUntilClosed(prompt)
+		},
+	}
+
+	before := runtime.NumGoroutine()
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+	if err := controller.Navigate(ctx, LevelTableBucket); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Navigate() error = %v, want context.Canceled", err)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutines = %d after Navigate, want at most %d", after, before)
+	}
+}
+
+// TestNavigateShowAgeResolvesAnnotatedNames tests that annotated items map back to
+// the namespace and table they were built from
+func TestNavigateShowAgeResolvesAnnotatedNames(t *testing.T) {
+	mock := newHierarchyMock()
+	SetClock(newFakeClock(time.Now().Add(3*24*time.Hour + time.Hour)))
+	defer SetClock(nil)
+
+	var prompts [][]string
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			prompts = append(prompts, items)
+			switch label {
+			case "Select Namespace":
+				return &SelectionResult{Selected: "ns_b (3d ago)", Action: ActionSelect}, nil
+			case "Select Table":
+				if len(prompts) == 2 {
+					return &SelectionResult{Selected: "tbl_b (3d ago)", Action: ActionSelect}, nil
+				}
+			}
+			return &SelectionResult{Action: ActionExit}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b"})
+	controller.SetShowAge(true)
+
+	if err := controller.Navigate(context.Background(), LevelNamespace); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	if len(prompts) < 2 || !slices.Contains(prompts[0], "ns_a (3d ago)") || !slices.Contains(prompts[1], "tbl_a (3d ago)") {
+		t.Fatalf("prompts = %v, want annotated namespace and table items", prompts)
+	}
+
+	state := controller.GetState()
+	if state.Selected.Namespace != "ns_b" {
+		t.Errorf("Selected.Namespace = %q, want ns_b", state.Selected.Namespace)
+	}
+	if state.Selected.Table != "tbl_b" {
+		t.Errorf("Selected.Table = %q, want tbl_b resolved from its annotated name", state.Selected.Table)
+	}
+}
+
+// TestNavigateCustomLevelLabels tests that configured labels reach SelectWithFilter
+// and that unset labels keep the default
+func TestNavigateCustomLevelLabels(t *testing.T) {
+	var labels []string
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			labels = append(labels, label)
+			if len(labels) < 3 {
+				return &SelectionResult{Selected: items[len(items)-1], Action: ActionSelect}, nil
+			}
+			return &SelectionResult{Action: ActionExit}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), selector)
+	controller.SetLevelLabels(LevelLabels{TableBucket: "バケットを選択", Table: "テーブルを選択"})
+
+	if err := controller.Navigate(context.Background(), LevelTableBucket); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	want := []string{"バケットを選択", "Select Namespace", "テーブルを選択"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("labels = %v, want %v", labels, want)
+	}
+}
+
+// newSingleItemMock returns a hierarchy with one table bucket, namespace and table
+func newSingleItemMock() *PaginatedMockS3TablesAPI {
+	mock := newHierarchyMock()
+	mock.TableBuckets = mock.TableBuckets[1:]
+	mock.Namespaces = mock.Namespaces[1:]
+	mock.Tables = mock.Tables[1:]
+	return mock
+}
+
+// TestNavigateAutoSingle tests that levels with a single item are selected without prompting
+func TestNavigateAutoSingle(t *testing.T) {
+	selector := &MockInteractiveSelector{}
+	controller := NewNavigationController(NewS3TablesLister(newSingleItemMock()), selector)
+	controller.SetAutoSingle(true)
+
+	if err := controller.Navigate(context.Background(), LevelTableBucket); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+	if selector.CallCount != 0 {
+		t.Errorf("selector called %d times, want 0", selector.CallCount)
+	}
+	state := controller.GetState()
+	if state.Selected.Bucket != "bucket-b" || state.Selected.Namespace != "ns_b" {
+		t.Errorf("Selected = %+v, want bucket-b/ns_b", state.Selected)
+	}
+	if state.Selected.Table != "tbl_b" {
+		t.Errorf("Selected.Table = %q, want tbl_b shown", state.Selected.Table)
+	}
+}
+
+// TestNavigateAutoSingleBack tests that going back prompts at single-item levels, so
+// that back navigation can reach the top and exit
+func TestNavigateAutoSingleBack(t *testing.T) {
+	mock := newSingleItemMock()
+	mock.Tables = append(mock.Tables, types.TableSummary{Name: aws.String("tbl_c"), TableARN: aws.String("arn:aws:s3tables:us-east-1:123456789012:bucket/bucket-b/table/c"), Namespace: []string{"ns_b"}, CreatedAt: aws.Time(time.Now()), Type: types.TableTypeCustomer})
+
+	selector := &MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			return &SelectionResult{Action: ActionBack}, nil
+		},
+	}
+	controller := NewNavigationController(NewS3TablesLister(mock), selector)
+	controller.SetAutoSingle(true)
+
+	if err := controller.Navigate(context.Background(), LevelTableBucket); err != nil {
+		t.Fatalf("Navigate() error = %v", err)
+	}
+
+	var labels []string
+	for _, call := range selector.CallHistory {
+		labels = append(labels, call.Label)
+	}
+	want := []string{"Select Table", "Select Namespace", "Select Table Bucket"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("prompts = %v, want %v", labels, want)
+	}
+}
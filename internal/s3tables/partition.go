@@ -0,0 +1,208 @@
+package s3tables
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// PartitionField is one field of an Iceberg partition spec given as col[:transform]
+type PartitionField struct {
+	Column    string
+	Transform string // identity, bucket, truncate, year, month, day, hour
+	Width     int    // bucket/truncate の N（その他の変換では 0）
+}
+
+// partitionTransforms lists the supported transforms; bucket and truncate take a width [N]
+var partitionTransforms = []string{"identity", "bucket", "truncate", "year", "month", "day", "hour"}
+
+// partitionTransformPattern splits a transform into its name and optional [N] width
+var partitionTransformPattern = regexp.MustCompile(`^([a-z]+)(?:\[([0-9]+)\])?$`)
+
+// TransformString returns the transform in Iceberg notation, e.g. "bucket[16]"
+func (f PartitionField) TransformString() string {
+	if f.Transform == "bucket" || f.Transform == "truncate" {
+		return fmt.Sprintf("%s[%d]", f.Transform, f.Width)
+	}
+	return f.Transform
+}
+
+// ParsePartitionField parses col[:transform]. The transform defaults to identity;
+// bucket and truncate require a positive width, e.g. "id:bucket[16]".
+func ParsePartitionField(s string) (PartitionField, error) {
+	column, transform, hasTransform := strings.Cut(strings.TrimSpace(s), ":")
+	column = strings.TrimSpace(column)
+	if column == "" {
+		return PartitionField{}, &ValidationError{Field: "partition", Message: fmt.Sprintf("'%s' has no column name", s)}
+	}
+	if !hasTransform {
+		return PartitionField{Column: column, Transform: "identity"}, nil
+	}
+
+	m := partitionTransformPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(transform)))
+	if m == nil || !isPartitionTransform(m[1]) {
+		return PartitionField{}, &ValidationError{
+			Field:   "partition",
+			Message: fmt.Sprintf("unknown transform '%s' for column '%s' (available: identity, bucket[N], truncate[N], year, month, day, hour)", transform, column),
+		}
+	}
+
+	field := PartitionField{Column: column, Transform: m[1]}
+	needsWidth := field.Transform == "bucket" || field.Transform == "truncate"
+	switch {
+	case needsWidth && m[2] == "":
+		return PartitionField{}, &ValidationError{Field: "partition", Message: fmt.Sprintf("transform '%s' for column '%s' requires a width, e.g. %s[16]", field.Transform, column, field.Transform)}
+	case !needsWidth && m[2] != "":
+		return PartitionField{}, &ValidationError{Field: "partition", Message: fmt.Sprintf("transform '%s' for column '%s' takes no width", field.Transform, column)}
+	case needsWidth:
+		width, err := strconv.Atoi(m[2])
+		if err != nil || width <= 0 {
+			return PartitionField{}, &ValidationError{Field: "partition", Message: fmt.Sprintf("width of '%s' for column '%s' must be a positive integer", transform, column)}
+		}
+		field.Width = width
+	}
+	return field, nil
+}
+
+// ParsePartitionSpec parses every col[:transform] of a partition spec in order.
+// The same transform may not be applied to a column twice.
+func ParsePartitionSpec(specs []string) ([]PartitionField, error) {
+	fields := make([]PartitionField, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		field, err := ParsePartitionField(spec)
+		if err != nil {
+			return nil, err
+		}
+		key := field.Column + ":" + field.TransformString()
+		if seen[key] {
+			return nil, &ValidationError{Field: "partition", Message: fmt.Sprintf("duplicate partition field '%s'", key)}
+		}
+		seen[key] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// isPartitionTransform reports whether name is a supported transform
+func isPartitionTransform(name string) bool {
+	return slices.Contains(partitionTransforms, name)
+}
+
+// SchemaColumn is one column of an Iceberg table schema given as name:type
+type SchemaColumn struct {
+	Name string
+	Type string // Iceberg のプリミティブ型（例: long、string、timestamp、decimal(10,2)）
+}
+
+// schemaTypePattern matches the Iceberg primitive types accepted for a column
+var schemaTypePattern = regexp.MustCompile(`^(boolean|int|long|float|double|date|time|timestamp|timestamptz|string|uuid|binary|decimal\([0-9]+,[0-9]+\)|fixed\[[0-9]+\])$`)
+
+// ParseSchemaColumns parses every name:type column of a table schema in order.
+// Types are Iceberg primitive types; a column name may appear only once.
+func ParseSchemaColumns(specs []string) ([]SchemaColumn, error) {
+	columns := make([]SchemaColumn, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		name, typ, ok := strings.Cut(spec, ":")
+		name = strings.TrimSpace(name)
+		typ = strings.ToLower(strings.ReplaceAll(typ, " ", ""))
+		switch {
+		case !ok || name == "":
+			return nil, &ValidationError{Field: "column", Message: fmt.Sprintf("'%s' is not name:type", spec)}
+		case !schemaTypePattern.MatchString(typ):
+			return nil, &ValidationError{Field: "column", Message: fmt.Sprintf("unknown type '%s' for column '%s' (available: boolean, int, long, float, double, decimal(P,S), date, time, timestamp, timestamptz, string, uuid, fixed[N], binary)", typ, name)}
+		case seen[name]:
+			return nil, &ValidationError{Field: "column", Message: fmt.Sprintf("duplicate column '%s'", name)}
+		}
+		seen[name] = true
+		columns = append(columns, SchemaColumn{Name: name, Type: typ})
+	}
+	return columns, nil
+}
+
+// partitionFieldName names a partition field after its column and transform, e.g.
+// "ts_day" or "id_bucket_16"; the width keeps two buckets of one column apart
+func partitionFieldName(field PartitionField) string {
+	switch field.Transform {
+	case "identity":
+		return field.Column
+	case "bucket":
+		return fmt.Sprintf("%s_bucket_%d", field.Column, field.Width)
+	case "truncate":
+		return fmt.Sprintf("%s_trunc_%d", field.Column, field.Width)
+	default:
+		return field.Column + "_" + field.Transform
+	}
+}
+
+// checkPartitionSource reports a transform that cannot apply to the column type
+func checkPartitionSource(field PartitionField, column SchemaColumn) error {
+	var allowed []string
+	switch field.Transform {
+	case "year", "month", "day":
+		allowed = []string{"date", "timestamp", "timestamptz"}
+	case "hour":
+		allowed = []string{"timestamp", "timestamptz"}
+	default:
+		return nil
+	}
+	if slices.Contains(allowed, column.Type) {
+		return nil
+	}
+	return &ValidationError{
+		Field:   "partition",
+		Message: fmt.Sprintf("transform '%s' cannot be applied to column '%s' of type %s (requires %s)", field.Transform, column.Name, column.Type, strings.Join(allowed, ", ")),
+	}
+}
+
+// BuildIcebergMetadata assembles the CreateTable metadata of a schema and its
+// partition spec. Columns get field IDs 1..N in order and partition fields refer
+// to them by column name. It returns nil when neither is given; a partition spec
+// needs a schema that defines its columns.
+func BuildIcebergMetadata(columns []SchemaColumn, partition []PartitionField) (types.TableMetadata, error) {
+	if len(columns) == 0 {
+		if len(partition) > 0 {
+			return nil, &ValidationError{Field: "partition", Message: "a partition spec requires a table schema with the partition columns"}
+		}
+		return nil, nil
+	}
+
+	metadata := types.IcebergMetadata{Schema: &types.IcebergSchema{}}
+	ids := make(map[string]int32, len(columns))
+	for i, column := range columns {
+		id := int32(i + 1)
+		ids[column.Name] = id
+		metadata.Schema.Fields = append(metadata.Schema.Fields, types.SchemaField{
+			Id:   aws.Int32(id),
+			Name: aws.String(column.Name),
+			Type: aws.String(column.Type),
+		})
+	}
+
+	if len(partition) > 0 {
+		metadata.PartitionSpec = &types.IcebergPartitionSpec{}
+		for i, field := range partition {
+			id, ok := ids[field.Column]
+			if !ok {
+				return nil, &ValidationError{Field: "partition", Message: fmt.Sprintf("column '%s' is not in the table schema", field.Column)}
+			}
+			if err := checkPartitionSource(field, columns[id-1]); err != nil {
+				return nil, err
+			}
+			// Iceberg の慣例に従い、パーティションフィールド ID は 1000 から振る
+			metadata.PartitionSpec.Fields = append(metadata.PartitionSpec.Fields, types.IcebergPartitionField{
+				FieldId:   aws.Int32(int32(1000 + i)),
+				Name:      aws.String(partitionFieldName(field)),
+				SourceId:  aws.Int32(id),
+				Transform: aws.String(field.TransformString()),
+			})
+		}
+	}
+	return &types.TableMetadataMemberIceberg{Value: metadata}, nil
+}
@@ -0,0 +1,141 @@
+package s3tables
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// TestParsePartitionField tests parsing col[:transform] with and without widths
+func TestParsePartitionField(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      PartitionField
+		transform string
+	}{
+		{"region", PartitionField{Column: "region", Transform: "identity"}, "identity"},
+		{"region:identity", PartitionField{Column: "region", Transform: "identity"}, "identity"},
+		{"id:bucket[16]", PartitionField{Column: "id", Transform: "bucket", Width: 16}, "bucket[16]"},
+		{"name:truncate[4]", PartitionField{Column: "name", Transform: "truncate", Width: 4}, "truncate[4]"},
+		{"ts:Day", PartitionField{Column: "ts", Transform: "day"}, "day"},
+		{" ts : hour ", PartitionField{Column: "ts", Transform: "hour"}, "hour"},
+	}
+	for _, tt := range tests {
+		got, err := ParsePartitionField(tt.input)
+		if err != nil {
+			t.Errorf("ParsePartitionField(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got != tt.want || got.TransformString() != tt.transform {
+			t.Errorf("ParsePartitionField(%q) = %+v (%s), want %+v (%s)", tt.input, got, got.TransformString(), tt.want, tt.transform)
+		}
+	}
+}
+
+// TestParsePartitionFieldInvalid tests that unknown transforms and bad widths are rejected
+func TestParsePartitionFieldInvalid(t *testing.T) {
+	for _, input := range []string{"", ":day", "ts:week", "id:bucket", "id:bucket[0]", "ts:day[3]", "id:bucket[x]"} {
+		_, err := ParsePartitionField(input)
+		var valErr *ValidationError
+		if !errors.As(err, &valErr) {
+			t.Errorf("ParsePartitionField(%q) error = %v, want ValidationError", input, err)
+		}
+	}
+}
+
+// TestParsePartitionSpec tests parsing several fields in order and rejecting duplicates
+func TestParsePartitionSpec(t *testing.T) {
+	fields, err := ParsePartitionSpec([]string{"ts:day", "id:bucket[8]", "ts:hour"})
+	if err != nil {
+		t.Fatalf("ParsePartitionSpec() error = %v", err)
+	}
+	want := []PartitionField{
+		{Column: "ts", Transform: "day"},
+		{Column: "id", Transform: "bucket", Width: 8},
+		{Column: "ts", Transform: "hour"},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("ParsePartitionSpec() = %+v, want %+v", fields, want)
+	}
+
+	if _, err := ParsePartitionSpec([]string{"ts:day", "ts:DAY"}); err == nil {
+		t.Error("ParsePartitionSpec() with a duplicate field error = nil, want error")
+	}
+}
+
+// TestParseSchemaColumns tests parsing name:type columns and rejecting unknown types and duplicates
+func TestParseSchemaColumns(t *testing.T) {
+	columns, err := ParseSchemaColumns([]string{"id:long", "ts:TIMESTAMP", "price:decimal(10, 2)"})
+	if err != nil {
+		t.Fatalf("ParseSchemaColumns() error = %v", err)
+	}
+	want := []SchemaColumn{{Name: "id", Type: "long"}, {Name: "ts", Type: "timestamp"}, {Name: "price", Type: "decimal(10,2)"}}
+	if !reflect.DeepEqual(columns, want) {
+		t.Errorf("ParseSchemaColumns() = %+v, want %+v", columns, want)
+	}
+
+	for _, specs := range [][]string{{"id"}, {":long"}, {"id:varchar"}, {"id:long", "id:string"}} {
+		var valErr *ValidationError
+		if _, err := ParseSchemaColumns(specs); !errors.As(err, &valErr) {
+			t.Errorf("ParseSchemaColumns(%q) error = %v, want ValidationError", specs, err)
+		}
+	}
+}
+
+// TestBuildIcebergMetadata tests assembling the schema and partition spec of CreateTable
+func TestBuildIcebergMetadata(t *testing.T) {
+	columns := []SchemaColumn{{Name: "id", Type: "long"}, {Name: "ts", Type: "timestamp"}}
+	partition := []PartitionField{{Column: "ts", Transform: "day"}, {Column: "id", Transform: "bucket", Width: 16}}
+
+	metadata, err := BuildIcebergMetadata(columns, partition)
+	if err != nil {
+		t.Fatalf("BuildIcebergMetadata() error = %v", err)
+	}
+	iceberg, ok := metadata.(*types.TableMetadataMemberIceberg)
+	if !ok {
+		t.Fatalf("BuildIcebergMetadata() = %T, want *types.TableMetadataMemberIceberg", metadata)
+	}
+	var schema []string
+	for _, f := range iceberg.Value.Schema.Fields {
+		schema = append(schema, fmt.Sprintf("%d:%s:%s", aws.ToInt32(f.Id), aws.ToString(f.Name), aws.ToString(f.Type)))
+	}
+	if want := []string{"1:id:long", "2:ts:timestamp"}; !reflect.DeepEqual(schema, want) {
+		t.Errorf("schema = %v, want %v", schema, want)
+	}
+	var spec []string
+	for _, f := range iceberg.Value.PartitionSpec.Fields {
+		spec = append(spec, fmt.Sprintf("%d:%s:%d:%s", aws.ToInt32(f.FieldId), aws.ToString(f.Name), aws.ToInt32(f.SourceId), aws.ToString(f.Transform)))
+	}
+	if want := []string{"1000:ts_day:2:day", "1001:id_bucket_16:1:bucket[16]"}; !reflect.DeepEqual(spec, want) {
+		t.Errorf("partition spec = %v, want %v", spec, want)
+	}
+
+	if metadata, err := BuildIcebergMetadata(nil, nil); metadata != nil || err != nil {
+		t.Errorf("BuildIcebergMetadata(nil, nil) = %v, %v, want no metadata", metadata, err)
+	}
+}
+
+// TestBuildIcebergMetadataInvalid tests that partition fields must name a schema column of a suitable type
+func TestBuildIcebergMetadataInvalid(t *testing.T) {
+	columns := []SchemaColumn{{Name: "id", Type: "long"}, {Name: "d", Type: "date"}}
+	tests := []struct {
+		name      string
+		columns   []SchemaColumn
+		partition []PartitionField
+	}{
+		{name: "no schema", partition: []PartitionField{{Column: "id", Transform: "identity"}}},
+		{name: "unknown column", columns: columns, partition: []PartitionField{{Column: "ts", Transform: "day"}}},
+		{name: "day of a long", columns: columns, partition: []PartitionField{{Column: "id", Transform: "day"}}},
+		{name: "hour of a date", columns: columns, partition: []PartitionField{{Column: "d", Transform: "hour"}}},
+	}
+	for _, tt := range tests {
+		var valErr *ValidationError
+		if _, err := BuildIcebergMetadata(tt.columns, tt.partition); !errors.As(err, &valErr) {
+			t.Errorf("%s: BuildIcebergMetadata() error = %v, want ValidationError", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package s3tables
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// keyReader hands the input of a terminal to prompts. A blocked read of the
+// terminal cannot be interrupted, so a single goroutine reads it for the life of
+// the process and passes each chunk to the prompt that asks next. Prompts read
+// through a promptInput, which can be closed at any time.
+type keyReader struct {
+	r      io.Reader
+	start  sync.Once
+	chunks chan []byte
+	err    error // 読み取りを終えたエラー（chunks を閉じる前に設定）
+}
+
+// stdinKeys is the keyReader of os.Stdin shared by every prompt. It starts
+// reading on the first prompt, so commands that never prompt keep stdin to themselves.
+var stdinKeys = newKeyReader(os.Stdin)
+
+// newKeyReader creates a keyReader of r
+func newKeyReader(r io.Reader) *keyReader {
+	return &keyReader{r: r, chunks: make(chan []byte)}
+}
+
+// next returns the channel of input chunks, starting the reader on first use.
+// The channel is closed when r fails or reaches EOF.
+func (k *keyReader) next() <-chan []byte {
+	k.start.Do(func() {
+		go func() {
+			defer close(k.chunks)
+			for {
+				buf := make([]byte, 256)
+				n, err := k.r.Read(buf)
+				if n > 0 {
+					k.chunks <- buf[:n]
+				}
+				if err != nil {
+					k.err = err
+					return
+				}
+			}
+		}()
+	})
+	return k.chunks
+}
+
+// promptInput is the stdin of a single prompt. Closing it makes pending and later
+// reads return io.EOF, which ends the promptui prompt and restores the terminal.
+type promptInput struct {
+	keys    *keyReader
+	done    chan struct{}
+	close   sync.Once
+	pending []byte // 受け取り済みでまだ読まれていない入力（Read からのみ使用）
+
+	// clock and lastKey measure the time without input (set by watch before the prompt runs)
+	clock   Clock
+	lastKey atomic.Int64 // 最後に入力を受け取った時刻（UnixNano）
+
+	// keyAction maps a chunk of input to an action that ends the prompt (nil maps none)
+	keyAction func(chunk []byte) (NavigationAction, bool)
+	mu        sync.Mutex
+	action    NavigationAction // keyAction が返したアクション（ended が true の場合のみ有効）
+	ended     bool
+}
+
+// newPromptInput creates the input of a prompt reading from keys (nil reads os.Stdin)
+func newPromptInput(keys *keyReader) *promptInput {
+	if keys == nil {
+		keys = stdinKeys
+	}
+	return &promptInput{keys: keys, done: make(chan struct{})}
+}
+
+// Read returns the next input of the terminal, or io.EOF once the input is closed
+func (in *promptInput) Read(b []byte) (int, error) {
+	if len(in.pending) == 0 {
+		select {
+		case <-in.done:
+			return 0, io.EOF
+		default:
+		}
+		select {
+		case <-in.done:
+			return 0, io.EOF
+		case chunk, ok := <-in.keys.next():
+			if !ok {
+				if in.keys.err != nil && in.keys.err != io.EOF {
+					return 0, in.keys.err
+				}
+				return 0, io.EOF
+			}
+			if in.keyAction != nil {
+				if action, ok := in.keyAction(chunk); ok {
+					in.end(action)
+					return 0, io.EOF
+				}
+			}
+			if in.clock != nil {
+				in.lastKey.Store(in.clock.Now().UnixNano())
+			}
+			in.pending = chunk
+		}
+	}
+	n := copy(b, in.pending)
+	in.pending = in.pending[n:]
+	return n, nil
+}
+
+// end records the action of a key that ends the prompt and closes the input
+func (in *promptInput) end(action NavigationAction) {
+	in.mu.Lock()
+	in.action, in.ended = action, true
+	in.mu.Unlock()
+	in.Close()
+}
+
+// keyEnded returns the action of the key that ended the prompt, if any
+func (in *promptInput) keyEnded() (NavigationAction, bool) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.action, in.ended
+}
+
+// Close ends the prompt reading from the input. It is safe to call more than once.
+func (in *promptInput) Close() error {
+	in.close.Do(func() { close(in.done) })
+	return nil
+}
+
+// watch closes in when ctx is done or, when timeout is positive, once timeout
+// has elapsed on clock without input. The returned function stops watching,
+// closes in, waits for the watcher to return and reports whether the timeout elapsed.
+func (in *promptInput) watch(ctx context.Context, clock Clock, timeout time.Duration) func() bool {
+	watchCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	var timedOut bool
+	if timeout > 0 {
+		in.clock = clockOr(clock)
+		in.lastKey.Store(in.clock.Now().UnixNano())
+	}
+	go func() {
+		defer close(done)
+		defer in.Close()
+		if timeout > 0 {
+			timedOut = in.waitIdle(watchCtx, timeout)
+			return
+		}
+		<-watchCtx.Done()
+	}()
+	return func() bool {
+		cancel()
+		<-done
+		return timedOut
+	}
+}
+
+// waitIdle sleeps until no input has arrived for timeout, restarting the wait
+// after each key. It reports false when ctx is done first.
+func (in *promptInput) waitIdle(ctx context.Context, timeout time.Duration) bool {
+	wait := timeout
+	for {
+		if in.clock.Sleep(ctx, wait) != nil {
+			return false
+		}
+		idle := in.clock.Now().Sub(time.Unix(0, in.lastKey.Load()))
+		if idle >= timeout {
+			return true
+		}
+		wait = timeout - idle
+	}
+}
+
+// runPrompt runs a promptui prompt whose Stdin is in. When ctx is done, or the
+// timeout (0 disables it) elapses, in is closed so that promptui returns and
+// restores the terminal. runPrompt always waits for the prompt to return, so no
+// prompt outlives it. A prompt ended by ctx returns ctx.Err(); timedOut reports
+// a prompt ended by the timeout.
+func runPrompt[T any](ctx context.Context, in *promptInput, clock Clock, timeout time.Duration, run func() (T, error)) (out T, timedOut bool, err error) {
+	stop := in.watch(ctx, clock, timeout)
+	out, err = run()
+	timedOut = stop()
+	if err == nil {
+		return out, false, nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return out, false, ctxErr
+	}
+	return out, timedOut, err
+}
@@ -0,0 +1,66 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/manifoldco/promptui"
+)
+
+// idleKeys returns a keyReader whose terminal never sends input
+func idleKeys(t *testing.T) *keyReader {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	return newKeyReader(r)
+}
+
+// TestPromptInput tests that a prompt input passes the terminal input on, and
+// that closing it ends a pending read with io.EOF
+func TestPromptInput(t *testing.T) {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	in := newPromptInput(newKeyReader(r))
+
+	go w.Write([]byte("sales\r"))
+	buf := make([]byte, 3)
+	var got []byte
+	for len(got) < len("sales\r") {
+		n, err := in.Read(buf)
+		if err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		got = append(got, buf[:n]...)
+	}
+	if string(got) != "sales\r" {
+		t.Errorf("Read() = %q, want %q", got, "sales\r")
+	}
+
+	go in.Close()
+	if _, err := in.Read(buf); err != io.EOF {
+		t.Errorf("Read() after Close() error = %v, want io.EOF", err)
+	}
+}
+
+// TestRunPromptContextCancelled tests that a cancelled context closes the input of
+// an unanswered prompt and that runPrompt waits for the prompt to return
+func TestRunPromptContextCancelled(t *testing.T) {
+	in := newPromptInput(idleKeys(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	var promptDone atomic.Bool
+
+	_, timedOut, err := runPrompt(ctx, in, nil, 0, func() (string, error) {
+		defer promptDone.Store(true)
+		cancel()
+		_, err := io.Copy(io.Discard, in)
+		return "", errors.Join(err, promptui.ErrEOF)
+	})
+	if !errors.Is(err, context.Canceled) || timedOut {
+		t.Errorf("runPrompt() = timedOut %v, error %v, want context.Canceled", timedOut, err)
+	}
+	if !promptDone.Load() {
+		t.Error("runPrompt() returned while the prompt was still running")
+	}
+}
@@ -0,0 +1,118 @@
+package s3tables
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+)
+
+// S3TablesRegions lists the AWS regions where S3 Tables is generally available
+var S3TablesRegions = []string{
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-north-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"sa-east-1",
+}
+
+// CheckRegionFormat reports a region string that is not well formed, e.g. one
+// containing spaces, before any call is made. It only checks the format: the
+// endpoint resolver builds a host name from any well-formed region, so whether
+// S3 Tables is available there is reported by the first call instead.
+// An empty region is left to the SDK, which reports it on the first call.
+func CheckRegionFormat(ctx context.Context, region string) error {
+	if region == "" {
+		return nil
+	}
+	params := s3tables.EndpointParameters{Region: &region}.WithDefaults()
+	if _, err := s3tables.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params); err != nil {
+		return &S3TablesError{
+			Operation:   "CheckRegion",
+			OriginalErr: err,
+			Message:     msg(MsgInvalidRegionFormat, region),
+			Suggestions: []string{msg(MsgSuggestRegionFormat)},
+			Type:        ErrorTypeBadRequest,
+		}
+	}
+	return nil
+}
+
+// ClientFactory creates an S3 Tables client bound to the given region
+type ClientFactory func(ctx context.Context, region string) (S3TablesAPI, error)
+
+// RegionalTableBucket is a table bucket together with the region it lives in
+type RegionalTableBucket struct {
+	TableBucketInfo
+	Region string
+}
+
+// ListTableBucketsInRegions lists table buckets in every given region in parallel and
+// aggregates them sorted by region and name. Regions where the client cannot be created
+// or the call fails (e.g. the service is unavailable there) are skipped and reported
+// as ItemErrors keyed by region.
+// Credential errors abort the whole run because they would fail in every region.
+// newLister configures the lister of each region, e.g. its bucket type filter;
+// nil uses NewS3TablesLister.
+func ListTableBucketsInRegions(ctx context.Context, regions []string, factory ClientFactory, newLister func(S3TablesAPI) *S3TablesLister) ([]RegionalTableBucket, []ItemError, error) {
+	if newLister == nil {
+		newLister = NewS3TablesLister
+	}
+
+	type regionResult struct {
+		buckets []TableBucketInfo
+		err     error
+	}
+
+	results := make([]regionResult, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			client, err := factory(ctx, region)
+			if err != nil {
+				results[i].err = err
+				return
+			}
+			results[i].buckets, results[i].err = newLister(client).ListTableBucketsAll(ctx, "")
+		}(i, region)
+	}
+	wg.Wait()
+
+	var buckets []RegionalTableBucket
+	var skipped []ItemError
+	for i, region := range regions {
+		if err := results[i].err; err != nil {
+			if IsCredentialError(err) {
+				return nil, nil, err
+			}
+			skipped = append(skipped, ItemError{Item: region, Err: err})
+			continue
+		}
+		for _, b := range results[i].buckets {
+			buckets = append(buckets, RegionalTableBucket{TableBucketInfo: b, Region: region})
+		}
+	}
+
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].Region != buckets[j].Region {
+			return buckets[i].Region < buckets[j].Region
+		}
+		return buckets[i].Name < buckets[j].Name
+	})
+
+	return buckets, skipped, nil
+}
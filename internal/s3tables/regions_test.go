@@ -0,0 +1,130 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// bucketsMock returns a mock that lists the given bucket names
+func bucketsMock(region string, names ...string) *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			var summaries []types.TableBucketSummary
+			for _, name := range names {
+				summaries = append(summaries, types.TableBucketSummary{
+					Name: aws.String(name),
+					Arn:  aws.String("arn:aws:s3tables:" + region + ":123456789012:bucket/" + name),
+				})
+			}
+			return &s3tables.ListTableBucketsOutput{TableBuckets: summaries}, nil
+		},
+	}
+}
+
+func TestListTableBucketsInRegionsSkipsUnavailable(t *testing.T) {
+	factory := func(ctx context.Context, region string) (S3TablesAPI, error) {
+		switch region {
+		case "us-east-1":
+			return bucketsMock(region, "b-bucket", "a-bucket"), nil
+		case "ap-northeast-1":
+			return bucketsMock(region, "tokyo-bucket"), nil
+		case "af-south-1":
+			return nil, errors.New("endpoint resolution failed")
+		default:
+			return &FuncMockS3TablesAPI{
+				ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+					return nil, errors.New("UnknownEndpoint: could not resolve endpoint")
+				},
+			}, nil
+		}
+	}
+
+	buckets, skipped, err := ListTableBucketsInRegions(context.Background(), []string{"us-east-1", "af-south-1", "ap-northeast-1", "me-south-1"}, factory, nil)
+	if err != nil {
+		t.Fatalf("ListTableBucketsInRegions() error = %v", err)
+	}
+
+	wantOrder := []string{"ap-northeast-1/tokyo-bucket", "us-east-1/a-bucket", "us-east-1/b-bucket"}
+	if len(buckets) != len(wantOrder) {
+		t.Fatalf("ListTableBucketsInRegions() returned %d buckets, want %d", len(buckets), len(wantOrder))
+	}
+	for i, b := range buckets {
+		if got := b.Region + "/" + b.Name; got != wantOrder[i] {
+			t.Errorf("bucket[%d] = %s, want %s", i, got, wantOrder[i])
+		}
+	}
+
+	if len(skipped) != 2 || skipped[0].Item != "af-south-1" || skipped[1].Item != "me-south-1" {
+		t.Errorf("skipped = %+v, want af-south-1 and me-south-1", skipped)
+	}
+}
+
+// TestListTableBucketsInRegionsBucketType tests that the lister of every region applies the bucket type filter
+func TestListTableBucketsInRegionsBucketType(t *testing.T) {
+	var mu sync.Mutex
+	var gotTypes []types.TableBucketType
+	factory := func(ctx context.Context, region string) (S3TablesAPI, error) {
+		return &FuncMockS3TablesAPI{
+			ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+				mu.Lock()
+				gotTypes = append(gotTypes, params.Type)
+				mu.Unlock()
+				return &s3tables.ListTableBucketsOutput{}, nil
+			},
+		}, nil
+	}
+	newLister := func(client S3TablesAPI) *S3TablesLister {
+		lister := NewS3TablesLister(client)
+		lister.SetBucketType(types.TableBucketTypeAws)
+		return lister
+	}
+
+	if _, _, err := ListTableBucketsInRegions(context.Background(), []string{"us-east-1", "us-west-2"}, factory, newLister); err != nil {
+		t.Fatalf("ListTableBucketsInRegions() error = %v", err)
+	}
+	if len(gotTypes) != 2 || gotTypes[0] != types.TableBucketTypeAws || gotTypes[1] != types.TableBucketTypeAws {
+		t.Errorf("bucket types = %v, want aws in both regions", gotTypes)
+	}
+}
+
+func TestListTableBucketsInRegionsCredentialErrorAborts(t *testing.T) {
+	factory := func(ctx context.Context, region string) (S3TablesAPI, error) {
+		return &FuncMockS3TablesAPI{
+			ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+				return nil, errors.New("no credentials found")
+			},
+		}, nil
+	}
+
+	_, _, err := ListTableBucketsInRegions(context.Background(), []string{"us-east-1", "us-west-2"}, factory, nil)
+	if err == nil {
+		t.Fatal("ListTableBucketsInRegions() should return error on credential failure")
+	}
+	if !IsCredentialError(err) {
+		t.Errorf("ListTableBucketsInRegions() error = %v, want credential error", err)
+	}
+}
+
+// TestCheckRegionFormat tests that only malformed region strings are rejected
+func TestCheckRegionFormat(t *testing.T) {
+	for _, region := range []string{"", "us-east-1", "cn-north-1", "xx-nowhere-9"} {
+		if err := CheckRegionFormat(context.Background(), region); err != nil {
+			t.Errorf("CheckRegionFormat(%q) error = %v", region, err)
+		}
+	}
+
+	err := CheckRegionFormat(context.Background(), "us east 1")
+	if GetErrorType(err) != ErrorTypeBadRequest {
+		t.Fatalf("CheckRegionFormat(%q) = %v, want ErrorTypeBadRequest", "us east 1", err)
+	}
+	if !strings.Contains(err.Error(), "'us east 1' is not a well-formed AWS region name") {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
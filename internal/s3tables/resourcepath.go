@@ -0,0 +1,71 @@
+package s3tables
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ResourcePath identifies a table bucket, a namespace in it, or a table in that
+// namespace. Using one value instead of loose strings keeps the bucket, namespace
+// and table from being passed in the wrong order.
+type ResourcePath struct {
+	Bucket    string
+	BucketARN string // 空の場合は Bucket 名から解決する
+	Namespace string
+	Table     string
+}
+
+// Level returns the navigation level that starts at the path: table buckets for
+// the empty path, namespaces for a bucket, and tables for a namespace or a table
+func (p ResourcePath) Level() NavigationLevel {
+	switch {
+	case p.Namespace != "":
+		return LevelTable
+	case p.Bucket != "":
+		return LevelNamespace
+	default:
+		return LevelTableBucket
+	}
+}
+
+// Parent returns the path without its deepest component; the parent of the
+// empty path is the empty path
+func (p ResourcePath) Parent() ResourcePath {
+	switch {
+	case p.Table != "":
+		p.Table = ""
+	case p.Namespace != "":
+		p.Namespace = ""
+	default:
+		p = ResourcePath{}
+	}
+	return p
+}
+
+// String returns the path as bucket/namespace/table, omitting empty components
+func (p ResourcePath) String() string {
+	var parts []string
+	for _, part := range []string{p.Bucket, p.Namespace, p.Table} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// ParseResourcePath parses the bucket/namespace/table form produced by String.
+// One to three non-empty components are accepted.
+func ParseResourcePath(s string) (ResourcePath, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) > 3 || slices.Contains(parts, "") {
+		return ResourcePath{}, &ValidationError{Field: "path", Message: fmt.Sprintf("'%s' is not a bucket[/namespace[/table]] path", s)}
+	}
+	var p ResourcePath
+	for i, target := range []*string{&p.Bucket, &p.Namespace, &p.Table} {
+		if i < len(parts) {
+			*target = parts[i]
+		}
+	}
+	return p, nil
+}
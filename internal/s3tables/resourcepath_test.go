@@ -0,0 +1,67 @@
+package s3tables
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestResourcePathLevelAndParent tests the level, parent and string form of each path depth
+func TestResourcePathLevelAndParent(t *testing.T) {
+	const bucketARN = "arn:aws:s3tables:us-east-1:123456789012:bucket/sales"
+	table := ResourcePath{Bucket: "sales", BucketARN: bucketARN, Namespace: "orders", Table: "daily"}
+	namespace := ResourcePath{Bucket: "sales", BucketARN: bucketARN, Namespace: "orders"}
+	bucket := ResourcePath{Bucket: "sales", BucketARN: bucketARN}
+
+	tests := []struct {
+		path      ResourcePath
+		level     NavigationLevel
+		parent    ResourcePath
+		formatted string
+	}{
+		{table, LevelTable, namespace, "sales/orders/daily"},
+		{namespace, LevelTable, bucket, "sales/orders"},
+		{bucket, LevelNamespace, ResourcePath{}, "sales"},
+		{ResourcePath{}, LevelTableBucket, ResourcePath{}, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.path.Level(); got != tt.level {
+			t.Errorf("%q.Level() = %v, want %v", tt.formatted, got, tt.level)
+		}
+		if got := tt.path.Parent(); got != tt.parent {
+			t.Errorf("%q.Parent() = %+v, want %+v", tt.formatted, got, tt.parent)
+		}
+		if got := tt.path.String(); got != tt.formatted {
+			t.Errorf("String() = %q, want %q", got, tt.formatted)
+		}
+	}
+}
+
+// TestSetInitialStateIgnoresTable tests that navigation keeps the bucket and namespace of a table path
+func TestSetInitialStateIgnoresTable(t *testing.T) {
+	controller := NewNavigationController(NewS3TablesLister(newHierarchyMock()), &MockInteractiveSelector{})
+	controller.SetInitialState(ResourcePath{Bucket: "bucket-b", BucketARN: "arn", Namespace: "ns_b", Table: "t"})
+	if got, want := controller.GetState().Selected, (ResourcePath{Bucket: "bucket-b", BucketARN: "arn", Namespace: "ns_b"}); got != want {
+		t.Errorf("Selected = %+v, want %+v", got, want)
+	}
+}
+
+// TestParseResourcePath tests parsing each path depth and rejecting malformed paths
+func TestParseResourcePath(t *testing.T) {
+	for _, want := range []ResourcePath{
+		{Bucket: "sales"},
+		{Bucket: "sales", Namespace: "orders"},
+		{Bucket: "sales", Namespace: "orders", Table: "daily"},
+	} {
+		got, err := ParseResourcePath(want.String())
+		if err != nil || got != want {
+			t.Errorf("ParseResourcePath(%q) = %+v, %v, want %+v", want.String(), got, err, want)
+		}
+	}
+
+	for _, s := range []string{"", "sales/", "/orders", "sales//daily", "a/b/c/d"} {
+		var validationErr *ValidationError
+		if _, err := ParseResourcePath(s); !errors.As(err, &validationErr) {
+			t.Errorf("ParseResourcePath(%q) error = %v, want ValidationError", s, err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	// randMu guards randSource, which is shared by every Backoff
+	randMu sync.Mutex
+	// randSource seeds backoffs that have no Source of their own; nil means time-seeded
+	randSource rand.Source
+)
+
+// SetRandSource makes randomized behavior (such as backoff jitter) reproducible.
+// Each new Backoff without its own Source draws its seed from src, so the same
+// seed yields the same delay sequences. Pass nil to restore time-based seeding.
+func SetRandSource(src rand.Source) {
+	randMu.Lock()
+	defer randMu.Unlock()
+	randSource = src
+}
+
+// newSeededSource returns a source seeded from the package source, or from the clock
+func newSeededSource() rand.Source {
+	randMu.Lock()
+	defer randMu.Unlock()
+	if randSource != nil {
+		return rand.NewSource(randSource.Int63())
+	}
+	return rand.NewSource(clockOr(nil).Now().UnixNano())
+}
+
+// BackoffStrategy selects how the delay between retry attempts grows
+type BackoffStrategy int
+
+const (
+	// BackoffExponentialJitter grows the delay exponentially and picks a random
+	// delay between zero and that value ("full jitter")
+	BackoffExponentialJitter BackoffStrategy = iota
+	// BackoffExponential doubles the delay after every attempt
+	BackoffExponential
+	// BackoffConstant always waits the base delay
+	BackoffConstant
+)
+
+// String returns the string representation of BackoffStrategy
+func (s BackoffStrategy) String() string {
+	switch s {
+	case BackoffExponentialJitter:
+		return "exponential-jitter"
+	case BackoffExponential:
+		return "exponential"
+	case BackoffConstant:
+		return "constant"
+	default:
+		return "Unknown"
+	}
+}
+
+// BackoffConfig configures the retry helper
+type BackoffConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Strategy    BackoffStrategy
+	// Source supplies randomness for jitter; nil falls back to SetRandSource
+	// or a time-seeded source
+	Source rand.Source
+	// Clock waits between attempts and measures deadlines; nil uses the package clock
+	Clock Clock
+}
+
+// DefaultBackoffConfig returns the default retry configuration (jittered exponential)
+func DefaultBackoffConfig() BackoffConfig {
+	return BackoffConfig{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Strategy:    BackoffExponentialJitter,
+	}
+}
+
+// maxBackoffCeiling bounds exponential growth when no MaxDelay is configured
+const maxBackoffCeiling = time.Duration(1 << 62)
+
+// Backoff computes retry delays for a BackoffConfig
+type Backoff struct {
+	cfg BackoffConfig
+	rnd *rand.Rand
+}
+
+// NewBackoff creates a Backoff from cfg
+func NewBackoff(cfg BackoffConfig) *Backoff {
+	src := cfg.Source
+	if src == nil {
+		src = newSeededSource()
+	}
+	return &Backoff{cfg: cfg, rnd: rand.New(src)}
+}
+
+// Delay returns the wait before retry number attempt (0-based)
+func (b *Backoff) Delay(attempt int) time.Duration {
+	if b.cfg.Strategy == BackoffConstant {
+		return b.cfg.BaseDelay
+	}
+
+	// Double per attempt, stopping at MaxDelay (when set) or before overflowing
+	ceiling := b.cfg.BaseDelay
+	for i := 0; i < attempt && ceiling > 0 && ceiling < maxBackoffCeiling; i++ {
+		if b.cfg.MaxDelay > 0 && ceiling >= b.cfg.MaxDelay {
+			break
+		}
+		ceiling *= 2
+	}
+	if b.cfg.MaxDelay > 0 && ceiling > b.cfg.MaxDelay {
+		ceiling = b.cfg.MaxDelay
+	}
+	// Int63n(ceiling+1) below must not overflow for a BaseDelay or MaxDelay near MaxInt64
+	ceiling = min(ceiling, maxBackoffCeiling)
+
+	if b.cfg.Strategy == BackoffExponential || ceiling <= 0 {
+		return ceiling
+	}
+	return time.Duration(b.rnd.Int63n(int64(ceiling) + 1))
+}
+
+// isRetryable reports whether an operation failing with err may succeed on retry
+func isRetryable(err error) bool {
+	var s3tErr *S3TablesError
+	return errors.As(err, &s3tErr) && s3tErr.Retryable()
+}
+
+// Retry calls fn until it succeeds, returns a non-retryable error, the attempts
+// are exhausted, or ctx is done. The last error is returned.
+func Retry(ctx context.Context, cfg BackoffConfig, fn func() error) error {
+	backoff := NewBackoff(cfg)
+	clock := clockOr(cfg.Clock)
+	attempts := cfg.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err = fn(); err == nil || !isRetryable(err) {
+			return err
+		}
+		if attempt == attempts-1 {
+			break
+		}
+
+		if err := clock.Sleep(ctx, backoff.Delay(attempt)); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// DefaultVisibilityTimeout bounds how long waitForVisible polls after a create
+const DefaultVisibilityTimeout = 10 * time.Second
+
+// DefaultBucketReadyTimeout bounds how long a new table bucket is polled until GetTableBucket succeeds
+const DefaultBucketReadyTimeout = 30 * time.Second
+
+// visibilityBackoff is the polling schedule used while waiting for a new resource
+func visibilityBackoff() BackoffConfig {
+	return BackoffConfig{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  2 * time.Second,
+		Strategy:  BackoffExponential,
+	}
+}
+
+// waitForVisible polls check until it reports the resource as visible, covering the
+// eventual consistency window after a create. It returns check's error immediately,
+// or a timeout error naming operation when the resource is still missing after timeout.
+// When the caller's ctx is done first, its error is returned instead.
+func waitForVisible(ctx context.Context, operation string, cfg BackoffConfig, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
+	// ctx の期限は実行中の check を打ち切るため、deadline は Clock 上の待機の上限
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clock := clockOr(cfg.Clock)
+	deadline := clock.Now().Add(timeout)
+	timeoutErr := func(err error) error {
+		if parentErr := parent.Err(); parentErr != nil {
+			return parentErr
+		}
+		return &S3TablesError{
+			OriginalErr: err,
+			Operation:   operation,
+			Message:     fmt.Sprintf("created resource not visible after %s", timeout),
+			Suggestions: []string{msg(MsgSuggestRetry)},
+			Type:        ErrorTypeTimeout,
+		}
+	}
+
+	backoff := NewBackoff(cfg)
+	for attempt := 0; ; attempt++ {
+		visible, err := check(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if visible {
+			return nil
+		}
+
+		remaining := deadline.Sub(clock.Now())
+		if remaining <= 0 {
+			return timeoutErr(context.DeadlineExceeded)
+		}
+		if err := clock.Sleep(ctx, min(backoff.Delay(attempt), remaining)); err != nil {
+			return timeoutErr(err)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayExponentialJitterBounds(t *testing.T) {
+	cfg := BackoffConfig{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  1 * time.Second,
+		Strategy:  BackoffExponentialJitter,
+		Source:    rand.NewSource(42),
+	}
+	backoff := NewBackoff(cfg)
+
+	ceilings := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		1 * time.Second,
+		1 * time.Second,
+	}
+	for attempt, ceiling := range ceilings {
+		for i := 0; i < 50; i++ {
+			d := backoff.Delay(attempt)
+			if d < 0 || d > ceiling {
+				t.Errorf("Delay(%d) = %v, want within [0, %v]", attempt, d, ceiling)
+			}
+		}
+	}
+}
+
+func TestBackoffDelaySeededIsDeterministic(t *testing.T) {
+	cfg := BackoffConfig{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  2 * time.Second,
+		Strategy:  BackoffExponentialJitter,
+	}
+
+	cfg.Source = rand.NewSource(7)
+	first := NewBackoff(cfg)
+	cfg.Source = rand.NewSource(7)
+	second := NewBackoff(cfg)
+
+	for attempt := 0; attempt < 5; attempt++ {
+		if a, b := first.Delay(attempt), second.Delay(attempt); a != b {
+			t.Errorf("Delay(%d) differs between identically seeded backoffs: %v vs %v", attempt, a, b)
+		}
+	}
+}
+
+func TestSetRandSourceReproducesDelays(t *testing.T) {
+	t.Cleanup(func() { SetRandSource(nil) })
+
+	sequence := func() []time.Duration {
+		SetRandSource(rand.NewSource(1931))
+		var delays []time.Duration
+		for run := 0; run < 2; run++ {
+			backoff := NewBackoff(DefaultBackoffConfig())
+			for attempt := 0; attempt < 5; attempt++ {
+				delays = append(delays, backoff.Delay(attempt))
+			}
+		}
+		return delays
+	}
+
+	first, second := sequence(), sequence()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("delay %d differs between runs with the same seed: %v vs %v", i, first[i], second[i])
+		}
+	}
+}
+
+func TestBackoffDelayWithoutJitter(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy BackoffStrategy
+		want     []time.Duration
+	}{
+		{
+			name:     "exponential",
+			strategy: BackoffExponential,
+			want:     []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond},
+		},
+		{
+			name:     "constant",
+			strategy: BackoffConstant,
+			want:     []time.Duration{10 * time.Millisecond, 10 * time.Millisecond, 10 * time.Millisecond, 10 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backoff := NewBackoff(BackoffConfig{
+				BaseDelay: 10 * time.Millisecond,
+				MaxDelay:  50 * time.Millisecond,
+				Strategy:  tt.strategy,
+			})
+			for attempt, want := range tt.want {
+				if got := backoff.Delay(attempt); got != want {
+					t.Errorf("Delay(%d) = %v, want %v", attempt, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestBackoffDelayHugeDelays tests that jitter does not overflow for delays near MaxInt64
+func TestBackoffDelayHugeDelays(t *testing.T) {
+	for _, cfg := range []BackoffConfig{
+		{BaseDelay: math.MaxInt64},
+		{BaseDelay: time.Second, MaxDelay: math.MaxInt64},
+	} {
+		cfg.Strategy = BackoffExponentialJitter
+		cfg.Source = rand.NewSource(1)
+		backoff := NewBackoff(cfg)
+		for attempt := 0; attempt < 70; attempt++ {
+			if d := backoff.Delay(attempt); d < 0 || d > maxBackoffCeiling {
+				t.Errorf("Delay(%d) = %v, want within [0, %v]", attempt, d, maxBackoffCeiling)
+			}
+		}
+	}
+}
+
+func TestBackoffStrategyString(t *testing.T) {
+	tests := []struct {
+		strategy BackoffStrategy
+		want     string
+	}{
+		{BackoffExponentialJitter, "exponential-jitter"},
+		{BackoffExponential, "exponential"},
+		{BackoffConstant, "constant"},
+		{BackoffStrategy(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.strategy.String(); got != tt.want {
+			t.Errorf("BackoffStrategy(%d).String() = %q, want %q", tt.strategy, got, tt.want)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	retryable := &S3TablesError{Type: ErrorTypeInternalServer, Message: "AWS service error"}
+	permanent := &S3TablesError{Type: ErrorTypeNotFound, Message: "resource not found"}
+
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "succeeds first time", errs: []error{nil}, wantCalls: 1},
+		{name: "succeeds after retry", errs: []error{retryable, nil}, wantCalls: 2},
+		{name: "gives up after max attempts", errs: []error{retryable, retryable, retryable, nil}, wantCalls: 3, wantErr: retryable},
+		{name: "does not retry permanent errors", errs: []error{permanent, nil}, wantCalls: 1, wantErr: permanent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			cfg := BackoffConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Strategy: BackoffConstant}
+			err := Retry(context.Background(), cfg, func() error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("Retry() made %d calls, want %d", calls, tt.wantCalls)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Retry() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cfg := BackoffConfig{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour, Strategy: BackoffConstant}
+	err := Retry(ctx, cfg, func() error {
+		return &S3TablesError{Type: ErrorTypeInternalServer}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Retry() error = %v, want context.Canceled", err)
+	}
+}
+
+// TestWaitForVisible tests polling until the check reports the resource as visible
+func TestWaitForVisible(t *testing.T) {
+	cfg := BackoffConfig{BaseDelay: time.Millisecond, Strategy: BackoffConstant}
+	checkErr := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		notFound  int
+		err       error
+		timeout   time.Duration
+		wantCalls int
+		wantType  ErrorType
+		wantErr   error
+	}{
+		{name: "visible immediately", timeout: time.Second, wantCalls: 1},
+		{name: "visible after three polls", notFound: 3, timeout: time.Second, wantCalls: 4},
+		{name: "check error is returned", err: checkErr, timeout: time.Second, wantCalls: 1, wantErr: checkErr},
+		{name: "never visible times out", notFound: 1 << 30, timeout: 20 * time.Millisecond, wantType: ErrorTypeTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := waitForVisible(context.Background(), "CreateNamespace", cfg, tt.timeout, func(ctx context.Context) (bool, error) {
+				calls++
+				if tt.err != nil {
+					return false, tt.err
+				}
+				return calls > tt.notFound, nil
+			})
+
+			switch {
+			case tt.wantType != ErrorTypeUnknown:
+				if GetErrorType(err) != tt.wantType {
+					t.Fatalf("waitForVisible() error = %v, want type %v", err, tt.wantType)
+				}
+				return
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("waitForVisible() error = %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("waitForVisible() error = %v", err)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("check calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// TestWaitForVisibleParentCancelled tests that cancelling the caller's context is
+// reported as such rather than as a resource that never became visible
+func TestWaitForVisibleParentCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := BackoffConfig{BaseDelay: time.Hour, Strategy: BackoffConstant}
+
+	err := waitForVisible(ctx, "CreateNamespace", cfg, time.Hour, func(ctx context.Context) (bool, error) {
+		cancel()
+		return false, ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) || GetErrorType(err) == ErrorTypeTimeout {
+		t.Errorf("waitForVisible() error = %v, want context.Canceled", err)
+	}
+}
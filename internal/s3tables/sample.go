@@ -0,0 +1,41 @@
+package s3tables
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Sample returns up to n items chosen uniformly at random from items, keeping
+// their original order. It uses reservoir sampling, so every item is considered
+// once. src supplies the randomness; nil falls back to SetRandSource or a
+// time-seeded source.
+func Sample[T any](items []T, n int, src rand.Source) []T {
+	if n <= 0 {
+		return nil
+	}
+	if n >= len(items) {
+		return items
+	}
+	if src == nil {
+		src = newSeededSource()
+	}
+	rnd := rand.New(src)
+
+	// reservoir holds indexes into items so that the sample can be put back in order
+	reservoir := make([]int, n)
+	for i := range reservoir {
+		reservoir[i] = i
+	}
+	for i := n; i < len(items); i++ {
+		if j := rnd.Intn(i + 1); j < n {
+			reservoir[j] = i
+		}
+	}
+	sort.Ints(reservoir)
+
+	sample := make([]T, n)
+	for i, index := range reservoir {
+		sample[i] = items[index]
+	}
+	return sample
+}
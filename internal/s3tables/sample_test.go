@@ -0,0 +1,55 @@
+package s3tables
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// TestSampleSize tests that the sample holds min(n, total) distinct items in their original order
+func TestSampleSize(t *testing.T) {
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+	for _, n := range []int{0, 1, 20, 50, 80} {
+		sample := Sample(items, n, rand.NewSource(1))
+		if want := min(n, len(items)); len(sample) != want {
+			t.Errorf("len(Sample(50 items, %d)) = %d, want %d", n, len(sample), want)
+		}
+		if !sort.IntsAreSorted(sample) {
+			t.Errorf("Sample(50 items, %d) = %v, want original order", n, sample)
+		}
+		for i := 1; i < len(sample); i++ {
+			if sample[i] == sample[i-1] {
+				t.Errorf("Sample(50 items, %d) repeats %d", n, sample[i])
+			}
+		}
+	}
+}
+
+// TestSampleDeterministic tests that the same seed yields the same sample and that
+// the sample is not simply the first items
+func TestSampleDeterministic(t *testing.T) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+	first := Sample(items, 10, rand.NewSource(42))
+	second := Sample(items, 10, rand.NewSource(42))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Sample() with the same seed = %v and %v, want equal", first, second)
+	}
+	if reflect.DeepEqual(first, items[:10]) {
+		t.Errorf("Sample() = %v, want a random sample rather than the first items", first)
+	}
+
+	SetRandSource(rand.NewSource(7))
+	fromPackage := Sample(items, 10, nil)
+	SetRandSource(rand.NewSource(7))
+	t.Cleanup(func() { SetRandSource(nil) })
+	if again := Sample(items, 10, nil); !reflect.DeepEqual(fromPackage, again) {
+		t.Errorf("Sample() with SetRandSource = %v and %v, want equal", fromPackage, again)
+	}
+}
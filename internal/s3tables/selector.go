@@ -1,8 +1,13 @@
 package s3tables
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/manifoldco/promptui"
 )
@@ -17,8 +22,10 @@ type SelectionResult struct {
 type InteractiveSelector interface {
 	// SelectWithFilter displays items with real-time filtering
 	// showBack adds a ".. (Back)" option at the top when true
-	// Returns the selected item and the action taken
-	SelectWithFilter(label string, items []string, showBack bool) (*SelectionResult, error)
+	// Returns the selected item and the action taken; when ctx is done while
+	// the prompt is open, ActionExit is returned together with ctx.Err(), and
+	// a prompt left without input returns ActionExit with ErrPromptTimeout
+	SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error)
 }
 
 // Selector provides interactive selection UI (legacy interface)
@@ -27,6 +34,10 @@ type Selector interface {
 	Select(label string, items []string) (string, error)
 }
 
+// ErrPromptTimeout is returned when a prompt receives no input within the prompt
+// timeout. It ends the navigation at every level.
+var ErrPromptTimeout = errors.New("prompt timed out")
+
 // promptRunner abstracts promptui.Select.Run for testing
 type promptRunner interface {
 	Run() (int, string, error)
@@ -56,10 +67,15 @@ func (s *PromptSelector) Select(label string, items []string) (string, error) {
 		return "", fmt.Errorf("no items to select")
 	}
 
+	// Read the terminal through the shared key reader like the other prompts,
+	// so that this prompt does not compete with it for stdin
+	in := newPromptInput(nil)
+	defer in.Close()
 	prompt := &promptui.Select{
 		Label: label,
 		Items: items,
 		Size:  10,
+		Stdin: in,
 	}
 
 	idx, _, err := s.runFunc(prompt)
@@ -73,16 +89,192 @@ func (s *PromptSelector) Select(label string, items []string) (string, error) {
 // BackOption is the special option for navigating back
 const BackOption = ".. (Back)"
 
+// keyEscape is the byte sent by the ESC key. A terminal sends a lone ESC key in
+// a read of its own, while escape sequences such as arrow keys arrive whole.
+const keyEscape = 0x1b
+
+// backKeyAction ends a selection prompt with ActionBack when ESC is pressed
+func backKeyAction(chunk []byte) (NavigationAction, bool) {
+	if len(chunk) == 1 && chunk[0] == keyEscape {
+		return ActionBack, true
+	}
+	return 0, false
+}
+
+// LoadMoreOption is the special option for fetching the next page in paged navigation
+const LoadMoreOption = "\u2026 (Load more)"
+
+// ClearFilterHint is appended to the prompt label while the search filter matches
+// no item. Pressing Enter then returns ActionResetFilter and the prompt is shown
+// again unfiltered.
+const ClearFilterHint = " (no matches \u2014 press Enter to clear the filter)"
+
+// DefaultChunkThreshold is the item count above which the selector asks for a filter first
+const DefaultChunkThreshold = 500
+
 // FilterablePromptSelector implements InteractiveSelector with filtering
 type FilterablePromptSelector struct {
 	// runFunc allows overriding the prompt runner for testing
 	runFunc func(prompt promptRunner) (int, string, error)
+	// inputFunc allows overriding the text input prompt for testing
+	inputFunc func(ctx context.Context, label string) (string, error)
+	// chunkThreshold is the item count above which a filter is requested before selection (0 disables)
+	chunkThreshold int
+	// promptTimeout ends a prompt left without input for this long (0 disables)
+	promptTimeout time.Duration
+	// keys supplies the terminal input of the prompts (nil reads os.Stdin)
+	keys *keyReader
+	// clock measures the prompt timeout (nil uses the package clock)
+	clock Clock
+	// glyphs marks the active and selected items
+	glyphs Glyphs
+}
+
+// Glyphs selects the characters that mark the active and selected prompt items
+type Glyphs int
+
+const (
+	// GlyphsUnicode uses promptui's "▸" and "✔"
+	GlyphsUnicode Glyphs = iota
+	// GlyphsASCII uses ">" and "*" for terminals that cannot render Unicode
+	GlyphsASCII
+)
+
+// glyphsNames maps the Glyphs values to their flag names
+var glyphsNames = []string{"unicode", "ascii"}
+
+func (g Glyphs) String() string {
+	if int(g) < 0 || int(g) >= len(glyphsNames) {
+		return "unknown"
+	}
+	return glyphsNames[g]
+}
+
+// ParseGlyphs parses a prompt glyph set name: unicode or ascii
+func ParseGlyphs(s string) (Glyphs, error) {
+	for i, name := range glyphsNames {
+		if strings.EqualFold(s, name) {
+			return Glyphs(i), nil
+		}
+	}
+	return GlyphsUnicode, fmt.Errorf("invalid glyphs '%s' (available: %s)", s, strings.Join(glyphsNames, ", "))
+}
+
+// icons returns the active and selected item markers of the glyph set
+func (g Glyphs) icons() (active, selected string) {
+	if g == GlyphsASCII {
+		return ">", "*"
+	}
+	return promptui.IconSelect, promptui.IconGood
 }
 
 // NewFilterablePromptSelector creates a new FilterablePromptSelector
 func NewFilterablePromptSelector() *FilterablePromptSelector {
-	return &FilterablePromptSelector{
-		runFunc: defaultPromptRun,
+	s := &FilterablePromptSelector{
+		runFunc:        defaultPromptRun,
+		chunkThreshold: DefaultChunkThreshold,
+	}
+	s.inputFunc = s.input
+	return s
+}
+
+// SetChunkThreshold sets the item count above which a filter string is requested
+// before the selection list is shown. A value of 0 disables the filter prompt.
+func (s *FilterablePromptSelector) SetChunkThreshold(threshold int) {
+	s.chunkThreshold = threshold
+}
+
+// SetGlyphs selects the markers of the active and selected prompt items
+func (s *FilterablePromptSelector) SetGlyphs(glyphs Glyphs) {
+	s.glyphs = glyphs
+}
+
+// SetPromptTimeout ends a prompt that receives no input for timeout, e.g. on
+// shared terminals, with ErrPromptTimeout. Each key press restarts the timeout.
+// A value of 0 disables the timeout.
+func (s *FilterablePromptSelector) SetPromptTimeout(timeout time.Duration) {
+	s.promptTimeout = timeout
+}
+
+// input asks for a line of text on the selector's terminal input, subject to the prompt timeout
+func (s *FilterablePromptSelector) input(ctx context.Context, label string) (string, error) {
+	return inputText(ctx, s.keys, s.clock, s.promptTimeout, label)
+}
+
+// Input asks the user for a line of text using promptui.Prompt.
+// The prompt ends with ctx.Err() when ctx is done.
+func Input(ctx context.Context, label string) (string, error) {
+	return inputText(ctx, nil, nil, 0, label)
+}
+
+// inputText runs a text prompt reading from keys (nil reads os.Stdin) that ends
+// with ErrPromptTimeout after timeout without input (0 disables the timeout)
+func inputText(ctx context.Context, keys *keyReader, clock Clock, timeout time.Duration, label string) (string, error) {
+	in := newPromptInput(keys)
+	prompt := &promptui.Prompt{Label: label, Stdin: in}
+	text, timedOut, err := runPrompt(ctx, in, clock, timeout, prompt.Run)
+	if timedOut {
+		return "", promptTimeoutError(timeout)
+	}
+	return text, err
+}
+
+// promptTimeoutError reports a prompt that received no input for timeout
+func promptTimeoutError(timeout time.Duration) error {
+	return fmt.Errorf("no input for %s: %w", timeout, ErrPromptTimeout)
+}
+
+// Confirm asks a yes/no question and reports whether the user answered yes.
+// Declining or pressing Ctrl+C is treated as "no"; a done ctx ends the prompt
+// with ctx.Err().
+func Confirm(ctx context.Context, label string) (bool, error) {
+	in := newPromptInput(nil)
+	prompt := &promptui.Prompt{Label: label, IsConfirm: true, Stdin: in}
+	_, _, err := runPrompt(ctx, in, nil, 0, prompt.Run)
+	if err == promptui.ErrAbort || err == promptui.ErrInterrupt {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// filterItems returns the items containing pattern (case-insensitive)
+func filterItems(items []string, pattern string) []string {
+	if pattern == "" {
+		return items
+	}
+	pattern = strings.ToLower(pattern)
+	var filtered []string
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(item), pattern) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// narrowItems asks for a filter string until it matches at least one item.
+// Returns nil items when the user interrupts the prompt.
+func (s *FilterablePromptSelector) narrowItems(ctx context.Context, label string, items []string) ([]string, error) {
+	for {
+		pattern, err := s.inputFunc(ctx, fmt.Sprintf("%s (%d items, enter filter)", label, len(items)))
+		if err != nil {
+			if err == promptui.ErrInterrupt {
+				return nil, nil
+			}
+			if errors.Is(err, ErrPromptTimeout) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("filter input failed: %w", err)
+		}
+
+		filtered := filterItems(items, strings.TrimSpace(pattern))
+		if len(filtered) > 0 {
+			return filtered, nil
+		}
+		fmt.Printf("No items match '%s'\n", pattern)
 	}
 }
 
@@ -95,29 +287,171 @@ func createSearcher(items []string) func(string, int) bool {
 	}
 }
 
+// filterState tracks whether the current search term matches any item, so that
+// the prompt can offer to clear a filter that matches nothing. promptui calls the
+// searcher while the key reader consults the state, so it is guarded by mu.
+type filterState struct {
+	items   []string
+	match   func(string, int) bool
+	mu      sync.Mutex
+	term    string // searcher に最後に渡された検索語
+	noMatch bool   // 検索語に一致するアイテムがない
+}
+
+// newFilterState creates the filter state for the given display items
+func newFilterState(items []string) *filterState {
+	return &filterState{items: items, match: createSearcher(items)}
+}
+
+// searcher matches items by case-insensitive substring. promptui calls it for
+// every index in order, so the match state is recomputed at index 0.
+func (f *filterState) searcher(input string, index int) bool {
+	f.mu.Lock()
+	if index == 0 || input != f.term {
+		f.term = input
+		f.noMatch = strings.TrimSpace(input) != "" && len(filterItems(f.items, input)) == 0
+	}
+	f.mu.Unlock()
+	return f.match(input, index)
+}
+
+// searchKey is promptui's default key toggling the search mode of a select prompt
+const searchKey = '/'
+
+// keyAction ends the prompt with ActionResetFilter when Enter is pressed while the
+// filter matches nothing, and otherwise maps the key with backKeyAction. promptui
+// clears the search without calling the searcher on the search key and on a
+// Backspace that empties the term, so those keys clear the no-match state here.
+func (f *filterState) keyAction(chunk []byte) (NavigationAction, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.noMatch {
+		if len(chunk) == 1 && (chunk[0] == '\r' || chunk[0] == '\n') {
+			return ActionResetFilter, true
+		}
+		for _, b := range chunk {
+			switch {
+			case rune(b) == searchKey:
+				f.term, f.noMatch = "", false
+			case (rune(b) == promptui.KeyBackspace || rune(b) == promptui.KeyCtrlH) && utf8.RuneCountInString(f.term) <= 1:
+				f.term, f.noMatch = "", false
+			}
+		}
+	}
+	return backKeyAction(chunk)
+}
+
+// hint returns ClearFilterHint while the filter matches nothing
+func (f *filterState) hint() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.noMatch {
+		return ClearFilterHint
+	}
+	return ""
+}
+
+// selectTemplates renders the label with the clear-filter hint and marks the
+// active and selected items with glyphs
+func (f *filterState) selectTemplates(glyphs Glyphs) *promptui.SelectTemplates {
+	funcs := make(map[string]interface{}, len(promptui.FuncMap)+1)
+	for name, fn := range promptui.FuncMap {
+		funcs[name] = fn
+	}
+	funcs["clearHint"] = f.hint
+	active, selected := glyphs.icons()
+	return &promptui.SelectTemplates{
+		Label:    fmt.Sprintf("%s {{ . }}{{ clearHint }}: ", promptui.IconInitial),
+		Active:   fmt.Sprintf("%s {{ . | underline }}", active),
+		Inactive: "  {{ . }}",
+		Selected: fmt.Sprintf(`{{ "%s" | green }} {{ . | faint }}`, selected),
+		FuncMap:  funcs,
+	}
+}
+
 // SelectWithFilter displays a selection prompt with real-time filtering
 // Uses promptui's Searcher feature for case-insensitive substring matching
-// Selecting ".. (Back)" returns ActionBack
-func (s *FilterablePromptSelector) SelectWithFilter(label string, items []string, showBack bool) (*SelectionResult, error) {
+// Selecting ".. (Back)" or pressing ESC returns ActionBack, and Ctrl+C returns ActionExit
+// When the item count exceeds the chunk threshold, a filter string is requested
+// first so that only matching items are rendered
+// When the search filter matches nothing, the label shows ClearFilterHint and
+// pressing Enter shows the prompt again with the filter cleared.
+func (s *FilterablePromptSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error) {
 	if len(items) == 0 {
 		return nil, fmt.Errorf("no items to select")
 	}
+	if err := ctx.Err(); err != nil {
+		return &SelectionResult{Action: ActionExit}, err
+	}
 
+	if s.chunkThreshold > 0 && len(items) > s.chunkThreshold {
+		narrowed, err := s.narrowItems(ctx, label, items)
+		if err != nil && ctx.Err() != nil {
+			return &SelectionResult{Action: ActionExit}, ctx.Err()
+		}
+		if errors.Is(err, ErrPromptTimeout) {
+			return &SelectionResult{Action: ActionExit}, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		if narrowed == nil {
+			return &SelectionResult{Action: ActionExit}, nil
+		}
+		items = narrowed
+	}
+
+	for {
+		result, err := s.selectOnce(ctx, label, items, showBack)
+		if err != nil || result.Action != ActionResetFilter {
+			return result, err
+		}
+	}
+}
+
+// selectOnce shows the selection prompt a single time. The prompt reads a
+// closable input, so a cancelled ctx or an elapsed prompt timeout ends it and
+// restores the terminal before selectOnce returns.
+func (s *FilterablePromptSelector) selectOnce(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error) {
 	// Prepend back option if enabled
 	displayItems := items
 	if showBack {
 		displayItems = append([]string{BackOption}, items...)
 	}
+	filter := newFilterState(displayItems)
 
+	in := newPromptInput(s.keys)
+	in.keyAction = filter.keyAction
 	prompt := &promptui.Select{
 		Label:             label,
 		Items:             displayItems,
 		Size:              10,
-		Searcher:          createSearcher(displayItems),
+		Searcher:          filter.searcher,
 		StartInSearchMode: false,
+		Templates:         filter.selectTemplates(s.glyphs),
+		Stdin:             in,
+	}
+
+	type promptResult struct {
+		idx      int
+		selected string
+	}
+	res, timedOut, err := runPrompt(ctx, in, s.clock, s.promptTimeout, func() (promptResult, error) {
+		idx, selected, err := s.runFunc(prompt)
+		return promptResult{idx: idx, selected: selected}, err
+	})
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		return &SelectionResult{Action: ActionExit}, ctxErr
+	}
+	if timedOut {
+		return &SelectionResult{Action: ActionExit}, promptTimeoutError(s.promptTimeout)
 	}
 
-	idx, selected, err := s.runFunc(prompt)
+	if action, ok := in.keyEnded(); ok {
+		return &SelectionResult{Action: action}, nil
+	}
+
+	idx, selected := res.idx, res.selected
 	if err != nil {
 		// Ctrl+C triggers ErrInterrupt - treat as exit
 		if err == promptui.ErrInterrupt {
@@ -136,3 +470,25 @@ func (s *FilterablePromptSelector) SelectWithFilter(label string, items []string
 		Action:   ActionSelect,
 	}, nil
 }
+
+// TargetSelector implements InteractiveSelector by selecting a fixed item by name
+// without prompting. It is used for deterministic, scripted navigation.
+type TargetSelector struct {
+	Target string
+}
+
+// SelectWithFilter returns the target item via ActionSelect, or a not-found error
+// when the target is not among the items
+func (s *TargetSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error) {
+	for _, item := range items {
+		if item == s.Target {
+			return &SelectionResult{Selected: item, Action: ActionSelect}, nil
+		}
+	}
+	return nil, &S3TablesError{
+		Operation:   label,
+		Message:     fmt.Sprintf("'%s' not found", s.Target),
+		Suggestions: []string{msg(MsgSuggestCheckSelectTarget)},
+		Type:        ErrorTypeNotFound,
+	}
+}
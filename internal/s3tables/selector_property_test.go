@@ -1,6 +1,7 @@
 package s3tables
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -48,7 +49,7 @@ func TestPromptSelectorSelectEmptyItems(t *testing.T) {
 // TestFilterablePromptSelectorSelectWithFilterEmptyItems tests SelectWithFilter with empty items
 func TestFilterablePromptSelectorSelectWithFilterEmptyItems(t *testing.T) {
 	selector := NewFilterablePromptSelector()
-	_, err := selector.SelectWithFilter("Test", []string{}, false)
+	_, err := selector.SelectWithFilter(context.Background(), "Test", []string{}, false)
 	if err == nil {
 		t.Error("SelectWithFilter() with empty items should return error")
 	}
@@ -99,7 +100,7 @@ func TestFilterablePromptSelectorSelectWithFilterSuccess(t *testing.T) {
 		},
 	}
 
-	result, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, false)
+	result, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, false)
 	if err != nil {
 		t.Errorf("SelectWithFilter() error = %v", err)
 	}
@@ -119,7 +120,7 @@ func TestFilterablePromptSelectorSelectWithFilterBack(t *testing.T) {
 		},
 	}
 
-	result, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, true)
+	result, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, true)
 	if err != nil {
 		t.Errorf("SelectWithFilter() error = %v", err)
 	}
@@ -136,7 +137,7 @@ func TestFilterablePromptSelectorSelectWithFilterInterrupt(t *testing.T) {
 		},
 	}
 
-	result, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, false)
+	result, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, false)
 	if err != nil {
 		t.Errorf("SelectWithFilter() error = %v", err)
 	}
@@ -153,7 +154,7 @@ func TestFilterablePromptSelectorSelectWithFilterError(t *testing.T) {
 		},
 	}
 
-	_, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, false)
+	_, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, false)
 	if err == nil {
 		t.Error("SelectWithFilter() should return error")
 	}
@@ -170,7 +171,7 @@ func TestFilterablePromptSelectorSelectWithFilterNoBack(t *testing.T) {
 		},
 	}
 
-	result, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, false)
+	result, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, false)
 	if err != nil {
 		t.Errorf("SelectWithFilter() error = %v", err)
 	}
@@ -190,7 +191,7 @@ func TestFilterablePromptSelectorSelectWithFilterWithBackSelectItem(t *testing.T
 		},
 	}
 
-	result, err := selector.SelectWithFilter("Test", []string{"item1", "item2"}, true)
+	result, err := selector.SelectWithFilter(context.Background(), "Test", []string{"item1", "item2"}, true)
 	if err != nil {
 		t.Errorf("SelectWithFilter() error = %v", err)
 	}
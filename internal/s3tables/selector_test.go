@@ -0,0 +1,419 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// TestSelectWithFilterChunkedAboveThreshold tests that a filter prompt narrows items before selection
+func TestSelectWithFilterChunkedAboveThreshold(t *testing.T) {
+	var inputCalls int
+	var shownItems []string
+	selector := &FilterablePromptSelector{
+		chunkThreshold: 3,
+		inputFunc: func(ctx context.Context, label string) (string, error) {
+			inputCalls++
+			if inputCalls == 1 {
+				return "zzz", nil // no match, should re-prompt
+			}
+			return "Sales", nil
+		},
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			shownItems = prompt.(*promptui.Select).Items.([]string)
+			return 1, shownItems[1], nil
+		},
+	}
+
+	items := []string{"orders", "sales_2023", "customers", "sales_2024", "products"}
+	result, err := selector.SelectWithFilter(context.Background(), "Select Table", items, true)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if inputCalls != 2 {
+		t.Errorf("filter prompt called %d times, want 2", inputCalls)
+	}
+	wantShown := []string{BackOption, "sales_2023", "sales_2024"}
+	if !reflect.DeepEqual(shownItems, wantShown) {
+		t.Errorf("shown items = %v, want %v", shownItems, wantShown)
+	}
+	if result.Action != ActionSelect || result.Selected != "sales_2023" {
+		t.Errorf("SelectWithFilter() = %+v, want Select sales_2023", result)
+	}
+}
+
+// TestSelectWithFilterChunkedAtOrBelowThreshold tests that no filter prompt is shown for small lists
+func TestSelectWithFilterChunkedAtOrBelowThreshold(t *testing.T) {
+	selector := &FilterablePromptSelector{
+		chunkThreshold: 3,
+		inputFunc: func(ctx context.Context, label string) (string, error) {
+			t.Error("filter prompt should not be shown at or below the threshold")
+			return "", nil
+		},
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			return 0, "a", nil
+		},
+	}
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b", "c"}, false)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if result.Selected != "a" {
+		t.Errorf("SelectWithFilter() Selected = %q, want a", result.Selected)
+	}
+}
+
+// TestSelectWithFilterChunkedInterrupt tests that Ctrl+C at the filter prompt exits
+func TestSelectWithFilterChunkedInterrupt(t *testing.T) {
+	selector := &FilterablePromptSelector{
+		chunkThreshold: 1,
+		inputFunc: func(ctx context.Context, label string) (string, error) {
+			return "", promptui.ErrInterrupt
+		},
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			t.Error("selection should not be shown after interrupt")
+			return 0, "", nil
+		},
+	}
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b"}, false)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if result.Action != ActionExit {
+		t.Errorf("SelectWithFilter() Action = %v, want ActionExit", result.Action)
+	}
+}
+
+// TestNewFilterablePromptSelectorDefaultThreshold tests the default chunk threshold
+func TestNewFilterablePromptSelectorDefaultThreshold(t *testing.T) {
+	selector := NewFilterablePromptSelector()
+	if selector.chunkThreshold != DefaultChunkThreshold {
+		t.Errorf("chunkThreshold = %d, want %d", selector.chunkThreshold, DefaultChunkThreshold)
+	}
+	selector.SetChunkThreshold(0)
+	if selector.chunkThreshold != 0 {
+		t.Errorf("SetChunkThreshold(0) chunkThreshold = %d, want 0", selector.chunkThreshold)
+	}
+}
+
+// TestTargetSelector tests fixed-name selection
+func TestTargetSelector(t *testing.T) {
+	selector := &TargetSelector{Target: "b"}
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b", "c"}, true)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if result.Action != ActionSelect || result.Selected != "b" {
+		t.Errorf("SelectWithFilter() = %+v, want Select b", result)
+	}
+
+	selector.Target = "z"
+	_, err = selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b", "c"}, true)
+	if !IsNotFoundError(err) {
+		t.Errorf("SelectWithFilter() error = %v, want not found", err)
+	}
+}
+
+// readUntilClosed is a prompt runner for a user who never answers: like promptui,
+// it reads the prompt's input until the input is closed
+func readUntilClosed(prompt promptRunner) (int, string, error) {
+	if _, err := io.Copy(io.Discard, prompt.(*promptui.Select).Stdin); err != nil {
+		return 0, "", err
+	}
+	return 0, "", promptui.ErrEOF
+}
+
+// TestSelectWithFilterContextCancelled tests that cancelling the context ends the
+// open prompt by closing its input, and that the prompt has returned before SelectWithFilter does
+func TestSelectWithFilterContextCancelled(t *testing.T) {
+	opened := make(chan struct{})
+	var promptDone atomic.Bool
+
+	selector := &FilterablePromptSelector{
+		keys: idleKeys(t),
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			defer promptDone.Store(true)
+			close(opened)
+			return readUntilClosed(prompt)
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-opened
+		cancel()
+	}()
+
+	result, err := selector.SelectWithFilter(ctx, "Select", []string{"a", "b"}, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("SelectWithFilter() error = %v, want context.Canceled", err)
+	}
+	if result == nil || result.Action != ActionExit {
+		t.Errorf("SelectWithFilter() = %+v, want ActionExit", result)
+	}
+	if !promptDone.Load() {
+		t.Error("SelectWithFilter() returned while the prompt was still running")
+	}
+}
+
+// TestSelectWithFilterNoMatchReset tests that a filter matching nothing shows the
+// clear hint, and that Enter then shows the prompt again with the filter cleared
+func TestSelectWithFilterNoMatchReset(t *testing.T) {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	items := []string{"orders", "customers"}
+	var calls int
+	selector := &FilterablePromptSelector{
+		keys: newKeyReader(r),
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			calls++
+			sel := prompt.(*promptui.Select)
+			shown := sel.Items.([]string)
+			hint := sel.Templates.FuncMap["clearHint"].(func() string)
+
+			if slices.Contains(shown, ClearFilterHint) {
+				t.Errorf("call %d: items = %v, want no clear entry", calls, shown)
+			}
+			if hint() != "" {
+				t.Errorf("call %d: clear hint shown without a filter", calls)
+			}
+			if calls == 1 {
+				// Type a filter matching nothing, then press Enter
+				for i := range shown {
+					if sel.Searcher("zzz", i) {
+						t.Errorf("'zzz' matches %q", shown[i])
+					}
+				}
+				if hint() != ClearFilterHint {
+					t.Error("clear hint hidden while the filter matches nothing")
+				}
+				go w.Write([]byte("\r"))
+				return readUntilClosed(prompt)
+			}
+			// Enter on a matching filter selects as usual
+			if !sel.Searcher("cust", 2) || hint() != "" {
+				t.Error("filter 'cust' should match customers and hide the clear hint")
+			}
+			return 2, shown[2], nil
+		},
+	}
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select Table", items, true)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("prompt shown %d times, want 2", calls)
+	}
+	if result.Action != ActionSelect || result.Selected != "customers" {
+		t.Errorf("SelectWithFilter() = %+v, want Select customers", result)
+	}
+}
+
+// TestFilterStateClearedWithoutSearch tests that the keys with which promptui
+// clears the search without calling the searcher also clear the no-match state
+func TestFilterStateClearedWithoutSearch(t *testing.T) {
+	tests := []struct {
+		name      string
+		term      string
+		key       byte
+		wantClear bool
+	}{
+		{name: "search key", term: "zz", key: searchKey, wantClear: true},
+		{name: "backspace on the last character", term: "z", key: byte(promptui.KeyBackspace), wantClear: true},
+		{name: "backspace with characters left", term: "zz", key: byte(promptui.KeyBackspace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := newFilterState([]string{"orders"})
+			filter.searcher(tt.term, 0)
+			if _, ok := filter.keyAction([]byte{tt.key}); ok {
+				t.Fatalf("keyAction(%q) ended the prompt", tt.key)
+			}
+			if cleared := filter.hint() == ""; cleared != tt.wantClear {
+				t.Errorf("cleared = %v, want %v", cleared, tt.wantClear)
+			}
+			if action, ok := filter.keyAction([]byte("\r")); ok != !tt.wantClear || ok && action != ActionResetFilter {
+				t.Errorf("Enter = %v, %v, want reset only while nothing matches", action, ok)
+			}
+		})
+	}
+}
+
+// TestSelectWithFilterPromptTimeout tests that an idle prompt ends with ErrPromptTimeout
+// once the timeout elapses on the clock, without waiting for input
+func TestSelectWithFilterPromptTimeout(t *testing.T) {
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	selector := &FilterablePromptSelector{
+		clock:   clock,
+		keys:    idleKeys(t),
+		runFunc: readUntilClosed,
+	}
+	selector.SetPromptTimeout(5 * time.Minute)
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b"}, false)
+	if !errors.Is(err, ErrPromptTimeout) {
+		t.Fatalf("SelectWithFilter() error = %v, want ErrPromptTimeout", err)
+	}
+	if result == nil || result.Action != ActionExit {
+		t.Errorf("SelectWithFilter() result = %+v, want ActionExit", result)
+	}
+	if !reflect.DeepEqual(clock.sleeps, []time.Duration{5 * time.Minute}) {
+		t.Errorf("clock sleeps = %v, want [5m0s]", clock.sleeps)
+	}
+}
+
+// keyClock is a fakeClock that receives a key press 3 minutes into its first Sleep
+type keyClock struct {
+	*fakeClock
+	press func()
+	once  sync.Once
+}
+
+func (c *keyClock) Sleep(ctx context.Context, d time.Duration) error {
+	pressed := false
+	c.once.Do(func() {
+		c.fakeClock.Sleep(ctx, 3*time.Minute)
+		c.press()
+		c.fakeClock.Sleep(ctx, d-3*time.Minute)
+		pressed = true
+	})
+	if pressed {
+		return nil
+	}
+	return c.fakeClock.Sleep(ctx, d)
+}
+
+// TestSelectWithFilterPromptTimeoutRestartsOnInput tests that a key press restarts
+// the prompt timeout, so only a prompt idle for the whole timeout ends
+func TestSelectWithFilterPromptTimeoutRestartsOnInput(t *testing.T) {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	read := make(chan struct{})
+	clock := &keyClock{fakeClock: newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}
+	clock.press = func() {
+		w.Write([]byte("j"))
+		<-read
+	}
+	selector := &FilterablePromptSelector{
+		clock: clock,
+		keys:  newKeyReader(r),
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			stdin := prompt.(*promptui.Select).Stdin
+			buf := make([]byte, 8)
+			if _, err := stdin.Read(buf); err == nil {
+				close(read)
+			}
+			return readUntilClosed(prompt)
+		},
+	}
+	selector.SetPromptTimeout(5 * time.Minute)
+
+	_, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b"}, false)
+	if !errors.Is(err, ErrPromptTimeout) {
+		t.Fatalf("SelectWithFilter() error = %v, want ErrPromptTimeout", err)
+	}
+	// The key at 3m moves the deadline to 8m: 3m + 2m, then the remaining 3m
+	want := []time.Duration{3 * time.Minute, 2 * time.Minute, 3 * time.Minute}
+	if !reflect.DeepEqual(clock.sleeps, want) {
+		t.Errorf("clock sleeps = %v, want %v", clock.sleeps, want)
+	}
+}
+
+// TestSelectWithFilterAnswerBeforeTimeout tests that an answered prompt is not cut off by the timeout
+func TestSelectWithFilterAnswerBeforeTimeout(t *testing.T) {
+	selector := &FilterablePromptSelector{
+		clock: blockingClock{},
+		runFunc: func(prompt promptRunner) (int, string, error) {
+			return 1, "b", nil
+		},
+	}
+	selector.SetPromptTimeout(time.Minute)
+
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b"}, false)
+	if err != nil || result.Action != ActionSelect || result.Selected != "b" {
+		t.Errorf("SelectWithFilter() = %+v, %v, want Select b", result, err)
+	}
+}
+
+// TestSelectWithFilterEscapeGoesBack tests that a lone ESC key ends the prompt with
+// ActionBack, even when the back entry is hidden
+func TestSelectWithFilterEscapeGoesBack(t *testing.T) {
+	r, w := io.Pipe()
+	t.Cleanup(func() { w.Close() })
+	selector := &FilterablePromptSelector{
+		keys:    newKeyReader(r),
+		runFunc: readUntilClosed,
+	}
+
+	go w.Write([]byte{keyEscape})
+	result, err := selector.SelectWithFilter(context.Background(), "Select", []string{"a", "b"}, false)
+	if err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if result.Action != ActionBack {
+		t.Errorf("SelectWithFilter() Action = %v, want ActionBack", result.Action)
+	}
+}
+
+// blockingClock is a Clock whose Sleep only returns when ctx is done
+type blockingClock struct{}
+
+func (blockingClock) Now() time.Time { return time.Time{} }
+
+func (blockingClock) Sleep(ctx context.Context, d time.Duration) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// TestSelectWithFilterGlyphs tests the item markers of each glyph set in the prompt templates
+func TestSelectWithFilterGlyphs(t *testing.T) {
+	tests := []struct {
+		glyphs       Glyphs
+		wantActive   string
+		wantSelected string
+	}{
+		{glyphs: GlyphsUnicode, wantActive: promptui.IconSelect + " {{ . | underline }}", wantSelected: `{{ "` + promptui.IconGood + `" | green }} {{ . | faint }}`},
+		{glyphs: GlyphsASCII, wantActive: "> {{ . | underline }}", wantSelected: `{{ "*" | green }} {{ . | faint }}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.glyphs.String(), func(t *testing.T) {
+			var templates *promptui.SelectTemplates
+			selector := &FilterablePromptSelector{
+				runFunc: func(prompt promptRunner) (int, string, error) {
+					templates = prompt.(*promptui.Select).Templates
+					return 0, "orders", nil
+				},
+			}
+			selector.SetGlyphs(tt.glyphs)
+
+			if _, err := selector.SelectWithFilter(context.Background(), "Select Table", []string{"orders"}, false); err != nil {
+				t.Fatalf("SelectWithFilter() error = %v", err)
+			}
+			if templates.Active != tt.wantActive || templates.Selected != tt.wantSelected {
+				t.Errorf("templates = %q / %q, want %q / %q", templates.Active, templates.Selected, tt.wantActive, tt.wantSelected)
+			}
+			if templates.Inactive != "  {{ . }}" {
+				t.Errorf("Inactive = %q, want two-space indent", templates.Inactive)
+			}
+		})
+	}
+
+	if _, err := ParseGlyphs("emoji"); err == nil {
+		t.Error("ParseGlyphs(emoji) error = nil, want error")
+	}
+	if g, err := ParseGlyphs("ASCII"); err != nil || g != GlyphsASCII {
+		t.Errorf("ParseGlyphs(ASCII) = %v, %v, want ascii", g, err)
+	}
+}
@@ -0,0 +1,197 @@
+package s3tables
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// InputFormat identifies the file format of a hierarchy spec file
+type InputFormat string
+
+const (
+	// InputFormatYAML is a YAML list of HierarchySpec
+	InputFormatYAML InputFormat = "yaml"
+	// InputFormatJSON is a JSON array of HierarchySpec
+	InputFormatJSON InputFormat = "json"
+	// InputFormatCSV is a CSV file with the columns table_bucket,namespace,table
+	InputFormatCSV InputFormat = "csv"
+)
+
+// csvSpecColumns is the expected header of a CSV spec file
+var csvSpecColumns = []string{"table_bucket", "namespace", "table"}
+
+// ParseInputFormat validates an --input-format value
+func ParseInputFormat(s string) (InputFormat, error) {
+	switch f := InputFormat(strings.ToLower(s)); f {
+	case InputFormatYAML, InputFormatJSON, InputFormatCSV:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid input format '%s' (available: yaml, json, csv)", s)
+	}
+}
+
+// DetectInputFormat infers the input format from a file extension
+func DetectInputFormat(path string) (InputFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return InputFormatYAML, nil
+	case ".json":
+		return InputFormatJSON, nil
+	case ".csv":
+		return InputFormatCSV, nil
+	default:
+		return "", fmt.Errorf("cannot detect input format of '%s'; use --input-format yaml|json|csv", path)
+	}
+}
+
+// LoadSpecFile reads hierarchy specs from path. An empty format is detected from the extension.
+func LoadSpecFile(path string, format InputFormat) ([]HierarchySpec, error) {
+	if format == "" {
+		var err error
+		format, err = DetectInputFormat(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseSpecs(f, format)
+}
+
+// ParseSpecs parses hierarchy specs in the given format
+func ParseSpecs(r io.Reader, format InputFormat) ([]HierarchySpec, error) {
+	var specs []HierarchySpec
+	switch format {
+	case InputFormatYAML:
+		if err := yaml.NewDecoder(r).Decode(&specs); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("invalid YAML spec: %w", err)
+		}
+	case InputFormatJSON:
+		if err := json.NewDecoder(r).Decode(&specs); err != nil {
+			return nil, fmt.Errorf("invalid JSON spec: %w", err)
+		}
+	case InputFormatCSV:
+		return parseCSVSpecs(r)
+	default:
+		return nil, fmt.Errorf("unsupported input format '%s'", format)
+	}
+	return specs, nil
+}
+
+// parseCSVSpecs groups table_bucket,namespace,table rows into specs in first-seen order.
+// An empty table column declares a namespace without tables.
+func parseCSVSpecs(r io.Reader) ([]HierarchySpec, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV spec: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	if len(header) != len(csvSpecColumns) {
+		return nil, fmt.Errorf("invalid CSV spec: header must be %s", strings.Join(csvSpecColumns, ","))
+	}
+	for i, col := range csvSpecColumns {
+		if strings.TrimSpace(strings.ToLower(header[i])) != col {
+			return nil, fmt.Errorf("invalid CSV spec: header must be %s", strings.Join(csvSpecColumns, ","))
+		}
+	}
+
+	var specs []HierarchySpec
+	for _, rec := range records[1:] {
+		bucket, ns, table := strings.TrimSpace(rec[0]), strings.TrimSpace(rec[1]), strings.TrimSpace(rec[2])
+
+		bi := -1
+		for i := range specs {
+			if specs[i].TableBucket == bucket {
+				bi = i
+				break
+			}
+		}
+		if bi < 0 {
+			specs = append(specs, HierarchySpec{TableBucket: bucket})
+			bi = len(specs) - 1
+		}
+
+		spec := &specs[bi]
+		ni := -1
+		for i := range spec.Namespaces {
+			if spec.Namespaces[i].Name == ns {
+				ni = i
+				break
+			}
+		}
+		if ni < 0 {
+			spec.Namespaces = append(spec.Namespaces, NamespaceSpec{Name: ns})
+			ni = len(spec.Namespaces) - 1
+		}
+
+		if table != "" {
+			spec.Namespaces[ni].Tables = append(spec.Namespaces[ni].Tables, table)
+		}
+	}
+	return specs, nil
+}
+
+// ValidateSpecs checks every bucket, namespace and table name in specs with the
+// same rules as create, plus ValidateSpecUnique, and returns all problems found
+// instead of stopping at the first one
+func ValidateSpecs(specs []HierarchySpec) []error {
+	var problems []error
+	for _, spec := range specs {
+		if err := ValidateTableBucket(spec.TableBucket); err != nil {
+			problems = append(problems, fmt.Errorf("table bucket '%s': %w", spec.TableBucket, err))
+		}
+		for _, ns := range spec.Namespaces {
+			if err := ValidateNamespace(ns.Name); err != nil {
+				problems = append(problems, fmt.Errorf("namespace '%s/%s': %w", spec.TableBucket, ns.Name, err))
+			}
+			for _, table := range ns.Tables {
+				if err := ValidateTable(table); err != nil {
+					problems = append(problems, fmt.Errorf("table '%s/%s/%s': %w", spec.TableBucket, ns.Name, table, err))
+				}
+			}
+		}
+	}
+	return append(problems, ValidateSpecUnique(specs)...)
+}
+
+// ValidateSpecUnique reports namespaces listed more than once for a table bucket
+// and tables listed more than once in a namespace, across all specs
+func ValidateSpecUnique(specs []HierarchySpec) []error {
+	var problems []error
+	namespaces := make(map[string]bool)
+	tables := make(map[string]bool)
+	for _, spec := range specs {
+		for _, ns := range spec.Namespaces {
+			nsPath := spec.TableBucket + "/" + ns.Name
+			if namespaces[nsPath] {
+				problems = append(problems, fmt.Errorf("namespace '%s' is listed more than once", nsPath))
+			}
+			namespaces[nsPath] = true
+
+			for _, table := range ns.Tables {
+				tablePath := nsPath + "/" + table
+				if tables[tablePath] {
+					problems = append(problems, fmt.Errorf("table '%s' is listed more than once", tablePath))
+				}
+				tables[tablePath] = true
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,150 @@
+package s3tables
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// wantSpecs is the logical content shared by every format in the tests below
+var wantSpecs = []HierarchySpec{
+	{
+		TableBucket: "bucket-a",
+		Namespaces: []NamespaceSpec{
+			{Name: "sales", Tables: []string{"orders", "customers"}},
+			{Name: "staging"},
+		},
+	},
+	{
+		TableBucket: "bucket-b",
+		Namespaces: []NamespaceSpec{
+			{Name: "logs", Tables: []string{"events"}},
+		},
+	},
+}
+
+const yamlSpec = `
+- table_bucket: bucket-a
+  namespaces:
+    - name: sales
+      tables: [orders, customers]
+    - name: staging
+- table_bucket: bucket-b
+  namespaces:
+    - name: logs
+      tables:
+        - events
+`
+
+const jsonSpec = `[
+  {"table_bucket": "bucket-a", "namespaces": [
+    {"name": "sales", "tables": ["orders", "customers"]},
+    {"name": "staging"}
+  ]},
+  {"table_bucket": "bucket-b", "namespaces": [
+    {"name": "logs", "tables": ["events"]}
+  ]}
+]`
+
+const csvSpec = `table_bucket,namespace,table
+bucket-a,sales,orders
+bucket-a,sales,customers
+bucket-a,staging,
+bucket-b,logs,events
+`
+
+func TestParseSpecs(t *testing.T) {
+	tests := []struct {
+		format  InputFormat
+		content string
+	}{
+		{InputFormatYAML, yamlSpec},
+		{InputFormatJSON, jsonSpec},
+		{InputFormatCSV, csvSpec},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			specs, err := ParseSpecs(strings.NewReader(tt.content), tt.format)
+			if err != nil {
+				t.Fatalf("ParseSpecs() error = %v", err)
+			}
+			if !reflect.DeepEqual(specs, wantSpecs) {
+				t.Errorf("ParseSpecs() = %+v, want %+v", specs, wantSpecs)
+			}
+		})
+	}
+}
+
+func TestParseSpecs_CSVBadHeader(t *testing.T) {
+	_, err := ParseSpecs(strings.NewReader("bucket,ns,table\nb,n,t\n"), InputFormatCSV)
+	if err == nil {
+		t.Error("ParseSpecs() with wrong CSV header should fail")
+	}
+}
+
+func TestLoadSpecFile_DetectsFormat(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"spec.yml":  yamlSpec,
+		"spec.json": jsonSpec,
+		"spec.csv":  csvSpec,
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		specs, err := LoadSpecFile(path, "")
+		if err != nil {
+			t.Fatalf("LoadSpecFile(%s) error = %v", name, err)
+		}
+		if !reflect.DeepEqual(specs, wantSpecs) {
+			t.Errorf("LoadSpecFile(%s) = %+v, want %+v", name, specs, wantSpecs)
+		}
+	}
+
+	if _, err := LoadSpecFile(filepath.Join(dir, "spec.txt"), ""); err == nil {
+		t.Error("LoadSpecFile() with unknown extension should fail")
+	}
+}
+
+func TestParseInputFormat(t *testing.T) {
+	if f, err := ParseInputFormat("YAML"); err != nil || f != InputFormatYAML {
+		t.Errorf("ParseInputFormat(YAML) = %q, %v", f, err)
+	}
+	if _, err := ParseInputFormat("toml"); err == nil {
+		t.Error("ParseInputFormat(toml) should fail")
+	}
+}
+
+func TestValidateSpecs(t *testing.T) {
+	specs := []HierarchySpec{
+		{TableBucket: "Bad_Bucket", Namespaces: []NamespaceSpec{{Name: "sales", Tables: []string{"orders", "Orders-2"}}}},
+		{TableBucket: "good-bucket", Namespaces: []NamespaceSpec{
+			{Name: "sales", Tables: []string{"orders", "orders"}},
+			{Name: "sales"},
+		}},
+	}
+
+	var got []string
+	for _, problem := range ValidateSpecs(specs) {
+		got = append(got, problem.Error())
+	}
+	want := []string{
+		"table bucket 'Bad_Bucket': invalid table-bucket: must contain only lowercase letters, numbers, and hyphens",
+		"table 'Bad_Bucket/sales/Orders-2': invalid table: must contain only lowercase letters, numbers, and underscores",
+		"table 'good-bucket/sales/orders' is listed more than once",
+		"namespace 'good-bucket/sales' is listed more than once",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateSpecs() =\n%v\nwant\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if problems := ValidateSpecs(specs[:0]); len(problems) != 0 {
+		t.Errorf("ValidateSpecs(nil) = %v, want none", problems)
+	}
+}
@@ -0,0 +1,104 @@
+package s3tables
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// BucketSummary aggregates the contents of one table bucket
+type BucketSummary struct {
+	TableBucketARN string         `json:"table_bucket_arn"`
+	Namespaces     int            `json:"namespaces"`
+	Tables         int            `json:"tables"`
+	TablesByType   map[string]int `json:"tables_by_type"`           // Table 種別ごとの件数
+	OldestTable    time.Time      `json:"oldest_table_at,omitzero"` // 最も古い Table の作成日時（Table がない場合はゼロ値）
+	NewestTable    time.Time      `json:"newest_table_at,omitzero"` // 最も新しい Table の作成日時（Table がない場合はゼロ値）
+}
+
+// NamespaceSummary aggregates the tables of one namespace
+type NamespaceSummary struct {
+	Namespace    string         `json:"namespace"`
+	Tables       int            `json:"tables"`
+	TablesByType map[string]int `json:"tables_by_type"` // Table 種別ごとの件数
+}
+
+// SummarizeBucket walks the namespaces and tables of a table bucket and computes
+// its aggregates. Tables are listed concurrently with at most countConcurrency
+// calls in flight, like CountRecursive.
+func (l *S3TablesLister) SummarizeBucket(ctx context.Context, bucketARN string) (*BucketSummary, error) {
+	summary, _, err := l.SummarizeNamespaces(ctx, bucketARN)
+	return summary, err
+}
+
+// SummarizeNamespaces is SummarizeBucket that also returns the aggregates of
+// every namespace, sorted by namespace name
+func (l *S3TablesLister) SummarizeNamespaces(ctx context.Context, bucketARN string) (*BucketSummary, []NamespaceSummary, error) {
+	namespaces, err := l.ListNamespacesAll(ctx, bucketARN, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tables := make([][]TableInfo, len(namespaces))
+	err = runBounded(len(namespaces), func(i int) error {
+		var err error
+		tables[i], err = l.ListTablesAll(ctx, bucketARN, namespaces[i].Name, "")
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary := &BucketSummary{
+		TableBucketARN: bucketARN,
+		Namespaces:     len(namespaces),
+		TablesByType:   make(map[string]int),
+	}
+	perNamespace := make([]NamespaceSummary, len(namespaces))
+	for i, nsTables := range tables {
+		perNamespace[i] = NamespaceSummary{Namespace: namespaces[i].Name, TablesByType: make(map[string]int)}
+		for _, tbl := range nsTables {
+			summary.add(tbl)
+			perNamespace[i].Tables++
+			perNamespace[i].TablesByType[tbl.Type]++
+		}
+	}
+	sort.Slice(perNamespace, func(i, j int) bool { return perNamespace[i].Namespace < perNamespace[j].Namespace })
+	return summary, perNamespace, nil
+}
+
+// add counts tbl and widens the creation time range. Tables without a creation
+// time are counted but do not affect the range.
+func (s *BucketSummary) add(tbl TableInfo) {
+	s.Tables++
+	s.TablesByType[tbl.Type]++
+
+	if tbl.CreatedAt.IsZero() {
+		return
+	}
+	if s.OldestTable.IsZero() || tbl.CreatedAt.Before(s.OldestTable) {
+		s.OldestTable = tbl.CreatedAt
+	}
+	if tbl.CreatedAt.After(s.NewestTable) {
+		s.NewestTable = tbl.CreatedAt
+	}
+}
+
+// Merge adds the counts of other to s and widens the creation time range,
+// for aggregating several table buckets. TableBucketARN is left unchanged.
+func (s *BucketSummary) Merge(other *BucketSummary) {
+	s.Namespaces += other.Namespaces
+	s.Tables += other.Tables
+	if s.TablesByType == nil {
+		s.TablesByType = make(map[string]int)
+	}
+	for tableType, n := range other.TablesByType {
+		s.TablesByType[tableType] += n
+	}
+	if !other.OldestTable.IsZero() && (s.OldestTable.IsZero() || other.OldestTable.Before(s.OldestTable)) {
+		s.OldestTable = other.OldestTable
+	}
+	if other.NewestTable.After(s.NewestTable) {
+		s.NewestTable = other.NewestTable
+	}
+}
@@ -0,0 +1,125 @@
+package s3tables
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// newSummaryMock returns a mock serving namespace → tables for a single bucket
+func newSummaryMock(tables map[string][]types.TableSummary) *FuncMockS3TablesAPI {
+	return &FuncMockS3TablesAPI{
+		ListNamespacesFunc: func(ctx context.Context, params *s3tables.ListNamespacesInput) (*s3tables.ListNamespacesOutput, error) {
+			var summaries []types.NamespaceSummary
+			for ns := range tables {
+				summaries = append(summaries, types.NamespaceSummary{Namespace: []string{ns}})
+			}
+			return &s3tables.ListNamespacesOutput{Namespaces: summaries}, nil
+		},
+		ListTablesFunc: func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+			return &s3tables.ListTablesOutput{Tables: tables[aws.ToString(params.Namespace)]}, nil
+		},
+	}
+}
+
+// TestSummarizeBucket tests the counts, type breakdown and creation time range
+func TestSummarizeBucket(t *testing.T) {
+	oldest := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	middle := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	newest := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	table := func(name string, created time.Time, tableType types.TableType) types.TableSummary {
+		return types.TableSummary{Name: aws.String(name), CreatedAt: aws.Time(created), Type: tableType}
+	}
+
+	mock := newSummaryMock(map[string][]types.TableSummary{
+		"sales": {
+			table("orders", middle, types.TableTypeCustomer),
+			table("refunds", newest, types.TableTypeCustomer),
+		},
+		"logs": {
+			table("access", oldest, types.TableTypeAws),
+		},
+		"empty": nil,
+	})
+	lister := NewS3TablesLister(mock)
+
+	got, err := lister.SummarizeBucket(context.Background(), counterTestARNPrefix+"bucket-a")
+	if err != nil {
+		t.Fatalf("SummarizeBucket() error = %v", err)
+	}
+
+	want := &BucketSummary{
+		TableBucketARN: counterTestARNPrefix + "bucket-a",
+		Namespaces:     3,
+		Tables:         3,
+		TablesByType:   map[string]int{"customer": 2, "aws": 1},
+		OldestTable:    oldest,
+		NewestTable:    newest,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SummarizeBucket() = %+v, want %+v", got, want)
+	}
+}
+
+// TestSummarizeNamespaces tests the per-namespace counts and that they add up to the bucket total
+func TestSummarizeNamespaces(t *testing.T) {
+	table := func(name string, tableType types.TableType) types.TableSummary {
+		return types.TableSummary{Name: aws.String(name), Type: tableType}
+	}
+	mock := newSummaryMock(map[string][]types.TableSummary{
+		"sales": {table("orders", types.TableTypeCustomer), table("refunds", types.TableTypeCustomer)},
+		"logs":  {table("access", types.TableTypeAws)},
+		"empty": nil,
+	})
+
+	summary, perNamespace, err := NewS3TablesLister(mock).SummarizeNamespaces(context.Background(), counterTestARNPrefix+"bucket-a")
+	if err != nil {
+		t.Fatalf("SummarizeNamespaces() error = %v", err)
+	}
+
+	want := []NamespaceSummary{
+		{Namespace: "empty", Tables: 0, TablesByType: map[string]int{}},
+		{Namespace: "logs", Tables: 1, TablesByType: map[string]int{"aws": 1}},
+		{Namespace: "sales", Tables: 2, TablesByType: map[string]int{"customer": 2}},
+	}
+	if !reflect.DeepEqual(perNamespace, want) {
+		t.Errorf("SummarizeNamespaces() per namespace = %+v, want %+v", perNamespace, want)
+	}
+	if summary.Namespaces != 3 || summary.Tables != 3 {
+		t.Errorf("SummarizeNamespaces() summary = %+v, want 3 namespaces and 3 tables", summary)
+	}
+}
+
+// TestSummarizeBucketEmpty tests that a bucket without tables has no creation time range
+func TestSummarizeBucketEmpty(t *testing.T) {
+	lister := NewS3TablesLister(newSummaryMock(map[string][]types.TableSummary{"empty": nil}))
+
+	got, err := lister.SummarizeBucket(context.Background(), counterTestARNPrefix+"bucket-a")
+	if err != nil {
+		t.Fatalf("SummarizeBucket() error = %v", err)
+	}
+	if got.Namespaces != 1 || got.Tables != 0 || len(got.TablesByType) != 0 {
+		t.Errorf("SummarizeBucket() = %+v, want 1 namespace and no tables", got)
+	}
+	if !got.OldestTable.IsZero() || !got.NewestTable.IsZero() {
+		t.Errorf("time range = %v..%v, want zero", got.OldestTable, got.NewestTable)
+	}
+}
+
+// TestSummarizeBucketListError tests that a failing table listing aborts the summary
+func TestSummarizeBucketListError(t *testing.T) {
+	mock := newSummaryMock(map[string][]types.TableSummary{"sales": nil})
+	mock.ListTablesFunc = func(ctx context.Context, params *s3tables.ListTablesInput) (*s3tables.ListTablesOutput, error) {
+		return nil, errors.New("boom")
+	}
+
+	if _, err := NewS3TablesLister(mock).SummarizeBucket(context.Background(), counterTestARNPrefix+"bucket-a"); err == nil {
+		t.Error("SummarizeBucket() expected error")
+	}
+}
@@ -0,0 +1,211 @@
+package s3tables
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+)
+
+// Timings accumulates where a command spends its time: in API calls made through
+// a client wrapped with NewTimedClient, and in prompts of a selector wrapped with
+// NewTimedSelector. It is safe for concurrent use.
+type Timings struct {
+	clock Clock
+	start time.Time
+
+	mu          sync.Mutex
+	api         time.Duration
+	apiCalls    int
+	interactive time.Duration
+}
+
+// TimingReport is a snapshot of Timings. API sums the duration of every call, so it
+// can exceed Total when calls run concurrently; Other is then 0.
+type TimingReport struct {
+	Total       time.Duration
+	API         time.Duration
+	APICalls    int
+	Interactive time.Duration
+	Other       time.Duration
+}
+
+// NewTimings starts measuring the total time on clock (nil uses the package clock)
+func NewTimings(clock Clock) *Timings {
+	clock = clockOr(clock)
+	return &Timings{clock: clock, start: clock.Now()}
+}
+
+// measure runs fn and returns how long it took on the timings clock
+func (t *Timings) measure(fn func()) time.Duration {
+	start := t.clock.Now()
+	fn()
+	return t.clock.Now().Sub(start)
+}
+
+// AddAPI records one API call that took d
+func (t *Timings) AddAPI(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.api += d
+	t.apiCalls++
+}
+
+// AddInteractive records d spent waiting for user input
+func (t *Timings) AddInteractive(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactive += d
+}
+
+// Report returns the time measured so far
+func (t *Timings) Report() TimingReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	report := TimingReport{
+		Total:       t.clock.Now().Sub(t.start),
+		API:         t.api,
+		APICalls:    t.apiCalls,
+		Interactive: t.interactive,
+	}
+	report.Other = max(report.Total-report.API-report.Interactive, 0)
+	return report
+}
+
+// String formats the report as a single line, e.g.
+// "Timings: total 3.2s (API 1.1s in 4 calls, interactive 1.9s, other 200ms)"
+func (r TimingReport) String() string {
+	return fmt.Sprintf("Timings: total %s (API %s in %d calls, interactive %s, other %s)",
+		roundDuration(r.Total), roundDuration(r.API), r.APICalls, roundDuration(r.Interactive), roundDuration(r.Other))
+}
+
+// roundDuration rounds d to milliseconds so that reports stay readable
+func roundDuration(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
+
+// timedClient is an S3TablesAPI that records the duration of every call in Timings
+type timedClient struct {
+	client  S3TablesAPI
+	timings *Timings
+}
+
+// NewTimedClient wraps client so that the duration of every call is added to timings
+func NewTimedClient(client S3TablesAPI, timings *Timings) S3TablesAPI {
+	return &timedClient{client: client, timings: timings}
+}
+
+// timeCall runs call and records its duration as API time
+func timeCall[T any](t *timedClient, call func() (T, error)) (T, error) {
+	var out T
+	var err error
+	t.timings.AddAPI(t.timings.measure(func() { out, err = call() }))
+	return out, err
+}
+
+func (t *timedClient) ListTableBuckets(ctx context.Context, params *s3tables.ListTableBucketsInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTableBucketsOutput, error) {
+	return timeCall(t, func() (*s3tables.ListTableBucketsOutput, error) {
+		return t.client.ListTableBuckets(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) GetTableBucket(ctx context.Context, params *s3tables.GetTableBucketInput, optFns ...func(*s3tables.Options)) (*s3tables.GetTableBucketOutput, error) {
+	return timeCall(t, func() (*s3tables.GetTableBucketOutput, error) {
+		return t.client.GetTableBucket(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) CreateTableBucket(ctx context.Context, params *s3tables.CreateTableBucketInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateTableBucketOutput, error) {
+	return timeCall(t, func() (*s3tables.CreateTableBucketOutput, error) {
+		return t.client.CreateTableBucket(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) GetNamespace(ctx context.Context, params *s3tables.GetNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.GetNamespaceOutput, error) {
+	return timeCall(t, func() (*s3tables.GetNamespaceOutput, error) {
+		return t.client.GetNamespace(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) CreateNamespace(ctx context.Context, params *s3tables.CreateNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateNamespaceOutput, error) {
+	return timeCall(t, func() (*s3tables.CreateNamespaceOutput, error) {
+		return t.client.CreateNamespace(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) GetTable(ctx context.Context, params *s3tables.GetTableInput, optFns ...func(*s3tables.Options)) (*s3tables.GetTableOutput, error) {
+	return timeCall(t, func() (*s3tables.GetTableOutput, error) {
+		return t.client.GetTable(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) CreateTable(ctx context.Context, params *s3tables.CreateTableInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateTableOutput, error) {
+	return timeCall(t, func() (*s3tables.CreateTableOutput, error) {
+		return t.client.CreateTable(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) ListNamespaces(ctx context.Context, params *s3tables.ListNamespacesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListNamespacesOutput, error) {
+	return timeCall(t, func() (*s3tables.ListNamespacesOutput, error) {
+		return t.client.ListNamespaces(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) ListTables(ctx context.Context, params *s3tables.ListTablesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTablesOutput, error) {
+	return timeCall(t, func() (*s3tables.ListTablesOutput, error) {
+		return t.client.ListTables(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) DeleteNamespace(ctx context.Context, params *s3tables.DeleteNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteNamespaceOutput, error) {
+	return timeCall(t, func() (*s3tables.DeleteNamespaceOutput, error) {
+		return t.client.DeleteNamespace(ctx, params, optFns...)
+	})
+}
+
+func (t *timedClient) DeleteTable(ctx context.Context, params *s3tables.DeleteTableInput, optFns ...func(*s3tables.Options)) (*s3tables.DeleteTableOutput, error) {
+	return timeCall(t, func() (*s3tables.DeleteTableOutput, error) {
+		return t.client.DeleteTable(ctx, params, optFns...)
+	})
+}
+
+// timedSelector is an InteractiveSelector that records prompt time in Timings
+type timedSelector struct {
+	selector InteractiveSelector
+	timings  *Timings
+}
+
+// NewTimedSelector wraps selector so that the time spent in prompts is added to timings
+func NewTimedSelector(selector InteractiveSelector, timings *Timings) InteractiveSelector {
+	return &timedSelector{selector: selector, timings: timings}
+}
+
+func (t *timedSelector) SelectWithFilter(ctx context.Context, label string, items []string, showBack bool) (*SelectionResult, error) {
+	var result *SelectionResult
+	var err error
+	t.timings.AddInteractive(t.timings.measure(func() { result, err = t.selector.SelectWithFilter(ctx, label, items, showBack) }))
+	return result, err
+}
+
+// TimedInput wraps a text prompt so that the time spent answering it is added to timings
+func TimedInput(input func(ctx context.Context, label string) (string, error), timings *Timings) func(ctx context.Context, label string) (string, error) {
+	return func(ctx context.Context, label string) (string, error) {
+		var text string
+		var err error
+		timings.AddInteractive(timings.measure(func() { text, err = input(ctx, label) }))
+		return text, err
+	}
+}
+
+// TimedConfirm wraps a confirmation prompt so that the time spent answering it is
+// added to timings
+func TimedConfirm(confirm func(ctx context.Context, label string) (bool, error), timings *Timings) func(ctx context.Context, label string) (bool, error) {
+	return func(ctx context.Context, label string) (bool, error) {
+		var ok bool
+		var err error
+		timings.AddInteractive(timings.measure(func() { ok, err = confirm(ctx, label) }))
+		return ok, err
+	}
+}
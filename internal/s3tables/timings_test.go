@@ -0,0 +1,80 @@
+package s3tables
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+)
+
+// TestTimingsReport tests the totals reported for controlled API, prompt and other durations
+func TestTimingsReport(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	timings := NewTimings(clock)
+
+	client := NewTimedClient(&FuncMockS3TablesAPI{
+		ListTableBucketsFunc: func(ctx context.Context, params *s3tables.ListTableBucketsInput) (*s3tables.ListTableBucketsOutput, error) {
+			_ = clock.Sleep(ctx, 300*time.Millisecond)
+			return &s3tables.ListTableBucketsOutput{}, nil
+		},
+		GetTableFunc: func(ctx context.Context, params *s3tables.GetTableInput) (*s3tables.GetTableOutput, error) {
+			_ = clock.Sleep(ctx, 200*time.Millisecond)
+			return &s3tables.GetTableOutput{}, nil
+		},
+	}, timings)
+	selector := NewTimedSelector(&MockInteractiveSelector{
+		SelectWithFilterFunc: func(label string, items []string, showBack bool) (*SelectionResult, error) {
+			_ = clock.Sleep(ctx, 4*time.Second)
+			return &SelectionResult{Selected: items[0], Action: ActionSelect}, nil
+		},
+	}, timings)
+	confirm := TimedConfirm(func(ctx context.Context, label string) (bool, error) {
+		_ = clock.Sleep(ctx, time.Second)
+		return true, nil
+	}, timings)
+	input := TimedInput(func(ctx context.Context, label string) (string, error) {
+		_ = clock.Sleep(ctx, 2*time.Second)
+		return "ord", nil
+	}, timings)
+
+	if _, err := client.ListTableBuckets(ctx, &s3tables.ListTableBucketsInput{}); err != nil {
+		t.Fatalf("ListTableBuckets() error = %v", err)
+	}
+	if _, err := selector.SelectWithFilter(ctx, "Select Table", []string{"orders"}, false); err != nil {
+		t.Fatalf("SelectWithFilter() error = %v", err)
+	}
+	if _, err := client.GetTable(ctx, &s3tables.GetTableInput{}); err != nil {
+		t.Fatalf("GetTable() error = %v", err)
+	}
+	if _, err := confirm(context.Background(), "Delete?"); err != nil {
+		t.Fatalf("confirm() error = %v", err)
+	}
+	if _, err := input(ctx, "table prefix"); err != nil {
+		t.Fatalf("input() error = %v", err)
+	}
+	_ = clock.Sleep(ctx, 1500*time.Millisecond) // 出力の整形など API・入力以外の時間
+
+	got := timings.Report()
+	want := TimingReport{Total: 9 * time.Second, API: 500 * time.Millisecond, APICalls: 2, Interactive: 7 * time.Second, Other: 1500 * time.Millisecond}
+	if got != want {
+		t.Errorf("Report() = %+v, want %+v", got, want)
+	}
+	if s := got.String(); s != "Timings: total 9s (API 500ms in 2 calls, interactive 7s, other 1.5s)" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+// TestTimingsReportConcurrentAPI tests that overlapping API time never yields negative other time
+func TestTimingsReportConcurrentAPI(t *testing.T) {
+	clock := newFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	timings := NewTimings(clock)
+	timings.AddAPI(3 * time.Second)
+	timings.AddAPI(3 * time.Second)
+	_ = clock.Sleep(context.Background(), 4*time.Second)
+
+	if got := timings.Report(); got.Total != 4*time.Second || got.API != 6*time.Second || got.Other != 0 {
+		t.Errorf("Report() = %+v, want total 4s, API 6s, other 0", got)
+	}
+}
@@ -0,0 +1,95 @@
+package s3tables
+
+import (
+	"fmt"
+	"io"
+)
+
+// treeGlyphs holds the connector strings used to draw a tree
+type treeGlyphs struct {
+	branch string // 途中の子要素
+	last   string // 最後の子要素
+	pipe   string // 続きがある階層の縦線
+	blank  string // 続きがない階層の空白
+}
+
+var (
+	unicodeGlyphs = treeGlyphs{branch: "├── ", last: "└── ", pipe: "│   ", blank: "    "}
+	asciiGlyphs   = treeGlyphs{branch: "|-- ", last: "`-- ", pipe: "|   ", blank: "    "}
+)
+
+// TreeRenderer renders a HierarchySpec as an indented tree like the tree command
+type TreeRenderer struct {
+	// ASCII uses plain ASCII connectors instead of box-drawing characters
+	ASCII bool
+	// Depth limits how many levels below the bucket are drawn (1: namespaces only); 0 means unlimited
+	Depth int
+	// Compact draws a namespace holding exactly one table on a single "namespace/table" line
+	Compact bool
+}
+
+// RenderTree renders spec to w using box-drawing characters
+func RenderTree(w io.Writer, spec HierarchySpec) error {
+	return (&TreeRenderer{}).Render(w, spec)
+}
+
+// Render writes the tree for spec to w
+func (r *TreeRenderer) Render(w io.Writer, spec HierarchySpec) error {
+	glyphs := unicodeGlyphs
+	if r.ASCII {
+		glyphs = asciiGlyphs
+	}
+
+	if _, err := fmt.Fprintln(w, spec.TableBucket); err != nil {
+		return err
+	}
+
+	if r.Depth == 1 {
+		return r.renderNamespacesOnly(w, spec, glyphs)
+	}
+
+	for i, ns := range spec.Namespaces {
+		nsLast := i == len(spec.Namespaces)-1
+		connector, indent := glyphs.branch, glyphs.pipe
+		if nsLast {
+			connector, indent = glyphs.last, glyphs.blank
+		}
+
+		if r.Compact && len(ns.Tables) == 1 {
+			if _, err := fmt.Fprintf(w, "%s%s/%s\n", connector, ns.Name, ns.Tables[0]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "%s%s\n", connector, ns.Name); err != nil {
+			return err
+		}
+
+		for j, table := range ns.Tables {
+			tblConnector := glyphs.branch
+			if j == len(ns.Tables)-1 {
+				tblConnector = glyphs.last
+			}
+			if _, err := fmt.Fprintf(w, "%s%s%s\n", indent, tblConnector, table); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// renderNamespacesOnly draws the bucket and its namespaces without tables
+func (r *TreeRenderer) renderNamespacesOnly(w io.Writer, spec HierarchySpec, glyphs treeGlyphs) error {
+	for i, ns := range spec.Namespaces {
+		connector := glyphs.branch
+		if i == len(spec.Namespaces)-1 {
+			connector = glyphs.last
+		}
+		if _, err := fmt.Fprintf(w, "%s%s\n", connector, ns.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package s3tables
+
+import (
+	"bytes"
+	"testing"
+)
+
+// treeTestSpec is a small fixed hierarchy used by tree rendering tests
+var treeTestSpec = HierarchySpec{
+	TableBucket: "my-bucket",
+	Namespaces: []NamespaceSpec{
+		{Name: "analytics", Tables: []string{"events", "sessions"}},
+		{Name: "empty_ns", Tables: []string{}},
+		{Name: "sales", Tables: []string{"orders"}},
+	},
+}
+
+func TestRenderTree(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderTree(&buf, treeTestSpec); err != nil {
+		t.Fatalf("RenderTree() error = %v", err)
+	}
+
+	want := `my-bucket
+├── analytics
+│   ├── events
+│   └── sessions
+├── empty_ns
+└── sales
+    └── orders
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderTree() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTreeRendererASCII(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := &TreeRenderer{ASCII: true}
+	if err := renderer.Render(&buf, treeTestSpec); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := "my-bucket\n" +
+		"|-- analytics\n" +
+		"|   |-- events\n" +
+		"|   `-- sessions\n" +
+		"|-- empty_ns\n" +
+		"`-- sales\n" +
+		"    `-- orders\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTreeRendererDepth(t *testing.T) {
+	tests := []struct {
+		name  string
+		depth int
+		want  string
+	}{
+		{
+			name:  "depth 1 shows namespaces only",
+			depth: 1,
+			want: `my-bucket
+├── analytics
+├── empty_ns
+└── sales
+`,
+		},
+		{
+			name:  "depth 2 shows the full hierarchy",
+			depth: 2,
+			want: `my-bucket
+├── analytics
+│   ├── events
+│   └── sessions
+├── empty_ns
+└── sales
+    └── orders
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			renderer := &TreeRenderer{Depth: tt.depth}
+			if err := renderer.Render(&buf, treeTestSpec); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTreeRendererCompact(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := &TreeRenderer{Compact: true}
+	if err := renderer.Render(&buf, treeTestSpec); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := `my-bucket
+├── analytics
+│   ├── events
+│   └── sessions
+├── empty_ns
+└── sales/orders
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+
+	// 深さ 1 ではテーブルを描かないため、まとめ表示も行わない
+	buf.Reset()
+	renderer.Depth = 1
+	if err := renderer.Render(&buf, treeTestSpec); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got := buf.String(); got != "my-bucket\n├── analytics\n├── empty_ns\n└── sales\n" {
+		t.Errorf("Render() with Depth 1 =\n%s", got)
+	}
+}
+
+func TestRenderTreeEmptyBucket(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderTree(&buf, HierarchySpec{TableBucket: "empty-bucket"}); err != nil {
+		t.Fatalf("RenderTree() error = %v", err)
+	}
+	if got := buf.String(); got != "empty-bucket\n" {
+		t.Errorf("RenderTree() = %q, want %q", got, "empty-bucket\n")
+	}
+}
@@ -3,6 +3,7 @@ package s3tables
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -14,6 +15,9 @@ var (
 	tablePattern = regexp.MustCompile(`^[0-9a-z_]+$`)
 )
 
+// maxTableBucketLength is the longest Table Bucket name AWS accepts
+const maxTableBucketLength = 63
+
 // ValidationError represents a validation error with field name and reason
 type ValidationError struct {
 	Field   string
@@ -34,13 +38,16 @@ func ValidateTableBucket(name string) error {
 			Message: "must be at least 3 characters",
 		}
 	}
-	if len(name) > 63 {
+	if len(name) > maxTableBucketLength {
 		return &ValidationError{
 			Field:   "table-bucket",
 			Message: "must be at most 63 characters",
 		}
 	}
 	if !tableBucketPattern.MatchString(name) {
+		if err := uppercaseError("table-bucket", name, ValidateTableBucket); err != nil {
+			return err
+		}
 		return &ValidationError{
 			Field:   "table-bucket",
 			Message: "must contain only lowercase letters, numbers, and hyphens",
@@ -66,6 +73,9 @@ func ValidateNamespace(name string) error {
 		}
 	}
 	if !namespacePattern.MatchString(name) {
+		if err := uppercaseError("namespace", name, ValidateNamespace); err != nil {
+			return err
+		}
 		return &ValidationError{
 			Field:   "namespace",
 			Message: "must contain only lowercase letters, numbers, and underscores",
@@ -91,6 +101,9 @@ func ValidateTable(name string) error {
 		}
 	}
 	if !tablePattern.MatchString(name) {
+		if err := uppercaseError("table", name, ValidateTable); err != nil {
+			return err
+		}
 		return &ValidationError{
 			Field:   "table",
 			Message: "must contain only lowercase letters, numbers, and underscores",
@@ -99,6 +112,20 @@ func ValidateTable(name string) error {
 	return nil
 }
 
+// uppercaseError returns the error for a name whose only problem is uppercase
+// letters, suggesting its lowercase form. It returns nil when the lowercase form
+// would not pass validate either.
+func uppercaseError(field, name string, validate func(string) error) error {
+	lower := strings.ToLower(name)
+	if lower == name || validate(lower) != nil {
+		return nil
+	}
+	return &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf("contains uppercase letters; names must be lowercase (did you mean '%s'?)", lower),
+	}
+}
+
 // ValidateAll validates all input parameters for the create command
 func ValidateAll(tableBucket, namespace, table string) error {
 	if err := ValidateTableBucket(tableBucket); err != nil {
@@ -112,3 +139,74 @@ func ValidateAll(tableBucket, namespace, table string) error {
 	}
 	return nil
 }
+
+// nameConstraint describes the AWS naming constraint for a resource field
+type nameConstraint struct {
+	minLen      int
+	maxLen      int
+	allowedDesc string
+	allowed     func(r rune) bool
+}
+
+// nameConstraints maps validation field names to their AWS constraints
+var nameConstraints = map[string]nameConstraint{
+	"table-bucket": {
+		minLen:      3,
+		maxLen:      63,
+		allowedDesc: "lowercase letters (a-z), numbers (0-9), and hyphens (-)",
+		allowed:     func(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' },
+	},
+	"namespace": {
+		minLen:      1,
+		maxLen:      255,
+		allowedDesc: "lowercase letters (a-z), numbers (0-9), and underscores (_)",
+		allowed:     func(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' },
+	},
+	"table": {
+		minLen:      1,
+		maxLen:      255,
+		allowedDesc: "lowercase letters (a-z), numbers (0-9), and underscores (_)",
+		allowed:     func(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' },
+	},
+}
+
+// ExplainValidation returns a detailed, multi-line explanation of the AWS naming
+// constraint for field and how value violates it, including the positions of
+// any disallowed characters. Positions are 1-based.
+func ExplainValidation(field, value string) string {
+	constraint, ok := nameConstraints[field]
+	if !ok {
+		return fmt.Sprintf("no naming constraint is known for %s", field)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q\n", field, value)
+	fmt.Fprintf(&b, "  allowed characters: %s\n", constraint.allowedDesc)
+	fmt.Fprintf(&b, "  allowed length:     %d-%d characters\n", constraint.minLen, constraint.maxLen)
+
+	length := len(value)
+	switch {
+	case length < constraint.minLen:
+		fmt.Fprintf(&b, "  length:             %d (too short, need at least %d)\n", length, constraint.minLen)
+	case length > constraint.maxLen:
+		fmt.Fprintf(&b, "  length:             %d (too long, %d over the limit)\n", length, length-constraint.maxLen)
+	default:
+		fmt.Fprintf(&b, "  length:             %d (ok)\n", length)
+	}
+
+	var invalid []string
+	for i, r := range []rune(value) {
+		if !constraint.allowed(r) {
+			invalid = append(invalid, fmt.Sprintf("    position %d: %q", i+1, r))
+		}
+	}
+	if len(invalid) == 0 {
+		b.WriteString("  characters:         ok\n")
+	} else {
+		b.WriteString("  invalid characters:\n")
+		b.WriteString(strings.Join(invalid, "\n"))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -1,6 +1,9 @@
 package s3tables
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestValidationError_Error(t *testing.T) {
 	tests := []struct {
@@ -98,3 +101,104 @@ func TestValidateAll(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateUppercaseHint tests that names whose only problem is uppercase letters
+// get a hint with the lowercase name, while other invalid names keep the generic message
+func TestValidateUppercaseHint(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string) error
+		value    string
+		want     string
+	}{
+		{"camelCase bucket", ValidateTableBucket, "myBucket-01", "invalid table-bucket: contains uppercase letters; names must be lowercase (did you mean 'mybucket-01'?)"},
+		{"camelCase namespace", ValidateNamespace, "salesData", "invalid namespace: contains uppercase letters; names must be lowercase (did you mean 'salesdata'?)"},
+		{"uppercase table", ValidateTable, "DAILY_ORDERS", "invalid table: contains uppercase letters; names must be lowercase (did you mean 'daily_orders'?)"},
+		{"uppercase and underscore bucket", ValidateTableBucket, "My_bucket", "invalid table-bucket: must contain only lowercase letters, numbers, and hyphens"},
+		{"uppercase and hyphen table", ValidateTable, "Orders-2", "invalid table: must contain only lowercase letters, numbers, and underscores"},
+		{"short uppercase bucket", ValidateTableBucket, "AB", "invalid table-bucket: must be at least 3 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate(tt.value)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("validate(%q) error = %v, want %q", tt.value, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplainValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		field        string
+		value        string
+		wantContains []string
+		wantAbsent   []string
+	}{
+		{
+			name:  "bucket with uppercase and underscore",
+			field: "table-bucket",
+			value: "My_bucket",
+			wantContains: []string{
+				`table-bucket "My_bucket"`,
+				"allowed characters: lowercase letters (a-z), numbers (0-9), and hyphens (-)",
+				"allowed length:     3-63 characters",
+				"length:             9 (ok)",
+				"position 1: 'M'",
+				"position 3: '_'",
+			},
+		},
+		{
+			name:  "bucket too short",
+			field: "table-bucket",
+			value: "ab",
+			wantContains: []string{
+				"length:             2 (too short, need at least 3)",
+				"characters:         ok",
+			},
+			wantAbsent: []string{"invalid characters"},
+		},
+		{
+			name:  "namespace with hyphen",
+			field: "namespace",
+			value: "my-namespace",
+			wantContains: []string{
+				"underscores (_)",
+				"1-255 characters",
+				"position 3: '-'",
+			},
+		},
+		{
+			name:  "table too long with dot",
+			field: "table",
+			value: strings.Repeat("a", 256) + ".",
+			wantContains: []string{
+				"length:             257 (too long, 2 over the limit)",
+				"position 257: '.'",
+			},
+		},
+		{
+			name:         "unknown field",
+			field:        "bogus",
+			value:        "x",
+			wantContains: []string{"no naming constraint is known for bogus"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExplainValidation(tt.field, tt.value)
+			for _, want := range tt.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("ExplainValidation(%q, %q) = %q, want to contain %q", tt.field, tt.value, got, want)
+				}
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(got, absent) {
+					t.Errorf("ExplainValidation(%q, %q) = %q, should not contain %q", tt.field, tt.value, got, absent)
+				}
+			}
+		})
+	}
+}